@@ -6,9 +6,12 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"os"
 
 	"r53restapi.com/pkg/apiserver"
 	"r53restapi.com/pkg/buildflags"
+	"r53restapi.com/pkg/config"
 	"r53restapi.com/pkg/log"
 )
 
@@ -21,6 +24,8 @@ var (
 	logLevel    = flag.String("loglevel", "info", "Sets logging level.")
 	logFile     = flag.String("logfile", "", "Sets logfile name.")
 	logToStdout = flag.Bool("logstdout", true, "Logs to stdout only.")
+	configFile  = flag.String("config", "", "Path to a YAML config file. Values in the file override environment variables.")
+	printConfig = flag.Bool("print-config", false, "Prints the effective (redacted) configuration, validates it and exits.")
 )
 
 var Commit string
@@ -30,6 +35,23 @@ const OEP_VER = "24.08"
 func main() {
 	flag.Parse()
 
+	appConfig, err := config.Load(*configFile)
+	if *printConfig {
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+			os.Exit(1)
+		}
+		if _, err := fmt.Fprint(os.Stdout, appConfig.YAML()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error printing configuration: %v\n", err)
+			os.Exit(1)
+		}
+		if err := appConfig.Validate(); err != nil {
+			fmt.Fprintf(os.Stderr, "Configuration is invalid: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	logConfig := log.Config{
 		Directory:  LOG_DIR,
 		File:       *logFile,
@@ -61,6 +83,11 @@ func main() {
 		panic("Error initialising logging")
 	}
 
+	if err != nil {
+		log.Errorf("Error loading configuration: %v", err)
+		os.Exit(1)
+	}
+
 	log.Infof("Starting AWS Management Web endpoint for ACM and Route53")
 
 	ver_info := "Version " + OEP_VER + "_" + Commit
@@ -70,6 +97,7 @@ func main() {
 	serverConf := apiserver.ServerConfig{
 		VersionInfo: ver_info,
 		HttpPort:    *httpPort,
+		App:         appConfig,
 	}
 
 	if err := apiserver.Init(serverConf); err != nil {
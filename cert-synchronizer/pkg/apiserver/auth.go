@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	appconfig "r53restapi.com/pkg/config"
+	"r53restapi.com/pkg/log"
+)
+
+const (
+	AUTH_MODE_SERVICEACCOUNT = appconfig.AUTH_MODE_SERVICEACCOUNT
+	AUTH_MODE_JWT            = appconfig.AUTH_MODE_JWT
+	AUTH_MODE_NONE           = appconfig.AUTH_MODE_NONE
+
+	ROLE_CERT_ADMIN = "cert-admin"
+	ROLE_DNS_ADMIN  = "dns-admin"
+	ROLE_DEBUG      = "debug"
+
+	HEADER_AUTHORIZATION = "Authorization"
+	BEARER_PREFIX        = "bearer "
+)
+
+// principal is the authenticated caller of a /v1 endpoint.
+type principal struct {
+	name   string
+	groups []string
+	roles  []string
+}
+
+// realmAccess mirrors the Keycloak realm_access claim used across the orchestrator.
+type realmAccess struct {
+	Roles []string `json:"roles"`
+}
+
+type jwtClaims struct {
+	PreferredUsername string      `json:"preferred_username"`
+	RealmAccess       realmAccess `json:"realm_access"`
+	Roles             []string    `json:"roles"`
+	jwt.RegisteredClaims
+}
+
+var (
+	jwtVerifyKey crypto.PublicKey
+	// roleBindings maps a role to the user names or groups granted it.
+	roleBindings map[string][]string
+)
+
+/*
+initAuth loads the key material and role bindings needed by the configured auth mode.
+It is called from Init so that misconfiguration stops the server from starting.
+*/
+func initAuth() error {
+	roleBindings = env.apiRoleBindings
+
+	switch env.apiAuthMode {
+	case AUTH_MODE_SERVICEACCOUNT:
+		log.Infof("API authentication mode: Kubernetes ServiceAccount tokens")
+	case AUTH_MODE_JWT:
+		pemBytes, err := os.ReadFile(env.apiJwtPublicKeyFile)
+		if err != nil {
+			return fmt.Errorf("unable to read API_JWT_PUBLIC_KEY_FILE %s: %w", env.apiJwtPublicKeyFile, err)
+		}
+		if jwtVerifyKey, err = jwt.ParseRSAPublicKeyFromPEM(pemBytes); err != nil {
+			if jwtVerifyKey, err = jwt.ParseECPublicKeyFromPEM(pemBytes); err != nil {
+				return fmt.Errorf("API_JWT_PUBLIC_KEY_FILE does not contain an RSA or EC public key: %w", err)
+			}
+		}
+		log.Infof("API authentication mode: JWT")
+	case AUTH_MODE_NONE:
+		log.Warnf("API authentication is disabled, every /v1 caller is granted all roles")
+	default:
+		return fmt.Errorf("unknown API_AUTH_MODE %q", env.apiAuthMode)
+	}
+	return nil
+}
+
+func bearerToken(r *http.Request) string {
+	auth := r.Header.Get(HEADER_AUTHORIZATION)
+	if len(auth) <= len(BEARER_PREFIX) || !strings.EqualFold(auth[:len(BEARER_PREFIX)], BEARER_PREFIX) {
+		return ""
+	}
+	return strings.TrimSpace(auth[len(BEARER_PREFIX):])
+}
+
+func authenticate(r *http.Request) (*principal, error) {
+	if env.apiAuthMode == AUTH_MODE_NONE {
+		return &principal{name: "anonymous", roles: []string{ROLE_CERT_ADMIN, ROLE_DNS_ADMIN, ROLE_DEBUG}}, nil
+	}
+
+	token := bearerToken(r)
+	if token == "" {
+		return nil, fmt.Errorf("missing bearer token")
+	}
+
+	if env.apiAuthMode == AUTH_MODE_JWT {
+		return authenticateJWT(token)
+	}
+	return authenticateServiceAccount(r.Context(), token)
+}
+
+func authenticateJWT(token string) (*principal, error) {
+	opts := []jwt.ParserOption{jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"})}
+	if env.apiJwtIssuer != "" {
+		opts = append(opts, jwt.WithIssuer(env.apiJwtIssuer))
+	}
+	if env.apiJwtAudience != "" {
+		opts = append(opts, jwt.WithAudience(env.apiJwtAudience))
+	}
+
+	var claims jwtClaims
+	if _, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return jwtVerifyKey, nil
+	}, opts...); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	name := claims.PreferredUsername
+	if name == "" {
+		name = claims.Subject
+	}
+	return &principal{
+		name:  name,
+		roles: slices.Concat(claims.RealmAccess.Roles, claims.Roles),
+	}, nil
+}
+
+// authenticateServiceAccount validates the token with a Kubernetes TokenReview.
+func authenticateServiceAccount(ctx context.Context, token string) (*principal, error) {
+	dynamicClient, err := getKubernetesClient()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Kubernetes client for token review: %w", err)
+	}
+
+	reviewGVR := schema.GroupVersionResource{Group: "authentication.k8s.io", Version: "v1", Resource: "tokenreviews"}
+	review := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "authentication.k8s.io/v1",
+			"kind":       "TokenReview",
+			"spec": map[string]interface{}{
+				"token": token,
+			},
+		},
+	}
+
+	result, err := dynamicClient.Resource(reviewGVR).Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("token review failed: %w", err)
+	}
+
+	authenticated, _, _ := unstructured.NestedBool(result.Object, "status", "authenticated")
+	if !authenticated {
+		reason, _, _ := unstructured.NestedString(result.Object, "status", "error")
+		return nil, fmt.Errorf("token not authenticated: %s", reason)
+	}
+
+	name, _, _ := unstructured.NestedString(result.Object, "status", "user", "username")
+	groups, _, _ := unstructured.NestedStringSlice(result.Object, "status", "user", "groups")
+	return &principal{name: name, groups: groups}, nil
+}
+
+// hasRole reports whether the principal carries the role directly or through API_ROLE_BINDINGS.
+func (p *principal) hasRole(role string) bool {
+	if slices.Contains(p.roles, role) {
+		return true
+	}
+	for _, subject := range roleBindings[role] {
+		if subject == p.name || slices.Contains(p.groups, subject) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+func TestPrincipalHasRole(t *testing.T) {
+	savedBindings := roleBindings
+	t.Cleanup(func() { roleBindings = savedBindings })
+	roleBindings = map[string][]string{
+		ROLE_CERT_ADMIN: {"system:serviceaccount:orch-gateway:sa-cert-rewriter", "cert-admins"},
+		ROLE_DEBUG:      {"alice"},
+	}
+
+	tests := []struct {
+		name string
+		p    principal
+		role string
+		want bool
+	}{
+		{
+			name: "role carried by the token",
+			p:    principal{name: "bob", roles: []string{ROLE_DNS_ADMIN}},
+			role: ROLE_DNS_ADMIN,
+			want: true,
+		},
+		{
+			name: "role bound to the user name",
+			p:    principal{name: "system:serviceaccount:orch-gateway:sa-cert-rewriter"},
+			role: ROLE_CERT_ADMIN,
+			want: true,
+		},
+		{
+			name: "role bound to a group",
+			p:    principal{name: "bob", groups: []string{"developers", "cert-admins"}},
+			role: ROLE_CERT_ADMIN,
+			want: true,
+		},
+		{
+			name: "binding of another role",
+			p:    principal{name: "alice"},
+			role: ROLE_CERT_ADMIN,
+			want: false,
+		},
+		{
+			name: "other role carried by the token",
+			p:    principal{name: "bob", roles: []string{ROLE_DEBUG}},
+			role: ROLE_DNS_ADMIN,
+			want: false,
+		},
+		{
+			name: "group named like a user of the binding",
+			p:    principal{name: "bob", groups: []string{"alice"}},
+			role: ROLE_DEBUG,
+			want: true,
+		},
+		{
+			name: "no roles or groups",
+			p:    principal{name: "mallory"},
+			role: ROLE_DEBUG,
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.hasRole(tt.role); got != tt.want {
+				t.Errorf("hasRole(%s) = %t, want %t", tt.role, got, tt.want)
+			}
+		})
+	}
+}
+
+// withJWTAuth configures JWT authentication with a new key, and returns a function signing tokens with it.
+func withJWTAuth(t *testing.T) func(claims jwtClaims) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	savedEnv, savedKey, savedBindings := env, jwtVerifyKey, roleBindings
+	t.Cleanup(func() {
+		env, jwtVerifyKey, roleBindings = savedEnv, savedKey, savedBindings
+	})
+	env.apiAuthMode = AUTH_MODE_JWT
+	env.apiJwtIssuer = "https://keycloak.example.com/realms/master"
+	jwtVerifyKey = &key.PublicKey
+	roleBindings = nil
+
+	return func(claims jwtClaims) string {
+		t.Helper()
+		signed, err := jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+}
+
+func TestV1HandlerAuthErrors(t *testing.T) {
+	sign := withJWTAuth(t)
+	valid := jwt.RegisteredClaims{
+		Issuer:    env.apiJwtIssuer,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+	expired := jwt.RegisteredClaims{
+		Issuer:    env.apiJwtIssuer,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+	}
+	otherIssuer := jwt.RegisteredClaims{
+		Issuer:    "https://other.example.com",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+	}{
+		{
+			name:       "missing token",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "not a bearer token",
+			authorization: "Basic dXNlcjpwYXNz",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "malformed token",
+			authorization: "Bearer not-a-jwt",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "expired token",
+			authorization: "Bearer " + sign(jwtClaims{RealmAccess: realmAccess{Roles: []string{ROLE_CERT_ADMIN}}, RegisteredClaims: expired}),
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "token of another issuer",
+			authorization: "Bearer " + sign(jwtClaims{RealmAccess: realmAccess{Roles: []string{ROLE_CERT_ADMIN}}, RegisteredClaims: otherIssuer}),
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "token without the role",
+			authorization: "Bearer " + sign(jwtClaims{PreferredUsername: "bob", RealmAccess: realmAccess{Roles: []string{ROLE_DNS_ADMIN}}, RegisteredClaims: valid}),
+			wantStatus:    http.StatusForbidden,
+		},
+		{
+			name:          "token with the realm role",
+			authorization: "Bearer " + sign(jwtClaims{PreferredUsername: "alice", RealmAccess: realmAccess{Roles: []string{ROLE_CERT_ADMIN}}, RegisteredClaims: valid}),
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:          "token with the role claim",
+			authorization: "Bearer " + sign(jwtClaims{PreferredUsername: "alice", Roles: []string{ROLE_CERT_ADMIN}, RegisteredClaims: valid}),
+			wantStatus:    http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := v1Handler(ROLE_CERT_ADMIN, http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPut, API_V1_PREFIX+"/certificates", nil)
+			if tt.authorization != "" {
+				req.Header.Set(HEADER_AUTHORIZATION, tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if called != (tt.wantStatus == http.StatusOK) {
+				t.Errorf("handler called = %t with status %d", called, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK {
+				return
+			}
+
+			if got := rec.Header().Get(HEADER_CONTENT); got != JSON_CONTENT {
+				t.Errorf("content type %q, want %q", got, JSON_CONTENT)
+			}
+			var body ApiError
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("body is not an ApiError: %v: %s", err, rec.Body.String())
+			}
+			if body.Error.Code != tt.wantStatus || body.Error.Status != http.StatusText(tt.wantStatus) {
+				t.Errorf("error code %d %q, want %d %q", body.Error.Code, body.Error.Status, tt.wantStatus, http.StatusText(tt.wantStatus))
+			}
+			if body.Error.Message == "" || body.Error.RequestId == "" {
+				t.Errorf("error without message or request id: %+v", body.Error)
+			}
+		})
+	}
+}
+
+func TestV1HandlerRewritesHandlerErrors(t *testing.T) {
+	withJWTAuth(t)
+	env.apiAuthMode = AUTH_MODE_NONE
+
+	handler := v1Handler(ROLE_DNS_ADMIN, http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("handler got method %s, want %s", r.Method, http.MethodPost)
+		}
+		http.Error(w, "recordName is required", http.StatusBadRequest)
+	})
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodDelete, API_V1_PREFIX+"/dnsrecords", nil))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	var body ApiError
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("body is not an ApiError: %v: %s", err, rec.Body.String())
+	}
+	if body.Error.Code != http.StatusBadRequest || body.Error.Message != "recordName is required" {
+		t.Errorf("error %+v, want code %d with the handler message", body.Error, http.StatusBadRequest)
+	}
+}
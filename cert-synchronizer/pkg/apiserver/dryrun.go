@@ -0,0 +1,363 @@
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	b64 "encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"r53restapi.com/pkg/log"
+)
+
+const (
+	DRY_RUN_PARAM       = "dryrun"
+	DRY_RUN_ARN         = "arn:aws:acm:dry-run:certificate/planned"
+	DRY_RUN_ZONE_PREFIX = "/hostedzone/dry-run-"
+
+	ACTION_ACM_IMPORT       = "acm:ImportCertificate"
+	ACTION_ACM_ADD_TAGS     = "acm:AddTagsToCertificate"
+	ACTION_ACM_DELETE       = "acm:DeleteCertificate"
+	ACTION_R53_CREATE_ZONE  = "route53:CreateHostedZone"
+	ACTION_R53_CHANGE       = "route53:"
+	ACTION_K8S_APPLY_SECRET = "kubernetes:ApplySecret"
+)
+
+// PlannedChange is one mutation that would have been made had the request not been a dry run.
+type PlannedChange struct {
+	Action   string `json:"action"`
+	Target   string `json:"target"`
+	OldValue string `json:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
+}
+
+// DryRunResponse is returned in place of the normal response body for a dry-run request.
+type DryRunResponse struct {
+	DryRun  bool            `json:"dryRun"`
+	Message string          `json:"message"`
+	Changes []PlannedChange `json:"changes"`
+}
+
+type changePlan struct {
+	mu      sync.Mutex
+	changes []PlannedChange
+}
+
+type planKey struct{}
+
+func withPlan(ctx context.Context) (context.Context, *changePlan) {
+	plan := &changePlan{changes: []PlannedChange{}}
+	return context.WithValue(ctx, planKey{}, plan), plan
+}
+
+// planFrom returns the plan attached to ctx, or nil if the mutations in ctx should be executed.
+func planFrom(ctx context.Context) *changePlan {
+	plan, _ := ctx.Value(planKey{}).(*changePlan)
+	return plan
+}
+
+func isDryRun(ctx context.Context) bool {
+	return planFrom(ctx) != nil
+}
+
+func (p *changePlan) add(change PlannedChange) {
+	log.Infof("Dry run, skipping %s on %s", change.Action, change.Target)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.changes = append(p.changes, change)
+}
+
+func (p *changePlan) list() []PlannedChange {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]PlannedChange{}, p.changes...)
+}
+
+func (p *changePlan) log() {
+	for _, c := range p.list() {
+		log.Infof("Planned change: %s %s old=%q new=%q", c.Action, c.Target, c.OldValue, c.NewValue)
+	}
+}
+
+/*
+bufferedResponseWriter holds back the status and body written by a handler so the caller can
+decide what to send. Informational (1xx) status codes, which UpdateCert uses to keep botKube
+waiting, are passed straight through.
+*/
+type bufferedResponseWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	if status < http.StatusOK {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(b)
+}
+
+func (w *bufferedResponseWriter) statusCode() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+// message unwraps a handler response that is either {"message": ...} or plain text.
+func (w *bufferedResponseWriter) message() string {
+	var jsonResp JsonResponse
+	if err := json.Unmarshal(w.body.Bytes(), &jsonResp); err == nil && jsonResp.Message != "" {
+		return jsonResp.Message
+	}
+	return strings.TrimSpace(w.body.String())
+}
+
+// passThrough sends the buffered response unchanged.
+func (w *bufferedResponseWriter) passThrough() {
+	w.ResponseWriter.WriteHeader(w.statusCode())
+	if _, err := w.ResponseWriter.Write(w.body.Bytes()); err != nil {
+		log.Errorf("Error writing response body: %v", err)
+	}
+}
+
+func requestDryRun(r *http.Request) (bool, error) {
+	for key, values := range r.URL.Query() {
+		if strings.ToLower(key) == DRY_RUN_PARAM && len(values) > 0 {
+			return strconv.ParseBool(values[0])
+		}
+	}
+	return false, nil
+}
+
+/*
+dryRunHandler runs handler against a change plan when the request carries dryRun=true or the
+server is configured for dry run. The mutations are recorded rather than executed and the plan is
+returned as a DryRunResponse. Requests that are not dry runs are passed to handler untouched.
+*/
+func dryRunHandler(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		dryRun, err := requestDryRun(r)
+		if err != nil {
+			log.Warnf("Invalid dryRun parameter: %v", err)
+			httpResponse{acceptedContent: r.Header.Get(HEADER_ACCEPT), status: http.StatusBadRequest, message: MSG_400_BAD_RQ}.write(w)
+			return
+		}
+
+		if !dryRun && !env.dryRun {
+			handler(w, r)
+			return
+		}
+
+		ctx, plan := withPlan(r.Context())
+		rw := &bufferedResponseWriter{ResponseWriter: w}
+		handler(rw, r.WithContext(ctx))
+
+		if rw.statusCode() >= http.StatusBadRequest {
+			rw.passThrough()
+			return
+		}
+
+		body, err := json.Marshal(DryRunResponse{DryRun: true, Message: rw.message(), Changes: plan.list()})
+		if err != nil {
+			log.Errorf("Error marshalling dry-run response: %v", err)
+			rw.passThrough()
+			return
+		}
+
+		w.Header().Set(HEADER_CONTENT, JSON_CONTENT)
+		w.WriteHeader(rw.statusCode())
+		if _, err := w.Write(body); err != nil {
+			log.Errorf("Error writing response body: %v", err)
+		}
+	}
+}
+
+// describeCertPEM summarises a PEM certificate for a plan without including key material.
+func describeCertPEM(certPEM []byte) string {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return ""
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("subject=%s serial=%x notAfter=%s", cert.Subject.CommonName, cert.SerialNumber, cert.NotAfter.Format(time.RFC3339))
+}
+
+func describeACMCertificate(ctx context.Context, svc *acm.Client, arn string) string {
+	detail, err := svc.DescribeCertificate(ctx, &acm.DescribeCertificateInput{CertificateArn: aws.String(arn)})
+	if err != nil || detail.Certificate == nil {
+		return arn
+	}
+	notAfter := ""
+	if detail.Certificate.NotAfter != nil {
+		notAfter = detail.Certificate.NotAfter.Format(time.RFC3339)
+	}
+	return fmt.Sprintf("subject=%s serial=%s notAfter=%s", aws.ToString(detail.Certificate.DomainName), strings.ReplaceAll(aws.ToString(detail.Certificate.Serial), ":", ""), notAfter)
+}
+
+func acmImportCertificate(ctx context.Context, svc *acm.Client, input *acm.ImportCertificateInput) (*acm.ImportCertificateOutput, error) {
+	plan := planFrom(ctx)
+	if plan == nil {
+		return svc.ImportCertificate(ctx, input)
+	}
+
+	target := aws.ToString(input.CertificateArn)
+	oldValue := ""
+	if target == "" {
+		target = DRY_RUN_ARN
+	} else {
+		oldValue = describeACMCertificate(ctx, svc, target)
+	}
+	plan.add(PlannedChange{Action: ACTION_ACM_IMPORT, Target: target, OldValue: oldValue, NewValue: describeCertPEM(input.Certificate)})
+	return &acm.ImportCertificateOutput{CertificateArn: aws.String(target)}, nil
+}
+
+func acmAddTagsToCertificate(ctx context.Context, svc *acm.Client, input *acm.AddTagsToCertificateInput) error {
+	plan := planFrom(ctx)
+	if plan == nil {
+		_, err := svc.AddTagsToCertificate(ctx, input)
+		return err
+	}
+
+	var tags []string
+	for _, tag := range input.Tags {
+		tags = append(tags, aws.ToString(tag.Key)+"="+aws.ToString(tag.Value))
+	}
+	plan.add(PlannedChange{Action: ACTION_ACM_ADD_TAGS, Target: aws.ToString(input.CertificateArn), NewValue: strings.Join(tags, ",")})
+	return nil
+}
+
+func acmDeleteCertificate(ctx context.Context, svc *acm.Client, input *acm.DeleteCertificateInput) error {
+	plan := planFrom(ctx)
+	if plan == nil {
+		_, err := svc.DeleteCertificate(ctx, input)
+		return err
+	}
+
+	arn := aws.ToString(input.CertificateArn)
+	plan.add(PlannedChange{Action: ACTION_ACM_DELETE, Target: arn, OldValue: describeACMCertificate(ctx, svc, arn)})
+	return nil
+}
+
+func r53CreateHostedZone(ctx context.Context, svc *route53.Client, input *route53.CreateHostedZoneInput) (string, error) {
+	plan := planFrom(ctx)
+	if plan == nil {
+		output, err := svc.CreateHostedZone(ctx, input)
+		if err != nil {
+			return "", err
+		}
+		return aws.ToString(output.HostedZone.Id), nil
+	}
+
+	newValue := "private=false"
+	if input.VPC != nil {
+		newValue = "private=true vpc=" + aws.ToString(input.VPC.VPCId) + " region=" + string(input.VPC.VPCRegion)
+	}
+	plan.add(PlannedChange{Action: ACTION_R53_CREATE_ZONE, Target: aws.ToString(input.Name), NewValue: newValue})
+	return DRY_RUN_ZONE_PREFIX + aws.ToString(input.Name), nil
+}
+
+func recordValues(rrs *route53types.ResourceRecordSet) string {
+	if rrs == nil {
+		return ""
+	}
+	var values []string
+	for _, rr := range rrs.ResourceRecords {
+		values = append(values, aws.ToString(rr.Value))
+	}
+	return strings.Join(values, ",")
+}
+
+func r53ChangeResourceRecordSets(ctx context.Context, svc *route53.Client, input *route53.ChangeResourceRecordSetsInput) error {
+	plan := planFrom(ctx)
+	if plan == nil {
+		_, err := svc.ChangeResourceRecordSets(ctx, input)
+		return err
+	}
+
+	zone := aws.ToString(input.HostedZoneId)
+	for _, change := range input.ChangeBatch.Changes {
+		rrs := change.ResourceRecordSet
+		name := strings.TrimSuffix(aws.ToString(rrs.Name), ".")
+		planned := PlannedChange{
+			Action: ACTION_R53_CHANGE + string(change.Action),
+			Target: zone + " " + name + " " + string(rrs.Type),
+		}
+
+		switch change.Action {
+		case route53types.ChangeActionDelete:
+			planned.OldValue = recordValues(rrs)
+		default:
+			planned.NewValue = recordValues(rrs)
+			if existing, err := getRoute53Record(svc, zone, name, string(rrs.Type)); err == nil {
+				planned.OldValue = recordValues(existing)
+			}
+		}
+		plan.add(planned)
+	}
+	return nil
+}
+
+func k8sApplySecret(ctx context.Context, dynamicClient dynamic.Interface, secret *unstructured.Unstructured, cert certChain) error {
+	secretGVR := schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+	secrets := dynamicClient.Resource(secretGVR).Namespace(env.namespace)
+
+	plan := planFrom(ctx)
+	if plan == nil {
+		_, err := secrets.Apply(ctx, env.k8sCertSecretName, secret, metav1.ApplyOptions{FieldManager: "application/apply-patch", Force: true})
+		return err
+	}
+
+	oldValue := ""
+	existing, err := secrets.Get(ctx, env.k8sCertSecretName, metav1.GetOptions{})
+	if err == nil {
+		if tlsCrt, found, _ := unstructured.NestedString(existing.Object, "data", "tls.crt"); found {
+			if decoded, err := b64.StdEncoding.DecodeString(tlsCrt); err == nil {
+				oldValue = describeCertPEM(decoded)
+			}
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	plan.add(PlannedChange{
+		Action:   ACTION_K8S_APPLY_SECRET,
+		Target:   env.namespace + "/" + env.k8sCertSecretName,
+		OldValue: oldValue,
+		NewValue: describeCertPEM(cert.tlsCrt),
+	})
+	return nil
+}
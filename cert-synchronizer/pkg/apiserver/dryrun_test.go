@@ -0,0 +1,299 @@
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+const (
+	testCertArn = "arn:aws:acm:us-west-2:123456789012:certificate/test"
+	testZoneID  = "Z0123456789"
+
+	describeCertificateResponse = `{"Certificate": {"CertificateArn": "` + testCertArn + `",
+		"DomainName": "orch.example.com", "Serial": "0a:1b", "NotAfter": 1767225600}}`
+	listResourceRecordSetsResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<ListResourceRecordSetsResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+  <ResourceRecordSets>
+    <ResourceRecordSet>
+      <Name>web-ui.orch.example.com.</Name><Type>CNAME</Type><TTL>300</TTL>
+      <ResourceRecords><ResourceRecord><Value>old-lb.example.com</Value></ResourceRecord></ResourceRecords>
+    </ResourceRecordSet>
+  </ResourceRecordSets>
+  <IsTruncated>false</IsTruncated><MaxItems>100</MaxItems>
+</ListResourceRecordSetsResponse>`
+	changeResourceRecordSetsResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<ChangeResourceRecordSetsResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+  <ChangeInfo><Id>/change/C1</Id><Status>PENDING</Status><SubmittedAt>2025-01-01T00:00:00Z</SubmittedAt></ChangeInfo>
+</ChangeResourceRecordSetsResponse>`
+)
+
+// fakeAWS serves the ACM and Route53 APIs and records the operations it receives.
+type fakeAWS struct {
+	mu         sync.Mutex
+	operations []string
+}
+
+func (f *fakeAWS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if target := r.Header.Get("X-Amz-Target"); target != "" {
+		operation := "acm:" + strings.TrimPrefix(target, "CertificateManager.")
+		f.record(operation)
+		w.Header().Set(HEADER_CONTENT, "application/x-amz-json-1.1")
+		if operation == "acm:DescribeCertificate" {
+			_, _ = w.Write([]byte(describeCertificateResponse))
+			return
+		}
+		_, _ = w.Write([]byte(`{"CertificateArn": "` + testCertArn + `"}`))
+		return
+	}
+
+	f.record("route53:" + r.Method + " " + strings.TrimSuffix(r.URL.Path, "/"))
+	w.Header().Set(HEADER_CONTENT, "text/xml")
+	if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/rrset") {
+		_, _ = w.Write([]byte(listResourceRecordSetsResponse))
+		return
+	}
+	_, _ = w.Write([]byte(changeResourceRecordSetsResponse))
+}
+
+func (f *fakeAWS) record(operation string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.operations = append(f.operations, operation)
+}
+
+// mutations returns the operations received that change ACM or Route53.
+func (f *fakeAWS) mutations() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var mutations []string
+	for _, operation := range f.operations {
+		switch {
+		case operation == ACTION_ACM_IMPORT, operation == ACTION_ACM_ADD_TAGS, operation == ACTION_ACM_DELETE:
+			mutations = append(mutations, operation)
+		case strings.HasPrefix(operation, "route53:"+http.MethodPost):
+			mutations = append(mutations, operation)
+		}
+	}
+	return mutations
+}
+
+func newFakeAWSClients(t *testing.T) (*fakeAWS, *acm.Client, *route53.Client) {
+	t.Helper()
+	fake := &fakeAWS{}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	cfg := aws.Config{
+		Region:           "us-west-2",
+		Credentials:      credentials.NewStaticCredentialsProvider("AKIAEXAMPLE", "secret", ""),
+		RetryMaxAttempts: 1,
+	}
+	acmSvc := acm.NewFromConfig(cfg, func(o *acm.Options) { o.BaseEndpoint = aws.String(srv.URL) })
+	r53Svc := route53.NewFromConfig(cfg, func(o *route53.Options) { o.BaseEndpoint = aws.String(srv.URL) })
+	return fake, acmSvc, r53Svc
+}
+
+func generateCertPEM(t *testing.T, commonName string, notAfter time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0x2c3d),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.Add(-90 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestDryRunSkipsAWSMutations(t *testing.T) {
+	fake, acmSvc, r53Svc := newFakeAWSClients(t)
+	notAfter := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	certPEM := generateCertPEM(t, "orch.example.com", notAfter)
+	ctx, plan := withPlan(context.Background())
+
+	output, err := acmImportCertificate(ctx, acmSvc, &acm.ImportCertificateInput{CertificateArn: aws.String(testCertArn), Certificate: certPEM})
+	if err != nil {
+		t.Fatalf("acmImportCertificate: %v", err)
+	}
+	if aws.ToString(output.CertificateArn) != testCertArn {
+		t.Errorf("reimport returned %s, want %s", aws.ToString(output.CertificateArn), testCertArn)
+	}
+	output, err = acmImportCertificate(ctx, acmSvc, &acm.ImportCertificateInput{Certificate: certPEM})
+	if err != nil {
+		t.Fatalf("acmImportCertificate: %v", err)
+	}
+	if aws.ToString(output.CertificateArn) != DRY_RUN_ARN {
+		t.Errorf("new import returned %s, want %s", aws.ToString(output.CertificateArn), DRY_RUN_ARN)
+	}
+	if err := acmAddTagsToCertificate(ctx, acmSvc, &acm.AddTagsToCertificateInput{
+		CertificateArn: aws.String(DRY_RUN_ARN),
+		Tags:           []acmtypes.Tag{{Key: aws.String("Name"), Value: aws.String("ACM_Certificate_Importer")}},
+	}); err != nil {
+		t.Fatalf("acmAddTagsToCertificate: %v", err)
+	}
+	if err := acmDeleteCertificate(ctx, acmSvc, &acm.DeleteCertificateInput{CertificateArn: aws.String(testCertArn)}); err != nil {
+		t.Fatalf("acmDeleteCertificate: %v", err)
+	}
+
+	zoneID, err := r53CreateHostedZone(ctx, r53Svc, &route53.CreateHostedZoneInput{
+		Name:            aws.String("orch.example.com"),
+		CallerReference: aws.String("test"),
+		VPC:             &route53types.VPC{VPCId: aws.String("vpc-0123"), VPCRegion: route53types.VPCRegionUsWest2},
+	})
+	if err != nil {
+		t.Fatalf("r53CreateHostedZone: %v", err)
+	}
+	if zoneID != DRY_RUN_ZONE_PREFIX+"orch.example.com" {
+		t.Errorf("hosted zone id %s, want a dry-run id", zoneID)
+	}
+	if err := r53ChangeResourceRecordSets(ctx, r53Svc, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(testZoneID),
+		ChangeBatch: &route53types.ChangeBatch{Changes: []route53types.Change{
+			{
+				Action: route53types.ChangeActionUpsert,
+				ResourceRecordSet: &route53types.ResourceRecordSet{
+					Name:            aws.String("web-ui.orch.example.com"),
+					Type:            route53types.RRTypeCname,
+					TTL:             aws.Int64(300),
+					ResourceRecords: []route53types.ResourceRecord{{Value: aws.String("new-lb.example.com")}},
+				},
+			},
+			{
+				Action: route53types.ChangeActionDelete,
+				ResourceRecordSet: &route53types.ResourceRecordSet{
+					Name:            aws.String("old.orch.example.com."),
+					Type:            route53types.RRTypeA,
+					TTL:             aws.Int64(300),
+					ResourceRecords: []route53types.ResourceRecord{{Value: aws.String("10.0.0.1")}},
+				},
+			},
+		}},
+	}); err != nil {
+		t.Fatalf("r53ChangeResourceRecordSets: %v", err)
+	}
+
+	if mutations := fake.mutations(); len(mutations) != 0 {
+		t.Errorf("dry run sent mutations to AWS: %v", mutations)
+	}
+
+	oldCert := "subject=orch.example.com serial=0a1b notAfter=2026-01-01T00:00:00Z"
+	newCert := "subject=orch.example.com serial=2c3d notAfter=2026-04-01T00:00:00Z"
+	want := []PlannedChange{
+		{Action: ACTION_ACM_IMPORT, Target: testCertArn, OldValue: oldCert, NewValue: newCert},
+		{Action: ACTION_ACM_IMPORT, Target: DRY_RUN_ARN, NewValue: newCert},
+		{Action: ACTION_ACM_ADD_TAGS, Target: DRY_RUN_ARN, NewValue: "Name=ACM_Certificate_Importer"},
+		{Action: ACTION_ACM_DELETE, Target: testCertArn, OldValue: oldCert},
+		{Action: ACTION_R53_CREATE_ZONE, Target: "orch.example.com", NewValue: "private=true vpc=vpc-0123 region=us-west-2"},
+		{Action: "route53:UPSERT", Target: testZoneID + " web-ui.orch.example.com CNAME", OldValue: "old-lb.example.com", NewValue: "new-lb.example.com"},
+		{Action: "route53:DELETE", Target: testZoneID + " old.orch.example.com A", OldValue: "10.0.0.1"},
+	}
+	got := plan.list()
+	if len(got) != len(want) {
+		t.Fatalf("plan has %d changes, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("change %d:\n got %+v\nwant %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestMutationsReachAWSWithoutDryRun(t *testing.T) {
+	fake, acmSvc, r53Svc := newFakeAWSClients(t)
+	ctx := context.Background()
+
+	if err := acmDeleteCertificate(ctx, acmSvc, &acm.DeleteCertificateInput{CertificateArn: aws.String(testCertArn)}); err != nil {
+		t.Fatalf("acmDeleteCertificate: %v", err)
+	}
+	if err := r53ChangeResourceRecordSets(ctx, r53Svc, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(testZoneID),
+		ChangeBatch: &route53types.ChangeBatch{Changes: []route53types.Change{{
+			Action: route53types.ChangeActionDelete,
+			ResourceRecordSet: &route53types.ResourceRecordSet{
+				Name:            aws.String("old.orch.example.com"),
+				Type:            route53types.RRTypeA,
+				TTL:             aws.Int64(300),
+				ResourceRecords: []route53types.ResourceRecord{{Value: aws.String("10.0.0.1")}},
+			},
+		}}},
+	}); err != nil {
+		t.Fatalf("r53ChangeResourceRecordSets: %v", err)
+	}
+
+	mutations := fake.mutations()
+	if len(mutations) != 2 || mutations[0] != ACTION_ACM_DELETE || !strings.HasSuffix(mutations[1], "/hostedzone/"+testZoneID+"/rrset") {
+		t.Errorf("mutations %v, want the ACM delete and the Route53 change", mutations)
+	}
+}
+
+func TestDryRunHandlerReturnsPlan(t *testing.T) {
+	fake, acmSvc, _ := newFakeAWSClients(t)
+	savedEnv := env
+	t.Cleanup(func() { env = savedEnv })
+	env.dryRun = false
+
+	handler := dryRunHandler(func(w http.ResponseWriter, r *http.Request) {
+		if err := acmDeleteCertificate(r.Context(), acmSvc, &acm.DeleteCertificateInput{CertificateArn: aws.String(testCertArn)}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httpResponse{acceptedContent: JSON_CONTENT, status: http.StatusOK, message: "Certificate deleted"}.write(w)
+	})
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodPost, "/v1/certificates/delete?dryRun=true", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var resp DryRunResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("body is not a DryRunResponse: %v: %s", err, rec.Body.String())
+	}
+	if !resp.DryRun || resp.Message != "Certificate deleted" {
+		t.Errorf("response %+v, want a dry run with the handler message", resp)
+	}
+	if len(resp.Changes) != 1 || resp.Changes[0].Action != ACTION_ACM_DELETE || resp.Changes[0].Target != testCertArn {
+		t.Errorf("changes %+v, want the ACM delete of %s", resp.Changes, testCertArn)
+	}
+	if mutations := fake.mutations(); len(mutations) != 0 {
+		t.Errorf("dry run sent mutations to AWS: %v", mutations)
+	}
+
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodPost, "/v1/certificates/delete?dryRun=maybe", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("invalid dryRun parameter: status %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,482 @@
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"r53restapi.com/pkg/log"
+)
+
+const (
+	DEFAULT_EXPIRY_SCAN_WORKERS = 4
+
+	EXPIRY_EVENT_SOURCE = "cert-synchronizer"
+)
+
+type expiryLevel int
+
+const (
+	expiryLevelOK expiryLevel = iota
+	expiryLevelWarning
+	expiryLevelCritical
+	expiryLevelExpired
+)
+
+func (l expiryLevel) String() string {
+	switch l {
+	case expiryLevelWarning:
+		return "warning"
+	case expiryLevelCritical:
+		return "critical"
+	case expiryLevelExpired:
+		return "expired"
+	default:
+		return "ok"
+	}
+}
+
+// expiryNotification is the body POSTed to CERT_EXPIRY_WEBHOOK_URL when a certificate crosses a threshold.
+type expiryNotification struct {
+	Source        string `json:"source"`
+	CertificateId string `json:"certificateArn"`
+	Domain        string `json:"domain"`
+	NotAfter      string `json:"notAfter"`
+	DaysToExpiry  int    `json:"daysToExpiry"`
+	Level         string `json:"level"`
+	PreviousLevel string `json:"previousLevel"`
+	TimeStamp     string `json:"timeStamp"`
+}
+
+var (
+	certExpiryDays = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cert_synchronizer_acm_certificate_expiry_days",
+			Help: "Number of days until the tagged ACM certificate expires.",
+		},
+		[]string{"arn", "domain"},
+	)
+	certExpiryScanErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "cert_synchronizer_acm_expiry_scan_errors_total",
+			Help: "Number of ACM certificate expiry scans that failed.",
+		},
+	)
+	certExpiryLastScan = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "cert_synchronizer_acm_expiry_last_scan_timestamp_seconds",
+			Help: "Unix time of the last successful ACM certificate expiry scan.",
+		},
+	)
+	certExpiryScanFailed = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "cert_synchronizer_acm_expiry_scan_failed",
+			Help: "1 if the latest ACM certificate expiry scan failed, 0 otherwise.",
+		},
+	)
+	certExpiryReimports = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cert_synchronizer_acm_reimports_total",
+			Help: "Number of certificate re-imports triggered by the expiry monitor.",
+		},
+		[]string{"result"},
+	)
+
+	// expiryLevels remembers the last level reported for each ARN so notifications only fire on transitions.
+	expiryLevels   = map[string]expiryLevel{}
+	expiryLevelsMu sync.Mutex
+)
+
+func init() {
+	prometheus.MustRegister(certExpiryDays, certExpiryScanErrors, certExpiryLastScan, certExpiryScanFailed,
+		certExpiryReimports)
+}
+
+func levelForDays(days int) expiryLevel {
+	switch {
+	case days <= 0:
+		return expiryLevelExpired
+	case days < env.expiryCriticalDays:
+		return expiryLevelCritical
+	case days < env.expiryWarnDays:
+		return expiryLevelWarning
+	default:
+		return expiryLevelOK
+	}
+}
+
+/*
+runExpiryMonitor scans the ACM certificates tagged with CSP_CERTIFICATE_NAME_TAG every
+CERT_EXPIRY_CHECK_INTERVAL_MINS until ctx is cancelled. A scan is run immediately on start.
+*/
+func runExpiryMonitor(ctx context.Context) {
+	interval := time.Duration(env.expiryCheckIntervalMins) * time.Minute
+	log.Infof("Starting ACM certificate expiry monitor, interval %v", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		scanCtx := ctx
+		var plan *changePlan
+		if env.dryRun {
+			scanCtx, plan = withPlan(ctx)
+		}
+
+		recordExpiryScan(scanExpiringCertificates(scanCtx))
+
+		if plan != nil {
+			plan.log()
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Infof("Stopping ACM certificate expiry monitor")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+/*
+recordExpiryScan reports the outcome of a scan in the metrics. A failed scan, usually a transient ACM error,
+is left to alerting on the metrics rather than to the health check, so it does not restart the pod.
+*/
+func recordExpiryScan(err error) {
+	if err != nil {
+		certExpiryScanErrors.Inc()
+		certExpiryScanFailed.Set(1)
+		log.Errorf("ACM certificate expiry scan failed: %v", err)
+		return
+	}
+	certExpiryLastScan.SetToCurrentTime()
+	certExpiryScanFailed.Set(0)
+}
+
+func newACMClient(ctx context.Context) (*acm.Client, error) {
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(env.region))
+	if err != nil {
+		return nil, err
+	}
+
+	if (env.awsAccessKey != "") && (env.awsSecretKey != "") {
+		cfg.Credentials = credentials.NewStaticCredentialsProvider(env.awsAccessKey, env.awsSecretKey, "")
+	}
+
+	return acm.NewFromConfig(cfg), nil
+}
+
+/*
+scanExpiringCertificates lists the ACM certificates, keeps only those whose Name tag matches
+env.acmCertificateName, and describes them with a small pool of workers. Each result updates
+the expiry gauge and is compared with the previous level to decide if a notification is due.
+*/
+func scanExpiringCertificates(ctx context.Context) error {
+	svc, err := newACMClient(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to load AWS SDK: %w", err)
+	}
+
+	arns, err := listTaggedCertificates(ctx, svc, env.acmCertificateName)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Expiry monitor found %d certificates tagged %s", len(arns), env.acmCertificateName)
+
+	jobs := make(chan string)
+	errs := make(chan error, len(arns))
+	var wg sync.WaitGroup
+
+	for i := 0; i < DEFAULT_EXPIRY_SCAN_WORKERS; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for arn := range jobs {
+				if err := checkCertificateExpiry(ctx, svc, arn); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+
+	for _, arn := range arns {
+		jobs <- arn
+	}
+	close(jobs)
+	wg.Wait()
+	close(errs)
+
+	var firstErr error
+	for err := range errs {
+		log.Errorf("Expiry check error: %v", err)
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	forgetRemovedCertificates(arns)
+
+	return firstErr
+}
+
+// listTaggedCertificates returns the ARNs of every ACM certificate whose Name tag equals name.
+func listTaggedCertificates(ctx context.Context, svc *acm.Client, name string) ([]string, error) {
+	var arns []string
+	paginator := acm.NewListCertificatesPaginator(svc, listCertificatesInput())
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error iterating ACM ListCertificatesPaginator: %w", err)
+		}
+
+		for _, certSummary := range page.CertificateSummaryList {
+			tagResult, err := svc.ListTagsForCertificate(ctx, &acm.ListTagsForCertificateInput{
+				CertificateArn: certSummary.CertificateArn,
+			})
+			if err != nil {
+				log.Errorf("failed to list tags for certificate %s: %v", aws.ToString(certSummary.CertificateArn), err)
+				continue
+			}
+
+			for _, tag := range tagResult.Tags {
+				if aws.ToString(tag.Key) == "Name" && aws.ToString(tag.Value) == name {
+					arns = append(arns, aws.ToString(certSummary.CertificateArn))
+					break
+				}
+			}
+		}
+	}
+	return arns, nil
+}
+
+func checkCertificateExpiry(ctx context.Context, svc *acm.Client, arn string) error {
+	describeOutput, err := svc.DescribeCertificate(ctx, &acm.DescribeCertificateInput{
+		CertificateArn: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe certificate %s: %w", arn, err)
+	}
+
+	certDetails := describeOutput.Certificate
+	if certDetails == nil || certDetails.NotAfter == nil {
+		return fmt.Errorf("certificate %s has no expiry date", arn)
+	}
+
+	domain := aws.ToString(certDetails.DomainName)
+	days := int(time.Until(*certDetails.NotAfter).Hours() / 24)
+	certExpiryDays.WithLabelValues(arn, domain).Set(float64(days))
+
+	level, previous, notify := recordExpiryLevel(arn, days)
+	log.Infof("Certificate %s (%s) expires in %v days, level %s", arn, domain, days, level)
+	if notify {
+		notifyExpiry(ctx, expiryNotification{
+			Source:        EXPIRY_EVENT_SOURCE,
+			CertificateId: arn,
+			Domain:        domain,
+			NotAfter:      certDetails.NotAfter.Format(time.RFC3339),
+			DaysToExpiry:  days,
+			Level:         level.String(),
+			PreviousLevel: previous.String(),
+			TimeStamp:     time.Now().Format(time.RFC3339),
+		})
+	}
+
+	// The re-import is attempted on every scan until ACM holds a renewed certificate, not only when the level
+	// changes, as the in-cluster certificate may be renewed after the certificate became critical.
+	if env.expiryAutoReimport && level >= expiryLevelCritical {
+		if err := reimportCertificate(ctx, svc, arn, *certDetails.NotAfter); err != nil {
+			certExpiryReimports.WithLabelValues("error").Inc()
+			return fmt.Errorf("re-import of certificate %s failed: %w", arn, err)
+		}
+	}
+	return nil
+}
+
+/*
+recordExpiryLevel stores the level of the certificate with the given ARN and returns it with the previously
+stored one. notify is true when the level got worse, or the first time a non-OK certificate is seen.
+*/
+func recordExpiryLevel(arn string, days int) (level expiryLevel, previous expiryLevel, notify bool) {
+	level = levelForDays(days)
+
+	expiryLevelsMu.Lock()
+	defer expiryLevelsMu.Unlock()
+	previous, seen := expiryLevels[arn]
+	expiryLevels[arn] = level
+
+	return level, previous, level != expiryLevelOK && (!seen || level > previous)
+}
+
+// forgetRemovedCertificates drops gauges and levels for ARNs that no longer carry the tag.
+func forgetRemovedCertificates(current []string) {
+	active := make(map[string]bool, len(current))
+	for _, arn := range current {
+		active[arn] = true
+	}
+
+	expiryLevelsMu.Lock()
+	defer expiryLevelsMu.Unlock()
+	for arn := range expiryLevels {
+		if !active[arn] {
+			delete(expiryLevels, arn)
+			certExpiryDays.DeletePartialMatch(prometheus.Labels{"arn": arn})
+		}
+	}
+}
+
+func notifyExpiry(ctx context.Context, n expiryNotification) {
+	msg := fmt.Sprintf("Certificate %s with ARN %s is %s, %d days to expiry", n.Domain, n.CertificateId, n.Level, n.DaysToExpiry)
+	if n.Level == expiryLevelWarning.String() {
+		log.Warnf("%s", msg)
+	} else {
+		log.Errorf("%s", msg)
+	}
+
+	if env.expiryK8sEvents {
+		if err := createExpiryEvent(ctx, n, msg); err != nil {
+			log.Errorf("Failed to create Kubernetes event for certificate %s: %v", n.CertificateId, err)
+		}
+	}
+
+	if env.expiryWebhookUrl != "" {
+		if err := postExpiryWebhook(ctx, n); err != nil {
+			log.Errorf("Failed to send expiry webhook for certificate %s: %v", n.CertificateId, err)
+		}
+	}
+}
+
+// createExpiryEvent records a Warning event against the TLS secret that cert-synchronizer manages.
+func createExpiryEvent(ctx context.Context, n expiryNotification, msg string) error {
+	dynamicClient, err := getKubernetesClient()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	eventGVR := schema.GroupVersionResource{Version: "v1", Resource: "events"}
+	event := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Event",
+			"metadata": map[string]interface{}{
+				"name":      env.k8sCertSecretName + "." + uuid.New().String()[:8],
+				"namespace": env.namespace,
+			},
+			"involvedObject": map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"name":       env.k8sCertSecretName,
+				"namespace":  env.namespace,
+			},
+			"reason":         "CertificateExpiry" + capitalize(n.Level),
+			"message":        msg,
+			"type":           "Warning",
+			"source":         map[string]interface{}{"component": EXPIRY_EVENT_SOURCE},
+			"firstTimestamp": now,
+			"lastTimestamp":  now,
+			"count":          int64(1),
+		},
+	}
+
+	_, err = dynamicClient.Resource(eventGVR).Namespace(env.namespace).Create(ctx, event, metav1.CreateOptions{})
+	return err
+}
+
+func postExpiryWebhook(ctx context.Context, n expiryNotification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, env.expiryWebhookUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(HEADER_CONTENT, JSON_CONTENT)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+/*
+reimportCertificate imports the certificate currently mounted in the pod over the ACM certificate
+with the given ARN, provided the in-cluster certificate expires later than the one in ACM.
+*/
+func reimportCertificate(ctx context.Context, svc *acm.Client, arn string, acmNotAfter time.Time) error {
+	var certs certChain
+	certs, err := readCertFiles(certs)
+	if err != nil {
+		return fmt.Errorf("failed to read certificate files: %w", err)
+	}
+
+	block, _ := pem.Decode(certs.tlsCrt)
+	if block == nil {
+		return fmt.Errorf("unable to decode PEM from %s", env.tlsCrtPath)
+	}
+	clusterCert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("unable to parse certificate from %s: %w", env.tlsCrtPath, err)
+	}
+
+	if !clusterCert.NotAfter.After(acmNotAfter) {
+		log.Warnf("In-cluster certificate expires %v, not later than ACM certificate %s (%v), skipping re-import",
+			clusterCert.NotAfter, arn, acmNotAfter)
+		certExpiryReimports.WithLabelValues("skipped").Inc()
+		return nil
+	}
+
+	input := &acm.ImportCertificateInput{
+		Certificate:    certs.tlsCrt,
+		PrivateKey:     certs.tlsKey,
+		CertificateArn: aws.String(arn),
+	}
+	if certs.caCrtChain != nil {
+		input.CertificateChain = certs.caCrtChain
+	}
+
+	if _, err := acmImportCertificate(ctx, svc, input); err != nil {
+		return err
+	}
+
+	log.Infof("Re-imported in-cluster certificate into ACM, cert ARN = %s", arn)
+	certExpiryReimports.WithLabelValues("success").Inc()
+	return nil
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return string(s[0]-'a'+'A') + s[1:]
+}
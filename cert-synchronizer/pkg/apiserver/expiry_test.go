@@ -0,0 +1,235 @@
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// withEnv restores the package configuration and the expiry monitor state when the test ends.
+func withEnv(t *testing.T) {
+	t.Helper()
+	savedEnv := env
+	t.Cleanup(func() {
+		env = savedEnv
+		expiryLevelsMu.Lock()
+		expiryLevels = map[string]expiryLevel{}
+		expiryLevelsMu.Unlock()
+		certExpiryScanFailed.Set(0)
+	})
+	env.expiryWarnDays = 30
+	env.expiryCriticalDays = 7
+}
+
+func TestLevelForDays(t *testing.T) {
+	withEnv(t)
+
+	tests := []struct {
+		days int
+		want expiryLevel
+	}{
+		{days: 90, want: expiryLevelOK},
+		{days: 30, want: expiryLevelOK},
+		{days: 29, want: expiryLevelWarning},
+		{days: 7, want: expiryLevelWarning},
+		{days: 6, want: expiryLevelCritical},
+		{days: 1, want: expiryLevelCritical},
+		{days: 0, want: expiryLevelExpired},
+		{days: -5, want: expiryLevelExpired},
+	}
+	for _, tt := range tests {
+		if got := levelForDays(tt.days); got != tt.want {
+			t.Errorf("levelForDays(%d) = %s, want %s", tt.days, got, tt.want)
+		}
+	}
+}
+
+func TestRecordExpiryLevelNotifiesOnTransitions(t *testing.T) {
+	withEnv(t)
+
+	const arn = "arn:aws:acm:us-west-2:123456789012:certificate/test"
+	steps := []struct {
+		days         int
+		wantLevel    expiryLevel
+		wantPrevious expiryLevel
+		wantNotify   bool
+	}{
+		{days: 60, wantLevel: expiryLevelOK, wantPrevious: expiryLevelOK, wantNotify: false},
+		{days: 20, wantLevel: expiryLevelWarning, wantPrevious: expiryLevelOK, wantNotify: true},
+		{days: 19, wantLevel: expiryLevelWarning, wantPrevious: expiryLevelWarning, wantNotify: false},
+		{days: 3, wantLevel: expiryLevelCritical, wantPrevious: expiryLevelWarning, wantNotify: true},
+		{days: 0, wantLevel: expiryLevelExpired, wantPrevious: expiryLevelCritical, wantNotify: true},
+		{days: 80, wantLevel: expiryLevelOK, wantPrevious: expiryLevelExpired, wantNotify: false},
+		{days: 25, wantLevel: expiryLevelWarning, wantPrevious: expiryLevelOK, wantNotify: true},
+	}
+	for i, step := range steps {
+		level, previous, notify := recordExpiryLevel(arn, step.days)
+		if level != step.wantLevel || previous != step.wantPrevious || notify != step.wantNotify {
+			t.Errorf("step %d (%d days): got level=%s previous=%s notify=%t, want level=%s previous=%s notify=%t",
+				i, step.days, level, previous, notify, step.wantLevel, step.wantPrevious, step.wantNotify)
+		}
+	}
+
+	// A certificate first seen below a threshold is reported at once.
+	if _, _, notify := recordExpiryLevel(arn+"-new", 2); !notify {
+		t.Errorf("first scan of a critical certificate did not notify")
+	}
+}
+
+func TestForgetRemovedCertificates(t *testing.T) {
+	withEnv(t)
+
+	recordExpiryLevel("kept", 3)
+	recordExpiryLevel("removed", 3)
+	forgetRemovedCertificates([]string{"kept"})
+
+	expiryLevelsMu.Lock()
+	_, kept := expiryLevels["kept"]
+	_, removed := expiryLevels["removed"]
+	expiryLevelsMu.Unlock()
+	if !kept || removed {
+		t.Errorf("after forgetting, kept=%t removed=%t, want kept=true removed=false", kept, removed)
+	}
+
+	// A certificate tagged again is treated as new and notified again.
+	if _, _, notify := recordExpiryLevel("removed", 3); !notify {
+		t.Errorf("re-tagged certificate did not notify")
+	}
+}
+
+func TestCheckCertificateExpiryReimportsOnEveryCriticalScan(t *testing.T) {
+	withEnv(t)
+	env.expiryAutoReimport = true
+	env.expiryK8sEvents = false
+	env.expiryWebhookUrl = ""
+
+	// The ACM certificate of the fake has expired, the in-cluster one is renewed after the first scan.
+	dir := t.TempDir()
+	env.tlsCrtPath = filepath.Join(dir, "tls.crt")
+	env.tlsKeyPath = filepath.Join(dir, "tls.key")
+	env.caCrtPath = filepath.Join(dir, "ca.crt")
+	writeClusterCert := func(notAfter time.Time) {
+		t.Helper()
+		if err := os.WriteFile(env.tlsCrtPath, generateCertPEM(t, "orch.example.com", notAfter), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, path := range []string{env.tlsKeyPath, env.caCrtPath} {
+		if err := os.WriteFile(path, []byte("pem"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fake, acmSvc, _ := newFakeAWSClients(t)
+
+	writeClusterCert(time.Unix(1767225600, 0))
+	if err := checkCertificateExpiry(context.Background(), acmSvc, testCertArn); err != nil {
+		t.Fatalf("first scan: %v", err)
+	}
+	if got := fake.mutations(); len(got) != 0 {
+		t.Errorf("first scan sent %v, want the re-import skipped until the in-cluster certificate is renewed", got)
+	}
+
+	writeClusterCert(time.Now().Add(90 * 24 * time.Hour))
+	if err := checkCertificateExpiry(context.Background(), acmSvc, testCertArn); err != nil {
+		t.Fatalf("second scan: %v", err)
+	}
+	if got := fake.mutations(); !reflect.DeepEqual(got, []string{ACTION_ACM_IMPORT}) {
+		t.Errorf("second scan sent %v, want the renewed certificate re-imported at the same level", got)
+	}
+}
+
+func TestHealthCheckServerIgnoresExpiryScans(t *testing.T) {
+	withEnv(t)
+
+	dir := t.TempDir()
+	for _, name := range []string{"tls.crt", "tls.key", "ca.crt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("pem"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	env.tlsCrtPath = filepath.Join(dir, "tls.crt")
+	env.tlsKeyPath = filepath.Join(dir, "tls.key")
+	env.caCrtPath = filepath.Join(dir, "ca.crt")
+
+	probe := func() int {
+		rec := httptest.NewRecorder()
+		HealthCheckServer(rec, httptest.NewRequest(http.MethodGet, "/healthcheck", nil))
+		return rec.Code
+	}
+
+	if code := probe(); code != http.StatusOK {
+		t.Errorf("before the first scan: status %d, want %d", code, http.StatusOK)
+	}
+
+	// A failed scan is reported in the metrics only, so the pod is not restarted for a transient ACM error.
+	recordExpiryScan(errors.New("ListCertificates: throttled"))
+	if code := probe(); code != http.StatusOK {
+		t.Errorf("after a failed scan: status %d, want %d", code, http.StatusOK)
+	}
+	if failed := testutil.ToFloat64(certExpiryScanFailed); failed != 1 {
+		t.Errorf("after a failed scan: scan failed metric %v, want 1", failed)
+	}
+
+	recordExpiryScan(nil)
+	if failed := testutil.ToFloat64(certExpiryScanFailed); failed != 0 {
+		t.Errorf("after a successful scan: scan failed metric %v, want 0", failed)
+	}
+
+	env.caCrtPath = filepath.Join(dir, "missing.crt")
+	if code := probe(); code != http.StatusInternalServerError {
+		t.Errorf("with a missing certificate file: status %d, want %d", code, http.StatusInternalServerError)
+	}
+}
+
+func TestPostExpiryWebhook(t *testing.T) {
+	withEnv(t)
+
+	var got expiryNotification
+	var contentType string
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get(HEADER_CONTENT)
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decoding webhook body: %v", err)
+		}
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+	env.expiryWebhookUrl = srv.URL
+
+	sent := expiryNotification{
+		Source:        EXPIRY_EVENT_SOURCE,
+		CertificateId: "arn:aws:acm:us-west-2:123456789012:certificate/test",
+		Domain:        "example.com",
+		DaysToExpiry:  5,
+		Level:         expiryLevelCritical.String(),
+		PreviousLevel: expiryLevelWarning.String(),
+	}
+	if err := postExpiryWebhook(context.Background(), sent); err != nil {
+		t.Fatalf("postExpiryWebhook: %v", err)
+	}
+	if got != sent {
+		t.Errorf("webhook received %+v, want %+v", got, sent)
+	}
+	if contentType != JSON_CONTENT {
+		t.Errorf("webhook content type %q, want %q", contentType, JSON_CONTENT)
+	}
+
+	status = http.StatusInternalServerError
+	if err := postExpiryWebhook(context.Background(), sent); err == nil {
+		t.Errorf("postExpiryWebhook succeeded on a %d response", status)
+	}
+}
@@ -39,7 +39,8 @@ import (
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"github.com/google/uuid"
 	ps "github.com/mitchellh/go-ps"
-	"r53restapi.com/pkg/buildflags"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	appconfig "r53restapi.com/pkg/config"
 	"r53restapi.com/pkg/log"
 
 	"flag"
@@ -55,30 +56,12 @@ import (
 )
 
 const (
-	DEFAULT_NAMESPACE           = "orch-gateway"
-	DEFAULT_AUTOCERT_SECRETNAME = "kubernetes-docker-internal" // kubernetes-docker-internal
-	DEFAULT_SECRETNAME          = "tls-orch"
-	DEFAULT_TLS_CERT_PATH       = "/etc/ssl/cert/cert-man/tls.crt"
-	DEFAULT_TLS_KEY_PATH        = "/etc/ssl/cert/cert-man/tls.key"
-	DEFAULT_CA_CERT_PATH        = "/etc/ssl/cert/cert-man/ca.crt"
-
-	DEBUG_TLS_CERT_PATH = "./certs/tls.crt"
-	DEBUG_TLS_KEY_PATH  = "./certs/tls.key"
-	DEBUG_CA_CERT_PATH  = "./certs/ca.crt"
-
-	DEFAULT_SLEEP_TIMEOUT = 1000 * 120
-	//DEFAULT_HTTP_TIMEOUT  = 10 * time.Second
-
 	IMPORT_COMMENT = "Imported by Intel Open Edge Platform CertSynchronizer"
 
 	//R10_URL  = "https://letsencrypt.org/certs/2024/r10.pem"
 	//R11_URL  = "https://letsencrypt.org/certs/2024/r11.pem"
 	//ROOT_URL = "https://letsencrypt.org/certs/isrgrootx1.pem"
 
-	DEFAULT_INTER1_URL = "https://letsencrypt.org/certs/2024/r10.pem"
-	DEFAULT_INTER2_URL = "https://letsencrypt.org/certs/2024/r11.pem"
-	DEFAULT_ROOT_URL   = "https://letsencrypt.org/certs/isrgrootx1.pem"
-
 	DEFAULT_INTER1_PEM = "LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCk1JSUZCVENDQXUyZ0F3SUJBZ0lRUzZoU2svZWFMNkp6Qmt1b0JJMTEwREFOQmdrcWhraUc5dzBCQVFzRkFEQlAKTVFzd0NRWURWUVFHRXdKVlV6RXBNQ2NHQTFVRUNoTWdTVzUwWlhKdVpYUWdVMlZqZFhKcGRIa2dVbVZ6WldGeQpZMmdnUjNKdmRYQXhGVEFUQmdOVkJBTVRERWxUVWtjZ1VtOXZkQ0JZTVRBZUZ3MHlOREF6TVRNd01EQXdNREJhCkZ3MHlOekF6TVRJeU16VTVOVGxhTURNeEN6QUpCZ05WQkFZVEFsVlRNUll3RkFZRFZRUUtFdzFNWlhRbmN5QkYKYm1OeWVYQjBNUXd3Q2dZRFZRUURFd05TTVRBd2dnRWlNQTBHQ1NxR1NJYjNEUUVCQVFVQUE0SUJEd0F3Z2dFSwpBb0lCQVFEUFYrWG14RlFTN2JSSC9za25XSFpHVUNpTUhUNkkzd1dkMWJVWUtiM2R0VnEvK3ZiT283NnZBQ0ZMCllscGFQQUV2eFZnRDlvbi9qaEZENjhHMTRCUUhsbzl2SDlmbnVvRTVDWFZsdDhLdkdGczNKaWpuby9RSEsyMGEKLzZ0WXZKV3VRUC9weTFmRXRWdC9lQTBZWWJ3WDUxVEd1MG1Selc0WTBZQ0Y3cVpsTnJ4MDZyeFFUT3I4SWZNNApGcE9VdXJEVGF6Z0d6UllTZXNwU2RjaXRkckxDbkYyWVJWeHZZWHZHTGU0OEUxS0dBZGxYNWpnYzM0MjFINUtSCm11ZEtITXhGcUhKVjhMRG1vd2ZzL2FjYlpwNC9TSXR4aEhGWXlUcjY3MTd5VzBRclBIVG5qN0pId1FkcXpacTMKRFpiM0VvRW1VVlFLN0dIMjkvWGk4b3JJbFEyTkFnTUJBQUdqZ2Znd2dmVXdEZ1lEVlIwUEFRSC9CQVFEQWdHRwpNQjBHQTFVZEpRUVdNQlFHQ0NzR0FRVUZCd01DQmdnckJnRUZCUWNEQVRBU0JnTlZIUk1CQWY4RUNEQUdBUUgvCkFnRUFNQjBHQTFVZERnUVdCQlM3dk1OSHBlUzhxY2JEcEhJTUVJMmlOZUhJNkRBZkJnTlZIU01FR0RBV2dCUjUKdEZubWU3Ymw1QUZ6Z0FpSXlCcFk5dW1iYmpBeUJnZ3JCZ0VGQlFjQkFRUW1NQ1F3SWdZSUt3WUJCUVVITUFLRwpGbWgwZEhBNkx5OTRNUzVwTG14bGJtTnlMbTl5Wnk4d0V3WURWUjBnQkF3d0NqQUlCZ1puZ1F3QkFnRXdKd1lEClZSMGZCQ0F3SGpBY29CcWdHSVlXYUhSMGNEb3ZMM2d4TG1NdWJHVnVZM0l1YjNKbkx6QU5CZ2txaGtpRzl3MEIKQVFzRkFBT0NBZ0VBa3JIblFUZnJlWjJCNXMzaUplRTZJT21RUkpXamdWelB3MTM5dmFCdzFiR1dLQ0lMMHZJbwp6d3puMU9aRGpDUWlIY0ZDa3RFSnI1OUw5TWh3VHlBV3NWcmRBZllmK0I5aGF4UW5zSEtOWTY3dTRzNUx6emZkCnU2UFV6ZWV0VUsyOXYrUHNQbUkyY0preHAraU4zZXBpNGhLdTlaelVQU3dNcXRDY2ViN3FQVnhFYnBZeFkxcDkKMW41UEpLQkxCWDllYjlMVTZsOHpTeFBXVjdiSzNsRzRYYU1KZ25UOXgzaWVzN21zRnRwS0s1YkR0b3Rpai9sMApHYUtlQTk3cGI1dXdEOUtnV3ZhRlhNSUV0OGpWVGpMRXZ3UmR2Q24yOTRHUERGMDhVOGxBa0l2N3RnaGx1YVFoCjFRbmxFNFNFTjRMT0VDajhkc0lHSlhwR1VrM2FVM0trSno5aWNLeSthVWdBKzJjUDIxdWg2TmNESVMzWHlmYVoKUWptRFE5OTNDaElJOFNYV3VwUVpWQmlJcGNXTzRScVprM2xyN0J6NU1VQ3d6RElBMzU5ZTU3U1NxNUNDa1kwTgo0QjZWdWxrN0xrdGZ3cmRHTlZJNUJzQzlxcXhTd1NLZ1JKZVo5d3lnSWFlaGJIRkhGaGNCYU1ES3BpWmxCSHl6CnJzbm5sRlhDYjVzOEhLbjVMc1VnR3ZCMjRMN3NHTlpQMkNYN2RoSG92K1loRCtqb3pMVzJwOVc0OTU5QnoyRWkKUm1xRHRtaVhMbnpxVHBYYkkrc3V5Q3NvaEtSZzZVbjBSQzQ3K2NwaVZ3SGlYWkFXK2NuOGVpTklqcWJWZ1hMeApLUHBkenZ2dFRuT1BsQzdTUVpTWW1kdW5yM0JmOWI3N0FpQy9aaWRzdEszNmRSSUxLejdPQTU0PQotLS0tLUVORCBDRVJUSUZJQ0FURS0tLS0tCg=="
 	DEFAULT_INTER2_PEM = "LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCk1JSUZCakNDQXU2Z0F3SUJBZ0lSQUlwOVBoUFdMekR2STRhOUtRZHJOUGd3RFFZSktvWklodmNOQVFFTEJRQXcKVHpFTE1Ba0dBMVVFQmhNQ1ZWTXhLVEFuQmdOVkJBb1RJRWx1ZEdWeWJtVjBJRk5sWTNWeWFYUjVJRkpsYzJWaApjbU5vSUVkeWIzVndNUlV3RXdZRFZRUURFd3hKVTFKSElGSnZiM1FnV0RFd0hoY05NalF3TXpFek1EQXdNREF3CldoY05NamN3TXpFeU1qTTFPVFU1V2pBek1Rc3dDUVlEVlFRR0V3SlZVekVXTUJRR0ExVUVDaE1OVEdWMEozTWcKUlc1amNubHdkREVNTUFvR0ExVUVBeE1EVWpFeE1JSUJJakFOQmdrcWhraUc5dzBCQVFFRkFBT0NBUThBTUlJQgpDZ0tDQVFFQXVvZThYQnNBT2N2S0NzM1VaeEQ1QVR5bFRxVmh5eWJLVXZzVkFiZTVLUFVvSHUwbnN5UVlPV2NKCkRBanM0RHF3TzNjT3ZmUGxPVlJCREU2dVFkYVpkTjVSMis5Ny8xaTlxTGNUOXQ0eDFmSnl5WEpxQzROMGxaeEcKQUdRVW1mT3gyU0xaemFpU3Fod21lai8rNzFnRmV3aVZnZHR4RDQ3NzR6RUp1d20rVUUxZmo1RjJQVnFkbm9QeQo2Y1JtcytFR1prTklHSUJsb0RjWW1wdUVNcGV4c3IzRStCVUFuU2VJKytKakY1WnNteWRuUzhUYktGNXB3bm53ClNWemdKRkRoeEx5aEJheDdRRzBBdE1KQlA2ZFl1Qy9GWEp1bHV3bWU4Zjdyc0lVNS9hZ0s3MFhFZU90bEtzTFAKWHp6ZTQxeE5HL2NMSnl1cUMwSjNVMDk1YWgySDJRSURBUUFCbzRINE1JSDFNQTRHQTFVZER3RUIvd1FFQXdJQgpoakFkQmdOVkhTVUVGakFVQmdnckJnRUZCUWNEQWdZSUt3WUJCUVVIQXdFd0VnWURWUjBUQVFIL0JBZ3dCZ0VCCi93SUJBREFkQmdOVkhRNEVGZ1FVeGM5R3BPcjB3OEI2YkpYRUxiQmVraThtNDdrd0h3WURWUjBqQkJnd0ZvQVUKZWJSWjVudTI1ZVFCYzRBSWlNZ2FXUGJwbTI0d01nWUlLd1lCQlFVSEFRRUVKakFrTUNJR0NDc0dBUVVGQnpBQwpoaFpvZEhSd09pOHZlREV1YVM1c1pXNWpjaTV2Y21jdk1CTUdBMVVkSUFRTU1Bb3dDQVlHWjRFTUFRSUJNQ2NHCkExVWRId1FnTUI0d0hLQWFvQmlHRm1oMGRIQTZMeTk0TVM1akxteGxibU55TG05eVp5OHdEUVlKS29aSWh2Y04KQVFFTEJRQURnZ0lCQUU3aWlWMEtBeHlRT05EMUgvbHhYUGpEajdJM2lIcHZzQ1VmN2I2MzJJWUdqdWtKaE0xeQp2NEh6L01yUFUwanR2ZlpwUXRTbEVUNDF5Qk95a2gwRlgrb3UxTmo0U2NPdDlabVduTzhtMk9HMEpBdElJRTM4CjAxUzBxY1loeU9FMkcvOTNaQ2tYdWZCTDcxM3F6WG5RdjVDL3ZpT3lrTnBLcVVneGRLbEVDK0hpOWkyRGNhUjEKZTlLVXdRVVpSaHk1ai9QRWRFZ2xLZzNsOWR0RDR0dVRtN2tadEI4djMyb09qekhUWXcrN0tkemRaaXcvc0J0bgpVZmhCUE9STnVheTRwSnhtWS9XcmhTTWR6Rk8ycTNHdTNNVUJjZG8yN2dvWUtqTDlDVEY4ai9aejU1eWN0VW9WCmFuZUNXcy9halVYK0h5cGtCVEErYzhMR0RMbldPMk5LcTBZRC9wbkFSa0FuWUdQZlVEb0hSOWdWU3AvcVJ4K1oKV2doaURMWnNNd2hOMXpqdFNDMHVCV2l1Z0YzdlROellJRUZmYVBHN1dzM2pEckFNTVllYlE5NUpRK0hJQkQvUgpQQnVIUlRCcHFLbHlEbmtTSERIWVBpTlgzYWRQb1BBY2dkRjNIMi9XMHJtb3N3TVdnVGxMbjFXdTBtcmtzNy9xCnBkV2ZTNlBKMWp0eTgwcjJWS3NNL0RqM1lJRGZialhLZGFGVTVDKzhiaGZKR3FVM3RhS2F1dXowd0hWR1QzZW8KNkZsV2tXWXRidDRwZ2RhbWx3VmVaRVcrTE03cVpFSkVzTU5QcmZDMDNBUEttWnNKZ3BXQ0RXT0tadmtaY3ZqVgp1WWtRNG9tWUNUWDVvaHkra25NamRPbWRIOWM3U3BxRVdCREM4NmZpTmV4K08wWE9NRVpTYThEQQotLS0tLUVORCBDRVJUSUZJQ0FURS0tLS0tCg=="
 	DEFAULT_ROOT_PEM   = "LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCk1JSUZhekNDQTFPZ0F3SUJBZ0lSQUlJUXo3RFNRT05aUkdQZ3UyT0Npd0F3RFFZSktvWklodmNOQVFFTEJRQXcKVHpFTE1Ba0dBMVVFQmhNQ1ZWTXhLVEFuQmdOVkJBb1RJRWx1ZEdWeWJtVjBJRk5sWTNWeWFYUjVJRkpsYzJWaApjbU5vSUVkeWIzVndNUlV3RXdZRFZRUURFd3hKVTFKSElGSnZiM1FnV0RFd0hoY05NVFV3TmpBME1URXdORE00CldoY05NelV3TmpBME1URXdORE00V2pCUE1Rc3dDUVlEVlFRR0V3SlZVekVwTUNjR0ExVUVDaE1nU1c1MFpYSnUKWlhRZ1UyVmpkWEpwZEhrZ1VtVnpaV0Z5WTJnZ1IzSnZkWEF4RlRBVEJnTlZCQU1UREVsVFVrY2dVbTl2ZENCWQpNVENDQWlJd0RRWUpLb1pJaHZjTkFRRUJCUUFEZ2dJUEFEQ0NBZ29DZ2dJQkFLM29KSFAwRkRmem01NHJWeWdjCmg3N2N0OTg0a0l4dVBPWlhvSGozZGNLaS92VnFidllBVHlqYjNtaUdiRVNUdHJGai9SUVNhNzhmMHVveG15RisKMFRNOHVrajEzWG5mczdqL0V2RWhta3ZCaW9aeGFVcG1abXlQZmp4d3Y2MHBJZ2J6NU1EbWdLN2lTNCszbVg2VQpBNS9UUjVkOG1VZ2pVK2c0cms4S2I0TXUwVWxYaklCMHR0b3YwRGlOZXdOd0lSdDE4akE4K28rdTNkcGpxK3NXClQ4S09FVXQrend2by83VjNMdlN5ZTByZ1RCSWxESENOQXltZzRWTWs3QlBaN2htL0VMTktqRCtKbzJGUjNxeUgKQjVUMFkzSHNMdUp2VzVpQjRZbGNOSGxzZHU4N2tHSjU1dHVrbWk4bXhkQVE0UTdlMlJDT0Z2dTM5NmozeCtVQwpCNWlQTmdpVjUrSTNsZzAyZFo3N0RuS3hIWnU4QS9sSkJkaUIzUVcwS3RaQjZhd0JkcFVLRDlqZjFiMFNIelV2CktCZHMwcGpCcUFsa2QyNUhON3JPckZsZWFKMS9jdGFKeFFaQktUNVpQdDBtOVNUSkVhZGFvMHhBSDBhaG1iV24KT2xGdWhqdWVmWEtuRWdWNFdlMCtVWGdWQ3dPUGpkQXZCYkkrZTBvY1MzTUZFdnpHNnVCUUUzeERrM1N6eW5UbgpqaDhCQ05BdzFGdHhOclFIdXNFd01GeEl0NEk3bUtaOVlJcWlveW1DekxxOWd3UWJvb01EUWFIV0JmRWJ3cmJ3CnFIeUdPMGFvU0NxSTNIYWFkcjhmYXFVOUdZL3JPUE5rM3NnckRRb28vL2ZiNGhWQzFDTFFKMTNoZWY0WTUzQ0kKclU3bTJZczZ4dDBuVVc3L3ZHVDFNME5QQWdNQkFBR2pRakJBTUE0R0ExVWREd0VCL3dRRUF3SUJCakFQQmdOVgpIUk1CQWY4RUJUQURBUUgvTUIwR0ExVWREZ1FXQkJSNXRGbm1lN2JsNUFGemdBaUl5QnBZOXVtYmJqQU5CZ2txCmhraUc5dzBCQVFzRkFBT0NBZ0VBVlI5WXFieXlxRkRRRExIWUdta2dKeWtJckdGMVhJcHUrSUxsYVMvVjlsWkwKdWJoekVGblRJWmQrNTB4eCs3TFNZSzA1cUF2cUZ5RldoZkZRRGxucnp1Qlo2YnJKRmUrR25ZK0VnUGJrNlpHUQozQmViWWh0RjhHYVYwbnh2d3VvNzd4L1B5OWF1Si9HcHNNaXUvWDErbXZvaUJPdi8yWC9xa1NzaXNSY09qL0tLCk5GdFkyUHdCeVZTNXVDYk1pb2d6aVV3dGhEeUMzKzZXVndXNkxMdjN4TGZIVGp1Q3ZqSElJbk56a3RIQ2dLUTUKT1JBekk0Sk1QSitHc2xXWUhiNHBob3dpbTU3aWF6dFhPb0p3VGR3Sng0bkxDZ2ROYk9oZGpzbnZ6cXZIdTdVcgpUa1hXU3RBbXpPVnl5Z2hxcFpYakZhSDNwTzNKTEYrbCsvK3NLQUl1dnRkN3UrTnhlNUFXMHdkZVJsTjhOd2RDCmpOUEVscHpWbWJVcTRKVWFnRWl1VERrSHpzeEhwRktWSzdxNCs2M1NNMU45NVIxTmJkV2hzY2RDYitaQUp6VmMKb3lpM0I0M25qVE9RNXlPZisxQ2NlV3hHMWJRVnM1WnVmcHNNbGpxNFVpMC8xbHZoK3dqQ2hQNGtxS09KMnF4cQo0Umdxc2FoRFlWdlRIOXc3alhieUxlaU5kZDhYTTJ3OVUvdDd5MEZmLzl5aTBHRTQ0WmE0ckYyTE45ZDExVFBBCm1SR3VuVUhCY25XRXZnSkJRbDluSkVpVTBac252Z2MvdWJoUGdYUlI0WHEzN1owajRyN2cxU2dFRXp3eEE1N2QKZW15UHhnY1l4bi9lUjQ0L0tKNEVCcytsVkRSM3ZleUptK2tYUTk5YjIxLytqaDVYb3MxQW5YNWlJdHJlR0NjPQotLS0tLUVORCBDRVJUSUZJQ0FURS0tLS0t"
@@ -115,6 +98,7 @@ var (
 type ServerConfig struct {
 	VersionInfo string
 	HttpPort    string
+	App         appconfig.Config
 }
 
 type JsonResponse struct {
@@ -176,6 +160,20 @@ type envVars struct {
 	inter1CertUrl             string
 	inter2CertUrl             string
 	rootCertUrl               string
+	expiryCheckIntervalMins   int
+	expiryWarnDays            int
+	expiryCriticalDays        int
+	expiryWebhookUrl          string
+	expiryK8sEvents           bool
+	expiryAutoReimport        bool
+	disableExpiryMonitor      bool
+	dryRun                    bool
+	legacyApiEnabled          bool
+	apiAuthMode               string
+	apiRoleBindings           map[string][]string
+	apiJwtPublicKeyFile       string
+	apiJwtIssuer              string
+	apiJwtAudience            string
 }
 
 type CertEvent struct {
@@ -286,7 +284,14 @@ func Init(cfg ServerConfig) error {
 	)
 
 	flag.Parse()
-	getEnvVars()
+
+	if err := cfg.App.Validate(); err != nil {
+		log.Errorf("Configuration validation error: %v", err)
+		return err
+	}
+	log.Infof("Effective configuration:\n%s", cfg.App.YAML())
+
+	applyConfig(cfg.App)
 	checkCertFiles(true, true)
 	if env.debugMode {
 		log.Infof("Debug mode=true")
@@ -298,35 +303,23 @@ func Init(cfg ServerConfig) error {
 		return err
 	}
 
-	mux := http.NewServeMux()
-	mux.HandleFunc("/updatecert", UpdateCert)
-	mux.HandleFunc("/updatecert/", UpdateCert)
-
-	mux.HandleFunc("/forceupdatecert", UpdateCertWithoutChecks)
-	mux.HandleFunc("/forceupdatecert/", UpdateCertWithoutChecks)
-
-	mux.HandleFunc("/deletecert", DeleteCert)
-	mux.HandleFunc("/deletecert/", DeleteCert)
-
-	mux.HandleFunc("/forcedeletecert", DeleteCertWithoutChecks)
-	mux.HandleFunc("/forcedeletecert/", DeleteCertWithoutChecks)
+	if err := initAuth(); err != nil {
+		log.Errorf("API authentication config error: %v", err)
+		return err
+	}
 
-	mux.HandleFunc("/creatednsrecord", HTTPCreateDNSRecord)
-	mux.HandleFunc("/creatednsrecord/", HTTPCreateDNSRecord)
+	mux := http.NewServeMux()
+	registerV1Routes(mux)
 
-	mux.HandleFunc("/deletednsrecord", HTTPDeleteDNSRecord)
-	mux.HandleFunc("/deletednsrecord/", HTTPDeleteDNSRecord)
+	if env.legacyApiEnabled {
+		log.Warnf("Legacy unauthenticated API paths are enabled")
+		registerLegacyRoutes(mux)
+	}
 
 	mux.HandleFunc("/healthcheck", HealthCheckServer)
 	mux.HandleFunc("/healthcheck/", HealthCheckServer)
 
-	mux.HandleFunc("/debug", POSTDebug)
-	mux.HandleFunc("/debug/", POSTDebug)
-
-	if buildflags.DEBUG {
-		mux.HandleFunc("/debugv1", GetDebug)
-		mux.HandleFunc("/debugv1/", GetDebug)
-	}
+	mux.Handle("/metrics", promhttp.Handler())
 
 	server = http.Server{
 		Addr:    ":" + cfg.HttpPort,
@@ -349,6 +342,12 @@ func Run() error {
 
 	doInititalCertUpdate() //Assume we need to an initial cert upload after cert-manager and botkube have started.
 
+	monitorCtx, stopMonitor := context.WithCancel(context.Background())
+	defer stopMonitor()
+	if !env.disableExpiryMonitor {
+		go runExpiryMonitor(monitorCtx)
+	}
+
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 	var err error
@@ -540,8 +539,9 @@ func haveCertFilesUpdated(checkCA bool) bool {
 }
 
 /*
-HealthCheckProcesses is used for kubernetes liveness/startup probes.
-Returns either a 200 or 418 if dependent processes are found or not in the pod.
+HealthCheckServer is used for kubernetes liveness/startup probes.
+Returns a 500 if the certificate files are missing from the pod. The probe makes no AWS calls: the
+expiry monitor reports failed scans in the cert_synchronizer_acm_expiry_scan_failed metric.
 */
 func HealthCheckServer(w http.ResponseWriter, r *http.Request) {
 	var (
@@ -552,37 +552,12 @@ func HealthCheckServer(w http.ResponseWriter, r *http.Request) {
 
 	if checkCertFiles(true, false) == false {
 		msg := "Required certificate files are missing from pod"
-		log.Errorf(msg)
+		log.Errorf("%s", msg)
 		httpResponse{acceptedContent: accContent, status: http.StatusInternalServerError, message: msg}.write(w)
 		return
 	}
 
-	// Load the custom AWS configuration with the provided credentials
-	cfg, err := config.LoadDefaultConfig(context.TODO(),
-		config.WithRegion(env.region),
-	)
-
-	if err != nil {
-		msg := "Unable to load AWS SDK"
-		log.Errorf(msg+", %v", err)
-		httpResponse{acceptedContent: accContent, status: http.StatusInternalServerError, message: msg}.write(w)
-		return
-	}
-
-	if (env.awsAccessKey != "") && (env.awsSecretKey != "") {
-		cfg.Credentials = credentials.NewStaticCredentialsProvider(env.awsAccessKey, env.awsSecretKey, "")
-	}
-
-	// Create an ACM client
-	svc := acm.NewFromConfig(cfg)
-
-	err2 := checkExpiringCertificates(svc, 30)
-	if err2 == nil {
-		httpResponse{acceptedContent: accContent, status: http.StatusOK, message: MSG_200_OK}.write(w)
-	} else {
-		log.Errorf("Returning 418. Kubernetes will restart the pod.")
-		httpResponse{acceptedContent: accContent, status: http.StatusTeapot, message: MSG_418_TEAPOT}.write(w)
-	}
+	httpResponse{acceptedContent: accContent, status: http.StatusOK, message: MSG_200_OK}.write(w)
 
 	log.Infof("HealthCheckServer()--------------->end")
 }
@@ -595,119 +570,59 @@ func boolToString(input bool) string {
 	return retval
 }
 
-func getEnvVars() {
-
-	log.Infof("Read Env vars--->start")
-
-	debugStr := strings.ToLower(os.Getenv("DEBUG"))
-	env.debugMode = false
-	if debugStr == "y" || debugStr == "yes" || debugStr == "t" || debugStr == "true" {
-		env.debugMode = true
-	}
-
-	env.namespace = os.Getenv("SECRET_NAMESPACE")
-	if env.namespace == "" {
-		env.namespace = DEFAULT_NAMESPACE
-	}
-
-	env.autoCertName = os.Getenv("AUTOCERT_CERTSECRET_NAME")
-	if env.autoCertName == "" {
-		env.autoCertName = DEFAULT_AUTOCERT_SECRETNAME
-	}
-
-	// Retrieve AWS credentials from environment variables
-	env.awsAccessKey = os.Getenv("AWS_ACCESS_KEY_ID")
-	env.awsSecretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
-
-	env.region = os.Getenv("AWS_REGION")
-	if env.region == "" {
-		env.region = "us-west-2"
-	}
-
-	env.vpc = os.Getenv("AWS_VPC")
-
-	env.domain = strings.ToLower(os.Getenv("AWS_R53_DOMAIN"))
-	if env.domain == "" {
-		env.domain = "espdqa.infra-host.com"
-	}
-
-	env.acmCertificateName = os.Getenv("CSP_CERTIFICATE_NAME_TAG")
-
-	// Define the certificate name (tag value)
-	if env.acmCertificateName == "" {
-		env.acmCertificateName = "ACM_Certificate_Importer"
-	}
-
-	env.certificateNameSpace = os.Getenv("K8S_CERTIFICATE_NAMESPACE")
-	// Define the certificate namespace (tag value)
-	if env.certificateNameSpace == "" {
-		env.certificateNameSpace = "orch-gateway"
-	}
-
-	env.k8sCertSecretName = os.Getenv("K8S_CERT_SECRET_NAME")
-	if env.k8sCertSecretName == "" {
-		env.k8sCertSecretName = DEFAULT_SECRETNAME
-	}
-
-	envStr := strings.ToLower(os.Getenv("CREATE_K8S_CERT_SECRET"))
-	env.createK8sCertSecret = false
-	if envStr == "y" || envStr == "yes" || envStr == "t" || envStr == "true" || envStr == "1" {
-		env.createK8sCertSecret = true
-	}
-
-	if env.debugMode {
-		//env.tlsCrtPath = DEBUG_TLS_CERT_PATH
-		//env.tlsKeyPath = DEBUG_TLS_KEY_PATH
-		//env.caCrtPath = DEBUG_CA_CERT_PATH
-
-		env.tlsCrtPath = os.Getenv("CERTIFICATE_FILE")
-		if env.tlsCrtPath == "" {
-			env.tlsCrtPath = DEBUG_TLS_CERT_PATH
-		}
-
-		env.tlsKeyPath = os.Getenv("PRIVATE_KEY_FILE")
-		if env.tlsKeyPath == "" {
-			env.tlsKeyPath = DEBUG_TLS_KEY_PATH
-		}
-		env.caCrtPath = os.Getenv("CA_CERTIFICATE_FILE")
-		if env.caCrtPath == "" {
-			env.caCrtPath = DEBUG_CA_CERT_PATH
-		}
-
-	} else {
-		env.tlsCrtPath = os.Getenv("CERTIFICATE_FILE")
-		if env.tlsCrtPath == "" {
-			env.tlsCrtPath = DEFAULT_TLS_CERT_PATH
-		}
-
-		env.tlsKeyPath = os.Getenv("PRIVATE_KEY_FILE")
-		if env.tlsKeyPath == "" {
-			env.tlsKeyPath = DEFAULT_TLS_KEY_PATH
-		}
-		env.caCrtPath = os.Getenv("CA_CERTIFICATE_FILE")
-		if env.caCrtPath == "" {
-			env.caCrtPath = DEFAULT_CA_CERT_PATH
-		}
-	}
+/*
+applyConfig copies the validated configuration into the package state used by the handlers
+and fetches the intermediate and root certificates, falling back to the built-in PEMs.
+*/
+func applyConfig(cfg appconfig.Config) {
+
+	log.Infof("Apply config--->start")
+
+	env.debugMode = cfg.Debug
+	env.namespace = cfg.SecretNamespace
+	env.autoCertName = cfg.Certificate.AutoCertSecretName
+
+	env.awsAccessKey = cfg.AWS.AccessKeyID
+	env.awsSecretKey = cfg.AWS.SecretAccessKey
+	env.region = cfg.AWS.Region
+	env.vpc = cfg.AWS.VPC
+	env.domain = cfg.AWS.R53Domain
+
+	env.acmCertificateName = cfg.Certificate.ACMCertificateName
+	env.certificateNameSpace = cfg.Certificate.K8sCertificateNamespace
+	env.k8sCertSecretName = cfg.Certificate.K8sCertSecretName
+	env.createK8sCertSecret = cfg.Certificate.CreateK8sCertSecret
+	env.tlsCrtPath = cfg.Certificate.TLSCertPath
+	env.tlsKeyPath = cfg.Certificate.TLSKeyPath
+	env.caCrtPath = cfg.Certificate.CACertPath
+	env.inter1CertUrl = cfg.Certificate.Intermediate1URL
+	env.inter2CertUrl = cfg.Certificate.Intermediate2URL
+	env.rootCertUrl = cfg.Certificate.RootURL
+	env.disableCertMatchChecks = cfg.Certificate.DisableCertMatchChecks
+	env.importIntoACMIfNotExists = cfg.Certificate.ACMImportIfNotExists
+	env.podFileUpdateSleepTimeout = cfg.Certificate.PodFileUpdateTimeoutSecs * 1000 //convert to millis
+
+	env.disableExpiryMonitor = cfg.ExpiryMonitor.Disabled
+	env.expiryCheckIntervalMins = cfg.ExpiryMonitor.CheckIntervalMins
+	env.expiryWarnDays = cfg.ExpiryMonitor.WarnDays
+	env.expiryCriticalDays = cfg.ExpiryMonitor.CriticalDays
+	env.expiryK8sEvents = cfg.ExpiryMonitor.K8sEvents
+	env.expiryWebhookUrl = cfg.ExpiryMonitor.WebhookURL
+	env.expiryAutoReimport = cfg.ExpiryMonitor.AutoReimport
+
+	env.dryRun = cfg.DryRun
+
+	env.legacyApiEnabled = cfg.API.LegacyEnabled
+	env.apiAuthMode = cfg.API.AuthMode
+	env.apiRoleBindings = cfg.API.RoleBindings
+	env.apiJwtPublicKeyFile = cfg.API.JWTPublicKeyFile
+	env.apiJwtIssuer = cfg.API.JWTIssuer
+	env.apiJwtAudience = cfg.API.JWTAudience
 
 	log.Debugf("env.tlsCrtPath = %s", env.tlsCrtPath)
 	log.Debugf("env.tlsKeyPath = %s", env.tlsKeyPath)
 	log.Debugf("env.caCrtPath = %s", env.caCrtPath)
 
-	env.inter1CertUrl = os.Getenv("INTERMEDIATE1_CERT_URL")
-	if (len(env.inter1CertUrl) == 0) || env.inter1CertUrl == "" {
-		env.inter1CertUrl = DEFAULT_INTER1_URL
-	}
-	env.inter2CertUrl = os.Getenv("INTERMEDIATE2_CERT_URL")
-	if (len(env.inter2CertUrl) == 0) || env.inter2CertUrl == "" {
-		env.inter2CertUrl = DEFAULT_INTER2_URL
-	}
-
-	env.rootCertUrl = os.Getenv("ROOT_CERT_URL")
-	if (len(env.rootCertUrl) == 0) || env.rootCertUrl == "" {
-		env.rootCertUrl = DEFAULT_ROOT_URL
-	}
-
 	inter1PEM, err := httpGetCertPEM(env.inter1CertUrl)
 	if err != nil {
 		env.inter1Cert, err = b64.StdEncoding.DecodeString(DEFAULT_INTER1_PEM) //UTF-8
@@ -782,31 +697,7 @@ func getEnvVars() {
 
 	log.Debugf("Root cert ENV PEM : %s", env.rootCert)
 
-	sleepStr := os.Getenv("POD_FILE_UPDATE_TIMEOUT_SECS")
-	if len(sleepStr) > 0 {
-		podFileUpdateSleepTimeout, err := strconv.Atoi(sleepStr)
-		if err != nil {
-			env.podFileUpdateSleepTimeout = DEFAULT_SLEEP_TIMEOUT
-		} else {
-			env.podFileUpdateSleepTimeout = podFileUpdateSleepTimeout * 1000 //convert to millis
-		}
-	} else {
-		env.podFileUpdateSleepTimeout = DEFAULT_SLEEP_TIMEOUT
-	}
-
-	env.disableCertMatchChecks = false
-	dmc := strings.ToLower(os.Getenv("DISABLE_CERT_MATCH_CHECKS"))
-	if (dmc == "1") || (dmc == "true") || (dmc == "t") || (dmc == "y") || (dmc == "yes") {
-		env.disableCertMatchChecks = true
-	}
-
-	env.importIntoACMIfNotExists = true
-	acmImp := strings.ToLower(os.Getenv("ACM_IMPORT_IF_NOT_EXISTS"))
-	if (acmImp == "0") || (acmImp == "false") || (acmImp == "f") || (acmImp == "n") || (acmImp == "no") {
-		env.importIntoACMIfNotExists = false
-	}
-
-	log.Infof("Read Env vars--->end")
+	log.Infof("Apply config--->end")
 }
 
 func (lp DNSRecordParams) validate() error {
@@ -820,6 +711,7 @@ func (lp DNSRecordParams) validate() error {
 }
 
 func HTTPCreateDNSRecord(w http.ResponseWriter, r *http.Request) {
+	var ctx = r.Context()
 	var accContent = strings.ToLower(r.Header.Get(HEADER_ACCEPT))
 	var params DNSRecordParams
 	var dnsrecords []DNSRecordParams
@@ -915,7 +807,7 @@ func HTTPCreateDNSRecord(w http.ResponseWriter, r *http.Request) {
 
 		svc := route53.NewFromConfig(cfg)
 		log.Infof("records: %v", dnsrecords)
-		createSingleDNSRecord(svc, w, accContent, dnsrecords[0])
+		createSingleDNSRecord(ctx, svc, w, accContent, dnsrecords[0])
 	} else {
 		var invalidStrings strings.Builder
 		var responseStrings strings.Builder
@@ -954,7 +846,7 @@ func HTTPCreateDNSRecord(w http.ResponseWriter, r *http.Request) {
 				continue //Skip malformed records
 			}
 
-			result, err2 := createDNSRecord(svc, record)
+			result, err2 := createDNSRecord(ctx, svc, record)
 			if err2 != nil {
 				errorcount++
 				errorStrings.WriteString(result)
@@ -1064,7 +956,7 @@ func readCertFiles(cert certChain) (certChain, error) {
 
 }
 
-func createK8sCertificateSecret(cert certChain) error {
+func createK8sCertificateSecret(ctx context.Context, cert certChain) error {
 
 	/* 	if env.debugMode {
 	   		log.Infof("tls.crt : " + string(cert.tlsCrt))
@@ -1080,7 +972,6 @@ func createK8sCertificateSecret(cert certChain) error {
 		return err
 	}
 
-	secretGVR := schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
 	secret := &unstructured.Unstructured{
 		Object: map[string]interface{}{
 			"apiVersion": "v1",
@@ -1099,7 +990,7 @@ func createK8sCertificateSecret(cert certChain) error {
 	}
 
 	// Create the secret in Kubernetes
-	_, err = dynamicClient.Resource(secretGVR).Namespace(env.namespace).Apply(context.TODO(), env.k8sCertSecretName, secret, metav1.ApplyOptions{FieldManager: "application/apply-patch", Force: true})
+	err = k8sApplySecret(ctx, dynamicClient, secret, cert)
 
 	if err != nil {
 		log.Errorf("Error creating secret: %s", err.Error())
@@ -1199,7 +1090,7 @@ func getKubernetesClient() (*dynamic.DynamicClient, error) {
 	}
 	return dynamicClient, err
 }
-func createSingleDNSRecord(svc *route53.Client, w http.ResponseWriter, accContent string, params DNSRecordParams) {
+func createSingleDNSRecord(ctx context.Context, svc *route53.Client, w http.ResponseWriter, accContent string, params DNSRecordParams) {
 
 	var vpc string
 	var region string
@@ -1220,7 +1111,7 @@ func createSingleDNSRecord(svc *route53.Client, w http.ResponseWriter, accConten
 	log.Infof("vpc : " + vpc)
 
 	// Check if the hosted zone exists, if not create it
-	hostedZoneID, err := getOrCreateHostedZone(svc, params.Domain, vpc, region, params.IsPrivate, true)
+	hostedZoneID, err := getOrCreateHostedZone(ctx, svc, params.Domain, vpc, region, params.IsPrivate, true)
 	if err != nil {
 		msg := "Failed to get or create Route53 hosted zone for domain : " + params.Domain
 		log.Errorf(msg+", err: %v", err)
@@ -1241,7 +1132,7 @@ func createSingleDNSRecord(svc *route53.Client, w http.ResponseWriter, accConten
 	if existingDNSRecord != nil {
 		// Update the existing DNS record
 		log.Infof("Updating existing DNS " + params.Recordtype + " record " + params.fqdn + " with value " + params.Recordvalue)
-		err = updateRoute53Record(svc, hostedZoneID, params.fqdn, params.Recordtype, params.Recordvalue)
+		err = updateRoute53Record(ctx, svc, hostedZoneID, params.fqdn, params.Recordtype, params.Recordvalue)
 		if err != nil {
 			msg := "Failed to update Route53 DNS " + params.Recordtype + " type record " + params.fqdn + " for hosted zone : " + hostedZoneID
 			log.Errorf(msg+", err: %v", err)
@@ -1254,7 +1145,7 @@ func createSingleDNSRecord(svc *route53.Client, w http.ResponseWriter, accConten
 	} else {
 		// Create the DNS record
 		log.Infof("Importing new DNS " + params.Recordtype + " record " + params.fqdn + " with value " + params.Recordvalue)
-		err = createRoute53Record(svc, hostedZoneID, params.Recordname+params.Domain, params.Recordtype, params.Recordvalue)
+		err = createRoute53Record(ctx, svc, hostedZoneID, params.Recordname+params.Domain, params.Recordtype, params.Recordvalue)
 		if err != nil {
 			msg := "Failed to insert Route53 DNS record " + params.Recordtype + " type record " + params.fqdn + " for hosted zone : " + hostedZoneID
 			log.Errorf(msg+", err: %v", err)
@@ -1267,7 +1158,7 @@ func createSingleDNSRecord(svc *route53.Client, w http.ResponseWriter, accConten
 	}
 }
 
-func createDNSRecord(svc *route53.Client, params DNSRecordParams) (string, error) {
+func createDNSRecord(ctx context.Context, svc *route53.Client, params DNSRecordParams) (string, error) {
 	var retval = ""
 	var vpc string
 	var region string
@@ -1284,7 +1175,7 @@ func createDNSRecord(svc *route53.Client, params DNSRecordParams) (string, error
 	}
 
 	// Check if the hosted zone exists, if not create it
-	hostedZoneID, err := getOrCreateHostedZone(svc, params.Domain, vpc, region, params.IsPrivate, true)
+	hostedZoneID, err := getOrCreateHostedZone(ctx, svc, params.Domain, vpc, region, params.IsPrivate, true)
 	if err != nil {
 		msg := "Failed to get or create Route53 hosted zone for domain : " + params.Domain
 		log.Errorf(msg+", err: %v", err)
@@ -1305,7 +1196,7 @@ func createDNSRecord(svc *route53.Client, params DNSRecordParams) (string, error
 	if existingDNSRecord != nil {
 		// Update the existing DNS record
 		log.Infof("Updating existing DNS " + params.Recordtype + " record " + params.fqdn + " with value " + params.Recordvalue)
-		err = updateRoute53Record(svc, hostedZoneID, params.fqdn, params.Recordtype, params.Recordvalue)
+		err = updateRoute53Record(ctx, svc, hostedZoneID, params.fqdn, params.Recordtype, params.Recordvalue)
 		if err != nil {
 			msg := "Failed to update Route53 DNS " + params.Recordtype + " type record " + params.fqdn + " for hosted zone : " + hostedZoneID
 			log.Errorf(msg+", err: %v", err)
@@ -1319,7 +1210,7 @@ func createDNSRecord(svc *route53.Client, params DNSRecordParams) (string, error
 	} else {
 		// Create the DNS record
 		log.Infof("Importing new DNS " + params.Recordtype + " record " + params.fqdn + " with value " + params.Recordvalue)
-		err = createRoute53Record(svc, hostedZoneID, params.Recordname+params.Domain, params.Recordtype, params.Recordvalue)
+		err = createRoute53Record(ctx, svc, hostedZoneID, params.Recordname+params.Domain, params.Recordtype, params.Recordvalue)
 		if err != nil {
 			msg := "Failed to insert Route53 DNS record " + params.Recordtype + " type record " + params.fqdn + " for hosted zone : " + hostedZoneID
 			log.Errorf(msg+", err: %v", err)
@@ -1334,9 +1225,9 @@ func createDNSRecord(svc *route53.Client, params DNSRecordParams) (string, error
 	return retval, nil
 }
 
-func deleteSingleDNSRecord(svc *route53.Client, w http.ResponseWriter, accContent string, params DNSRecordParams) {
+func deleteSingleDNSRecord(ctx context.Context, svc *route53.Client, w http.ResponseWriter, accContent string, params DNSRecordParams) {
 	// Check if the hosted zone exists, if not create it
-	hostedZoneID, err := getOrCreateHostedZone(svc, params.Domain, "", "", params.IsPrivate, false)
+	hostedZoneID, err := getOrCreateHostedZone(ctx, svc, params.Domain, "", "", params.IsPrivate, false)
 	if err != nil {
 		msg := "Failed to get or create Route53 hosted zone for domain : " + params.Domain
 		log.Errorf(msg+", err: %v", err)
@@ -1358,7 +1249,7 @@ func deleteSingleDNSRecord(svc *route53.Client, w http.ResponseWriter, accConten
 		// Update the existing DNS record
 		log.Infof("Deleting existing DNS record " + params.fqdn + " in hosted zone " + hostedZoneID)
 		// Delete the DNS record
-		err = deleteRoute53Record(svc, hostedZoneID, params.fqdn, params.Recordtype)
+		err = deleteRoute53Record(ctx, svc, hostedZoneID, params.fqdn, params.Recordtype)
 		if err != nil {
 			msg := "Failed to delete Route53 DNS " + params.Recordtype + " type record " + params.fqdn + " for hosted zone : " + hostedZoneID
 			log.Errorf(msg+", err: %v", err)
@@ -1375,9 +1266,9 @@ func deleteSingleDNSRecord(svc *route53.Client, w http.ResponseWriter, accConten
 	}
 }
 
-func deleteDNSRecord(svc *route53.Client, params DNSRecordParams) (string, error) {
+func deleteDNSRecord(ctx context.Context, svc *route53.Client, params DNSRecordParams) (string, error) {
 	// Check if the hosted zone exists, if not create it
-	hostedZoneID, err := getOrCreateHostedZone(svc, params.Domain, "", "", params.IsPrivate, false)
+	hostedZoneID, err := getOrCreateHostedZone(ctx, svc, params.Domain, "", "", params.IsPrivate, false)
 	if err != nil {
 		msg := "Failed to get or create Route53 hosted zone for domain : " + params.Domain
 		log.Errorf(msg+", err: %v", err)
@@ -1400,7 +1291,7 @@ func deleteDNSRecord(svc *route53.Client, params DNSRecordParams) (string, error
 		// Update the existing DNS record
 		log.Infof("Deleting existing DNS record " + params.fqdn + " in hosted zone " + hostedZoneID)
 		// Delete the DNS record
-		err = deleteRoute53Record(svc, hostedZoneID, params.fqdn, params.Recordtype)
+		err = deleteRoute53Record(ctx, svc, hostedZoneID, params.fqdn, params.Recordtype)
 		if err != nil {
 			msg := "Failed to delete Route53 DNS " + params.Recordtype + " type record " + params.fqdn + " for hosted zone : " + hostedZoneID
 			log.Errorf(msg+", err: %v", err)
@@ -1420,6 +1311,7 @@ func deleteDNSRecord(svc *route53.Client, params DNSRecordParams) (string, error
 }
 
 func HTTPDeleteDNSRecord(w http.ResponseWriter, r *http.Request) {
+	var ctx = r.Context()
 	var accContent = strings.ToLower(r.Header.Get(HEADER_ACCEPT))
 	var params DNSRecordParams
 	var dnsrecords []DNSRecordParams
@@ -1517,7 +1409,7 @@ func HTTPDeleteDNSRecord(w http.ResponseWriter, r *http.Request) {
 
 		svc := route53.NewFromConfig(cfg)
 		log.Infof("records: %v", dnsrecords)
-		deleteSingleDNSRecord(svc, w, accContent, dnsrecords[0])
+		deleteSingleDNSRecord(ctx, svc, w, accContent, dnsrecords[0])
 	} else {
 		var invalidStrings strings.Builder
 		var responseStrings strings.Builder
@@ -1563,7 +1455,7 @@ func HTTPDeleteDNSRecord(w http.ResponseWriter, r *http.Request) {
 			//Route53RecordName := "test." + domain
 			//Route53RecordType := "A"
 			//Route53RecordValue := "192.0.2.44"
-			result, err2 := deleteDNSRecord(svc, record)
+			result, err2 := deleteDNSRecord(ctx, svc, record)
 			if err2 != nil {
 				errorcount++
 				errorStrings.WriteString(result)
@@ -1582,6 +1474,7 @@ func HTTPDeleteDNSRecord(w http.ResponseWriter, r *http.Request) {
 }
 
 func UpdateCert(w http.ResponseWriter, r *http.Request) {
+	var ctx = r.Context()
 	var accContent = strings.ToLower(r.Header.Get(HEADER_ACCEPT))
 
 	log.Debugf("Response content type: |%s|\n", accContent)
@@ -1641,7 +1534,7 @@ func UpdateCert(w http.ResponseWriter, r *http.Request) {
 					timeOutExceeded := false
 					sleepTime := 0
 					log.Infof("Waiting for cert secret files to update in pod....")
-					for (!haveCertFilesUpdated(true)) && (!timeOutExceeded) {
+					for !isDryRun(ctx) && (!haveCertFilesUpdated(true)) && (!timeOutExceeded) {
 						time.Sleep(time.Duration(sleepInterval) * time.Millisecond)
 						sleepTime += sleepInterval
 						w.WriteHeader(http.StatusProcessing) //Send feedback to botKube
@@ -1664,7 +1557,7 @@ func UpdateCert(w http.ResponseWriter, r *http.Request) {
 					}
 
 					if env.createK8sCertSecret {
-						createK8sCertificateSecret(certs)
+						createK8sCertificateSecret(ctx, certs)
 					}
 
 					// Load the custom AWS configuration with the provided credentials
@@ -1714,7 +1607,7 @@ func UpdateCert(w http.ResponseWriter, r *http.Request) {
 							input.CertificateChain = certs.caCrtChain
 						}
 
-						result, err := svc.ImportCertificate(context.TODO(), input)
+						result, err := acmImportCertificate(ctx, svc, input)
 						if err != nil {
 							msg := "Failed to import certificate"
 							log.Errorf(msg+", err: %v", err)
@@ -1738,7 +1631,7 @@ func UpdateCert(w http.ResponseWriter, r *http.Request) {
 								input.CertificateChain = certs.caCrtChain
 							}
 
-							result, err := svc.ImportCertificate(context.TODO(), input)
+							result, err := acmImportCertificate(ctx, svc, input)
 							if err != nil {
 								msg := "Failed to import certificate"
 								log.Errorf(msg+", err: %v", err)
@@ -1767,7 +1660,7 @@ func UpdateCert(w http.ResponseWriter, r *http.Request) {
 								},
 							}
 
-							_, err = svc.AddTagsToCertificate(context.TODO(), tagInput)
+							err = acmAddTagsToCertificate(ctx, svc, tagInput)
 							if err != nil {
 								msg := "Failed to add tags to certificate"
 								log.Errorf(msg+", err: %v", err)
@@ -1814,6 +1707,7 @@ func UpdateCert(w http.ResponseWriter, r *http.Request) {
 }
 
 func DeleteCert(w http.ResponseWriter, r *http.Request) {
+	var ctx = r.Context()
 	var accContent = r.Header.Get(HEADER_ACCEPT)
 
 	log.Debugf("Response content type: |%s|\n", accContent)
@@ -1912,7 +1806,7 @@ func DeleteCert(w http.ResponseWriter, r *http.Request) {
 						CertificateArn: aws.String(certArn),
 					}
 
-					_, err = svc.DeleteCertificate(context.TODO(), input)
+					err = acmDeleteCertificate(ctx, svc, input)
 					if err != nil {
 						msg := "An error occurred trying to delete existing certificate"
 						log.Errorf(msg+", err: %v", err)
@@ -1970,6 +1864,12 @@ func httpGetCertPEM(requestURL string) (string, error) {
 }
 
 func doInititalCertUpdate() {
+	var ctx = context.Background()
+	if env.dryRun {
+		var plan *changePlan
+		ctx, plan = withPlan(ctx)
+		defer plan.log()
+	}
 
 	log.Debugf("AWS Region: %s\n", env.region)
 	log.Debugf("K8 Certificate Namespace: %s\n", env.certificateNameSpace)
@@ -1987,7 +1887,7 @@ func doInititalCertUpdate() {
 	}
 
 	if env.createK8sCertSecret {
-		createK8sCertificateSecret(certs)
+		createK8sCertificateSecret(ctx, certs)
 	}
 
 	//https://aws.github.io/aws-sdk-go-v2/docs/configuring-sdk/#static-credentials
@@ -2038,7 +1938,7 @@ func doInititalCertUpdate() {
 		}
 
 		//JOL should be able to remove all code up to here and use certs instead of certChain
-		result, err := svc.ImportCertificate(context.TODO(), input)
+		result, err := acmImportCertificate(ctx, svc, input)
 		if err != nil {
 			msg := "Failed to import certificate"
 			log.Errorf(msg+", err: %v", err)
@@ -2058,7 +1958,7 @@ func doInititalCertUpdate() {
 			input.CertificateChain = certs.caCrtChain
 		}
 
-		result, err := svc.ImportCertificate(context.TODO(), input)
+		result, err := acmImportCertificate(ctx, svc, input)
 		if err != nil {
 			msg := "Failed to import certificate"
 			log.Errorf(msg+", err: %v", err)
@@ -2081,7 +1981,7 @@ func doInititalCertUpdate() {
 			},
 		}
 
-		_, err = svc.AddTagsToCertificate(context.TODO(), tagInput)
+		err = acmAddTagsToCertificate(ctx, svc, tagInput)
 		if err != nil {
 			msg := "Failed to add tags to certificate"
 			log.Errorf(msg+", err: %v", err)
@@ -2093,6 +1993,7 @@ func doInititalCertUpdate() {
 }
 
 func UpdateCertWithoutChecks(w http.ResponseWriter, r *http.Request) {
+	var ctx = r.Context()
 	var accContent = strings.ToLower(r.Header.Get(HEADER_ACCEPT))
 
 	log.Debugf("Response content type: |%s|\n", accContent)
@@ -2110,7 +2011,7 @@ func UpdateCertWithoutChecks(w http.ResponseWriter, r *http.Request) {
 	timeOutExceeded := false
 	sleepTime := 0
 	log.Infof("Waiting for cert secret files to update in pod....")
-	for (!haveCertFilesUpdated(true)) && (!timeOutExceeded) {
+	for !isDryRun(ctx) && (!haveCertFilesUpdated(true)) && (!timeOutExceeded) {
 		time.Sleep(time.Duration(sleepInterval) * time.Millisecond)
 		sleepTime += sleepInterval
 		w.WriteHeader(http.StatusProcessing) //Send feedback to botKube
@@ -2181,7 +2082,7 @@ func UpdateCertWithoutChecks(w http.ResponseWriter, r *http.Request) {
 			input.CertificateChain = certs.caCrtChain
 		}
 
-		result, err := svc.ImportCertificate(context.TODO(), input)
+		result, err := acmImportCertificate(ctx, svc, input)
 		if err != nil {
 			msg := "Failed to import certificate"
 			log.Errorf(msg+", err: %v", err)
@@ -2205,7 +2106,7 @@ func UpdateCertWithoutChecks(w http.ResponseWriter, r *http.Request) {
 				input.CertificateChain = certs.caCrtChain
 			}
 
-			result, err := svc.ImportCertificate(context.TODO(), input)
+			result, err := acmImportCertificate(ctx, svc, input)
 			if err != nil {
 				msg := "Failed to import certificate"
 				log.Errorf(msg+", err: %v", err)
@@ -2234,7 +2135,7 @@ func UpdateCertWithoutChecks(w http.ResponseWriter, r *http.Request) {
 				},
 			}
 
-			_, err = svc.AddTagsToCertificate(context.TODO(), tagInput)
+			err = acmAddTagsToCertificate(ctx, svc, tagInput)
 			if err != nil {
 				msg := "Failed to add tags to certificate"
 				log.Errorf(msg+", err: %v", err)
@@ -2256,7 +2157,7 @@ func UpdateCertWithoutChecks(w http.ResponseWriter, r *http.Request) {
 }
 
 func DeleteCertWithoutChecks(w http.ResponseWriter, r *http.Request) {
-
+	var ctx = r.Context()
 	var accContent = r.Header.Get(HEADER_ACCEPT)
 
 	log.Debugf("Response content type: |%s|\n", accContent)
@@ -2315,7 +2216,7 @@ func DeleteCertWithoutChecks(w http.ResponseWriter, r *http.Request) {
 		CertificateArn: aws.String(certArn),
 	}
 
-	_, err = svc.DeleteCertificate(context.TODO(), input)
+	err = acmDeleteCertificate(ctx, svc, input)
 	if err != nil {
 		msg := "An error occurred trying to delete existing certificate"
 		log.Errorf(msg+", err: %v", err)
@@ -2329,7 +2230,7 @@ func DeleteCertWithoutChecks(w http.ResponseWriter, r *http.Request) {
 	httpResponse{acceptedContent: accContent, status: http.StatusOK, message: msg}.write(w)
 }
 
-func getOrCreateHostedZone(svc *route53.Client, domain string, vpc string, region string, isPrivate bool, createZone bool) (string, error) {
+func getOrCreateHostedZone(ctx context.Context, svc *route53.Client, domain string, vpc string, region string, isPrivate bool, createZone bool) (string, error) {
 	// List hosted zones and check if the domain exists
 
 	listZonesInput := &route53.ListHostedZonesByNameInput{
@@ -2364,15 +2265,15 @@ func getOrCreateHostedZone(svc *route53.Client, domain string, vpc string, regio
 			createZoneInput.HostedZoneConfig = &route53types.HostedZoneConfig{PrivateZone: true}
 		}
 
-		createZoneOutput, err := svc.CreateHostedZone(context.TODO(), createZoneInput)
+		hostedZoneID, err := r53CreateHostedZone(ctx, svc, createZoneInput)
 		if err != nil {
 			log.Errorf("Error creating Hosted Zone %s", err)
 			return "", err
 		}
 
-		log.Infof("Created hosted zone %s for %s, private zone=%s", *createZoneOutput.HostedZone.Id, domain, boolToString(isPrivate))
+		log.Infof("Created hosted zone %s for %s, private zone=%s", hostedZoneID, domain, boolToString(isPrivate))
 
-		return *createZoneOutput.HostedZone.Id, nil
+		return hostedZoneID, nil
 	}
 	return "", fmt.Errorf("Unable to find or create hosted zone")
 }
@@ -2383,6 +2284,10 @@ func getRoute53Record(svc *route53.Client, hostedZoneID, fqdn string, recordType
 		return nil, fmt.Errorf("Required parameters missing.")
 	}
 
+	if strings.HasPrefix(hostedZoneID, DRY_RUN_ZONE_PREFIX) {
+		return nil, nil // zone only exists in a dry-run plan
+	}
+
 	input := &route53.ListResourceRecordSetsInput{
 		HostedZoneId:    aws.String(hostedZoneID),
 		StartRecordName: aws.String(strings.ToLower(fqdn)),
@@ -2412,7 +2317,7 @@ func getRoute53Record(svc *route53.Client, hostedZoneID, fqdn string, recordType
 	return nil, nil
 }
 
-func createRoute53Record(svc *route53.Client, hostedZoneID, recordName, recordType string, recordValue string) error {
+func createRoute53Record(ctx context.Context, svc *route53.Client, hostedZoneID, recordName, recordType string, recordValue string) error {
 	input := &route53.ChangeResourceRecordSetsInput{
 		HostedZoneId: aws.String(hostedZoneID),
 		ChangeBatch: &route53types.ChangeBatch{
@@ -2435,11 +2340,10 @@ func createRoute53Record(svc *route53.Client, hostedZoneID, recordName, recordTy
 		},
 	}
 
-	_, err := svc.ChangeResourceRecordSets(context.TODO(), input)
-	return err
+	return r53ChangeResourceRecordSets(ctx, svc, input)
 }
 
-func updateRoute53Record(svc *route53.Client, hostedZoneID, recordName, recordType string, newValue string) error {
+func updateRoute53Record(ctx context.Context, svc *route53.Client, hostedZoneID, recordName, recordType string, newValue string) error {
 	input := &route53.ChangeResourceRecordSetsInput{
 		HostedZoneId: aws.String(hostedZoneID),
 		ChangeBatch: &route53types.ChangeBatch{
@@ -2462,11 +2366,10 @@ func updateRoute53Record(svc *route53.Client, hostedZoneID, recordName, recordTy
 		},
 	}
 
-	_, err := svc.ChangeResourceRecordSets(context.TODO(), input)
-	return err
+	return r53ChangeResourceRecordSets(ctx, svc, input)
 }
 
-func deleteRoute53Record(svc *route53.Client, hostedZoneID, recordName string, recordType string) error {
+func deleteRoute53Record(ctx context.Context, svc *route53.Client, hostedZoneID, recordName string, recordType string) error {
 	existingRecord, err := getRoute53Record(svc, hostedZoneID, recordName, recordType)
 	if err != nil {
 		return err
@@ -2498,8 +2401,7 @@ func deleteRoute53Record(svc *route53.Client, hostedZoneID, recordName string, r
 		},
 	}
 
-	_, err = svc.ChangeResourceRecordSets(context.TODO(), input)
-	return err
+	return r53ChangeResourceRecordSets(ctx, svc, input)
 }
 
 func POSTDebug(w http.ResponseWriter, r *http.Request) {
@@ -2644,9 +2546,9 @@ func GetDebug(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// findCertificateByName searches for a certificate with a specific name tag and ECDSA 256 key type
-func findCertificateByName(svc *acm.Client, name string) (string, error) {
-	input := &acm.ListCertificatesInput{
+// listCertificatesInput lists the ACM certificates of every status and key type that cert-synchronizer imports.
+func listCertificatesInput() *acm.ListCertificatesInput {
+	return &acm.ListCertificatesInput{
 		CertificateStatuses: []types.CertificateStatus{
 			types.CertificateStatusIssued,
 			types.CertificateStatusInactive,
@@ -2664,13 +2566,17 @@ func findCertificateByName(svc *acm.Client, name string) (string, error) {
 			},
 		},
 	}
+}
+
+// findCertificateByName searches for a certificate with a specific name tag and ECDSA 256 key type
+func findCertificateByName(svc *acm.Client, name string) (string, error) {
 	var certArn string
 
 	//now := time.Now()
 	//fmt.Println(now.UnixMilli())
 
 	log.Debugf("Send request to AWS for cert list")
-	paginator := acm.NewListCertificatesPaginator(svc, input)
+	paginator := acm.NewListCertificatesPaginator(svc, listCertificatesInput())
 	log.Debugf("Got response from AWS for cert list")
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(context.TODO())
@@ -2740,105 +2646,8 @@ func findCertificateByArn(svc *acm.Client, arn string) (*types.CertificateDetail
 	return result.Certificate, nil
 }
 
-func checkExpiringCertificates(svc *acm.Client, threshold int) error {
-	var retVal string = ""
-	input := &acm.ListCertificatesInput{
-		CertificateStatuses: []types.CertificateStatus{
-			types.CertificateStatusIssued,
-			types.CertificateStatusInactive,
-			types.CertificateStatusExpired,
-		},
-		Includes: &types.Filters{
-			KeyTypes: []types.KeyAlgorithm{
-				types.KeyAlgorithmEcPrime256v1,
-				types.KeyAlgorithmEcSecp384r1, // https://docs.aws.amazon.com/acm/latest/userguide/acm-certificate.html
-				types.KeyAlgorithmEcSecp521r1,
-				types.KeyAlgorithmRsa1024,
-				types.KeyAlgorithmRsa2048, // Include other key types if needed
-				types.KeyAlgorithmRsa3072,
-				types.KeyAlgorithmRsa4096,
-			},
-		},
-	}
-
-	//now := time.Now()
-	//fmt.Println(now.UnixMilli())
-
-	log.Infof("checkExpiringCertificates()------>start")
-
-	log.Debugf("Send request to AWS for cert list")
-	paginator := acm.NewListCertificatesPaginator(svc, input)
-	log.Debugf("Got response from AWS for cert list")
-	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.TODO())
-		if err != nil {
-			log.Errorf("Error iterating ACM ListCertificatesPaginator")
-			return err
-		}
-
-		log.Infof("Processing page with %d certificates", len(page.CertificateSummaryList))
-
-		for _, certSummary := range page.CertificateSummaryList {
-			log.Infof("Found certificate ARN: %s", aws.ToString(certSummary.CertificateArn))
-
-			// Describe the certificate to get its details
-			describeInput := &acm.DescribeCertificateInput{
-				CertificateArn: certSummary.CertificateArn,
-			}
-
-			describeOutput, err := svc.DescribeCertificate(context.TODO(), describeInput)
-			if err != nil {
-				log.Errorf("Failed to describe certificate: %v", err)
-				return err
-			}
-
-			// Check the key type of the certificate
-			//_ = describeOutput.Certificate
-			certDetails := describeOutput.Certificate
-
-			expiryDays := int(certDetails.NotAfter.Sub(time.Now()).Hours() / 24)
-			log.Infof("Certificate expires in %v days, warning threshold is %v days.", expiryDays, threshold)
-			if expiryDays < threshold {
-				//Report that the cert is within the expiry threshold
-				domain := certDetails.DomainName
-				arn := certDetails.CertificateArn
-
-				t := time.Now()
-				time := (t.Format(time.RFC3339))
-				level := "WARN"
-
-				if expiryDays > 1 {
-					level = "WARN"
-					retVal = "Certficate :" + *domain + " with ARN " + *arn + " is about to expire"
-					retVal = `{
-						"level": "` + level + `",
-						"event": "auditmessage",
-						"time": "` + time + `",
-						"caller": "ACM Certificate Healthcheck",
-						"message": "` + retVal + `"
-					}`
-					log.Warnf(retVal)
-				} else {
-					level = "ERROR"
-					retVal = "Certficate :" + *domain + " with ARN " + *arn + " has expired!!"
-					retVal = `{
-						"level": "` + level + `",
-						"event": "auditmessage",
-						"time": "` + time + `",
-						"caller": "ACM Certificate Healthcheck",
-						"message": "` + retVal + `"
-					}`
-					log.Errorf(retVal)
-				}
-			}
-		}
-	}
-	log.Infof("checkExpiringCertificates()------>end")
-	return nil
-}
-
 // deleteCertificateByName deletes a certificate by its name tag if it exists
-func deleteCertificateByName(svc *acm.Client, name string) error {
+func deleteCertificateByName(ctx context.Context, svc *acm.Client, name string) error {
 	certArn, err := findCertificateByName(svc, name)
 	if err != nil {
 		return err
@@ -2853,7 +2662,7 @@ func deleteCertificateByName(svc *acm.Client, name string) error {
 		CertificateArn: aws.String(certArn),
 	}
 
-	_, err = svc.DeleteCertificate(context.TODO(), input)
+	err = acmDeleteCertificate(ctx, svc, input)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"r53restapi.com/pkg/buildflags"
+	"r53restapi.com/pkg/log"
+)
+
+const (
+	API_V1_PREFIX = "/v1"
+	YAML_CONTENT  = "application/yaml"
+)
+
+//go:embed openapi.yaml
+var openAPISpec []byte
+
+// ApiError is the body returned by every /v1 endpoint on failure.
+type ApiError struct {
+	Error ApiErrorDetail `json:"error"`
+}
+
+type ApiErrorDetail struct {
+	Code      int    `json:"code"`
+	Status    string `json:"status"`
+	Message   string `json:"message"`
+	RequestId string `json:"requestId"`
+}
+
+// writeV1Response sends a buffered legacy handler response, rewriting errors into an ApiError.
+func writeV1Response(w *bufferedResponseWriter) {
+	if w.statusCode() >= http.StatusBadRequest {
+		writeApiError(w.ResponseWriter, w.statusCode(), w.message())
+		return
+	}
+
+	w.ResponseWriter.Header().Set(HEADER_CONTENT, JSON_CONTENT)
+	w.passThrough()
+}
+
+func writeApiError(w http.ResponseWriter, status int, message string) {
+	body, err := json.Marshal(ApiError{Error: ApiErrorDetail{
+		Code:      status,
+		Status:    http.StatusText(status),
+		Message:   message,
+		RequestId: uuid.New().String(),
+	}})
+	if err != nil {
+		log.Errorf("Error marshalling JSON error response: %v", err)
+		http.Error(w, message, status)
+		return
+	}
+
+	w.Header().Set(HEADER_CONTENT, JSON_CONTENT)
+	w.WriteHeader(status)
+	if _, err = w.Write(body); err != nil {
+		log.Errorf("Error writing response body: %v", err)
+	}
+}
+
+/*
+v1Handler authenticates the caller, checks that it holds role, and then runs the legacy handler
+with a JSON Accept header and the HTTP method it expects. An empty role skips authentication.
+*/
+func v1Handler(role string, legacyMethod string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if role != "" {
+			p, err := authenticate(r)
+			if err != nil {
+				log.Warnf("Rejected %s %s: %v", r.Method, r.URL.Path, err)
+				writeApiError(w, http.StatusUnauthorized, "Authentication required")
+				return
+			}
+			if !p.hasRole(role) {
+				log.Warnf("Rejected %s %s for %s: missing role %s", r.Method, r.URL.Path, p.name, role)
+				writeApiError(w, http.StatusForbidden, "Role "+role+" is required")
+				return
+			}
+			log.Infof("Authorized %s %s for %s", r.Method, r.URL.Path, p.name)
+		}
+
+		r.Header.Set(HEADER_ACCEPT, JSON_CONTENT)
+		if legacyMethod != "" {
+			r.Method = legacyMethod
+		}
+
+		rw := &bufferedResponseWriter{ResponseWriter: w}
+		handler(rw, r)
+		writeV1Response(rw)
+	}
+}
+
+func GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set(HEADER_CONTENT, YAML_CONTENT)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(openAPISpec); err != nil {
+		log.Errorf("Error writing response body: %v", err)
+	}
+}
+
+// registerV1Routes adds the versioned, authenticated API to mux.
+func registerV1Routes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+API_V1_PREFIX+"/openapi.yaml", GetOpenAPISpec)
+	mux.HandleFunc("GET "+API_V1_PREFIX+"/healthcheck", v1Handler("", "", HealthCheckServer))
+
+	mux.HandleFunc("POST "+API_V1_PREFIX+"/certificates/events", v1Handler(ROLE_CERT_ADMIN, http.MethodPost, dryRunHandler(UpdateCert)))
+	mux.HandleFunc("POST "+API_V1_PREFIX+"/certificates/delete-events", v1Handler(ROLE_CERT_ADMIN, http.MethodPost, dryRunHandler(DeleteCert)))
+	mux.HandleFunc("PUT "+API_V1_PREFIX+"/certificates", v1Handler(ROLE_CERT_ADMIN, http.MethodPost, dryRunHandler(UpdateCertWithoutChecks)))
+	mux.HandleFunc("DELETE "+API_V1_PREFIX+"/certificates", v1Handler(ROLE_CERT_ADMIN, http.MethodPost, dryRunHandler(DeleteCertWithoutChecks)))
+
+	mux.HandleFunc("POST "+API_V1_PREFIX+"/dnsrecords", v1Handler(ROLE_DNS_ADMIN, http.MethodPost, dryRunHandler(HTTPCreateDNSRecord)))
+	mux.HandleFunc("DELETE "+API_V1_PREFIX+"/dnsrecords", v1Handler(ROLE_DNS_ADMIN, http.MethodPost, dryRunHandler(HTTPDeleteDNSRecord)))
+
+	mux.HandleFunc("POST "+API_V1_PREFIX+"/debug", v1Handler(ROLE_DEBUG, http.MethodPost, POSTDebug))
+	if buildflags.DEBUG {
+		mux.HandleFunc("GET "+API_V1_PREFIX+"/debug/reply", v1Handler(ROLE_DEBUG, http.MethodGet, GetDebug))
+	}
+}
+
+// registerLegacyRoutes adds the original unauthenticated paths, kept for LEGACY_API_ENABLED.
+func registerLegacyRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/updatecert", dryRunHandler(UpdateCert))
+	mux.HandleFunc("/updatecert/", dryRunHandler(UpdateCert))
+
+	mux.HandleFunc("/forceupdatecert", dryRunHandler(UpdateCertWithoutChecks))
+	mux.HandleFunc("/forceupdatecert/", dryRunHandler(UpdateCertWithoutChecks))
+
+	mux.HandleFunc("/deletecert", dryRunHandler(DeleteCert))
+	mux.HandleFunc("/deletecert/", dryRunHandler(DeleteCert))
+
+	mux.HandleFunc("/forcedeletecert", dryRunHandler(DeleteCertWithoutChecks))
+	mux.HandleFunc("/forcedeletecert/", dryRunHandler(DeleteCertWithoutChecks))
+
+	mux.HandleFunc("/creatednsrecord", dryRunHandler(HTTPCreateDNSRecord))
+	mux.HandleFunc("/creatednsrecord/", dryRunHandler(HTTPCreateDNSRecord))
+
+	mux.HandleFunc("/deletednsrecord", dryRunHandler(HTTPDeleteDNSRecord))
+	mux.HandleFunc("/deletednsrecord/", dryRunHandler(HTTPDeleteDNSRecord))
+
+	mux.HandleFunc("/debug", POSTDebug)
+	mux.HandleFunc("/debug/", POSTDebug)
+
+	if buildflags.DEBUG {
+		mux.HandleFunc("/debugv1", GetDebug)
+		mux.HandleFunc("/debugv1/", GetDebug)
+	}
+}
@@ -0,0 +1,345 @@
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	DEFAULT_NAMESPACE           = "orch-gateway"
+	DEFAULT_AUTOCERT_SECRETNAME = "kubernetes-docker-internal"
+	DEFAULT_SECRETNAME          = "tls-orch"
+	DEFAULT_REGION              = "us-west-2"
+	DEFAULT_R53_DOMAIN          = "espdqa.infra-host.com"
+	DEFAULT_ACM_CERT_NAME       = "ACM_Certificate_Importer"
+	DEFAULT_TLS_CERT_PATH       = "/etc/ssl/cert/cert-man/tls.crt"
+	DEFAULT_TLS_KEY_PATH        = "/etc/ssl/cert/cert-man/tls.key"
+	DEFAULT_CA_CERT_PATH        = "/etc/ssl/cert/cert-man/ca.crt"
+
+	DEBUG_TLS_CERT_PATH = "./certs/tls.crt"
+	DEBUG_TLS_KEY_PATH  = "./certs/tls.key"
+	DEBUG_CA_CERT_PATH  = "./certs/ca.crt"
+
+	DEFAULT_INTER1_URL = "https://letsencrypt.org/certs/2024/r10.pem"
+	DEFAULT_INTER2_URL = "https://letsencrypt.org/certs/2024/r11.pem"
+	DEFAULT_ROOT_URL   = "https://letsencrypt.org/certs/isrgrootx1.pem"
+
+	DEFAULT_POD_FILE_UPDATE_TIMEOUT_SECS = 120
+
+	DEFAULT_EXPIRY_CHECK_INTERVAL_MINS = 60
+	DEFAULT_EXPIRY_WARN_DAYS           = 30
+	DEFAULT_EXPIRY_CRITICAL_DAYS       = 7
+
+	AUTH_MODE_SERVICEACCOUNT = "serviceaccount"
+	AUTH_MODE_JWT            = "jwt"
+	AUTH_MODE_NONE           = "none"
+
+	REDACTED = "<redacted>"
+)
+
+type Config struct {
+	Debug           bool              `json:"debug"`
+	DryRun          bool              `json:"dryRun"`
+	SecretNamespace string            `json:"secretNamespace"`
+	AWS             AWSConfig         `json:"aws"`
+	Certificate     CertificateConfig `json:"certificate"`
+	ExpiryMonitor   ExpiryConfig      `json:"expiryMonitor"`
+	API             APIConfig         `json:"api"`
+}
+
+type AWSConfig struct {
+	Region          string `json:"region"`
+	AccessKeyID     string `json:"accessKeyId,omitempty"`
+	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+	VPC             string `json:"vpc,omitempty"`
+	R53Domain       string `json:"r53Domain"`
+}
+
+type CertificateConfig struct {
+	AutoCertSecretName       string `json:"autoCertSecretName"`
+	ACMCertificateName       string `json:"acmCertificateName"`
+	K8sCertificateNamespace  string `json:"k8sCertificateNamespace"`
+	K8sCertSecretName        string `json:"k8sCertSecretName"`
+	CreateK8sCertSecret      bool   `json:"createK8sCertSecret"`
+	TLSCertPath              string `json:"tlsCertPath"`
+	TLSKeyPath               string `json:"tlsKeyPath"`
+	CACertPath               string `json:"caCertPath"`
+	Intermediate1URL         string `json:"intermediate1Url"`
+	Intermediate2URL         string `json:"intermediate2Url"`
+	RootURL                  string `json:"rootUrl"`
+	DisableCertMatchChecks   bool   `json:"disableCertMatchChecks"`
+	ACMImportIfNotExists     bool   `json:"acmImportIfNotExists"`
+	PodFileUpdateTimeoutSecs int    `json:"podFileUpdateTimeoutSecs"`
+}
+
+type ExpiryConfig struct {
+	Disabled          bool   `json:"disabled"`
+	CheckIntervalMins int    `json:"checkIntervalMins"`
+	WarnDays          int    `json:"warnDays"`
+	CriticalDays      int    `json:"criticalDays"`
+	K8sEvents         bool   `json:"k8sEvents"`
+	WebhookURL        string `json:"webhookUrl,omitempty"`
+	AutoReimport      bool   `json:"autoReimport"`
+}
+
+type APIConfig struct {
+	LegacyEnabled    bool                `json:"legacyEnabled"`
+	AuthMode         string              `json:"authMode"`
+	RoleBindings     map[string][]string `json:"roleBindings,omitempty"`
+	JWTPublicKeyFile string              `json:"jwtPublicKeyFile,omitempty"`
+	JWTIssuer        string              `json:"jwtIssuer,omitempty"`
+	JWTAudience      string              `json:"jwtAudience,omitempty"`
+}
+
+/*
+Defaults returns the configuration used when neither the environment nor a config file set a value.
+The certificate file paths are left empty here and resolved by Load once the debug mode is known.
+*/
+func Defaults() Config {
+	return Config{
+		SecretNamespace: DEFAULT_NAMESPACE,
+		AWS: AWSConfig{
+			Region:    DEFAULT_REGION,
+			R53Domain: DEFAULT_R53_DOMAIN,
+		},
+		Certificate: CertificateConfig{
+			AutoCertSecretName:       DEFAULT_AUTOCERT_SECRETNAME,
+			ACMCertificateName:       DEFAULT_ACM_CERT_NAME,
+			K8sCertificateNamespace:  DEFAULT_NAMESPACE,
+			K8sCertSecretName:        DEFAULT_SECRETNAME,
+			Intermediate1URL:         DEFAULT_INTER1_URL,
+			Intermediate2URL:         DEFAULT_INTER2_URL,
+			RootURL:                  DEFAULT_ROOT_URL,
+			ACMImportIfNotExists:     true,
+			PodFileUpdateTimeoutSecs: DEFAULT_POD_FILE_UPDATE_TIMEOUT_SECS,
+		},
+		ExpiryMonitor: ExpiryConfig{
+			CheckIntervalMins: DEFAULT_EXPIRY_CHECK_INTERVAL_MINS,
+			WarnDays:          DEFAULT_EXPIRY_WARN_DAYS,
+			CriticalDays:      DEFAULT_EXPIRY_CRITICAL_DAYS,
+			K8sEvents:         true,
+		},
+		API: APIConfig{
+			AuthMode: AUTH_MODE_SERVICEACCOUNT,
+		},
+	}
+}
+
+/*
+Load builds the configuration from the defaults, then overlays the environment variables, then
+overlays the YAML file at path if one is given. A value set in the file therefore wins over the
+same value set in the environment. Load does not validate the result, call Validate for that.
+*/
+func Load(path string) (Config, error) {
+	cfg := Defaults()
+
+	if err := cfg.applyEnv(); err != nil {
+		return cfg, err
+	}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return cfg, fmt.Errorf("unable to read config file %s: %w", path, err)
+		}
+		if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+			return cfg, fmt.Errorf("unable to parse config file %s: %w", path, err)
+		}
+	}
+
+	cfg.AWS.R53Domain = strings.ToLower(cfg.AWS.R53Domain)
+	cfg.API.AuthMode = strings.ToLower(cfg.API.AuthMode)
+
+	if cfg.Certificate.TLSCertPath == "" {
+		cfg.Certificate.TLSCertPath = pick(cfg.Debug, DEBUG_TLS_CERT_PATH, DEFAULT_TLS_CERT_PATH)
+	}
+	if cfg.Certificate.TLSKeyPath == "" {
+		cfg.Certificate.TLSKeyPath = pick(cfg.Debug, DEBUG_TLS_KEY_PATH, DEFAULT_TLS_KEY_PATH)
+	}
+	if cfg.Certificate.CACertPath == "" {
+		cfg.Certificate.CACertPath = pick(cfg.Debug, DEBUG_CA_CERT_PATH, DEFAULT_CA_CERT_PATH)
+	}
+
+	return cfg, nil
+}
+
+func pick(cond bool, a, b string) string {
+	if cond {
+		return a
+	}
+	return b
+}
+
+// envLoader collects parse errors so that every malformed variable is reported at once.
+type envLoader struct {
+	errs []string
+}
+
+func (l *envLoader) str(name string, target *string) {
+	if val := os.Getenv(name); val != "" {
+		*target = val
+	}
+}
+
+func (l *envLoader) boolean(name string, target *bool) {
+	val := strings.ToLower(strings.TrimSpace(os.Getenv(name)))
+	switch val {
+	case "":
+	case "1", "t", "true", "y", "yes":
+		*target = true
+	case "0", "f", "false", "n", "no":
+		*target = false
+	default:
+		l.errs = append(l.errs, fmt.Sprintf("%s: %q is not a boolean", name, val))
+	}
+}
+
+func (l *envLoader) integer(name string, target *int) {
+	val := os.Getenv(name)
+	if val == "" {
+		return
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil {
+		l.errs = append(l.errs, fmt.Sprintf("%s: %q is not an integer", name, val))
+		return
+	}
+	*target = i
+}
+
+func (cfg *Config) applyEnv() error {
+	var l envLoader
+
+	l.boolean("DEBUG", &cfg.Debug)
+	l.boolean("DRY_RUN", &cfg.DryRun)
+	l.str("SECRET_NAMESPACE", &cfg.SecretNamespace)
+
+	l.str("AWS_REGION", &cfg.AWS.Region)
+	l.str("AWS_ACCESS_KEY_ID", &cfg.AWS.AccessKeyID)
+	l.str("AWS_SECRET_ACCESS_KEY", &cfg.AWS.SecretAccessKey)
+	l.str("AWS_VPC", &cfg.AWS.VPC)
+	l.str("AWS_R53_DOMAIN", &cfg.AWS.R53Domain)
+
+	l.str("AUTOCERT_CERTSECRET_NAME", &cfg.Certificate.AutoCertSecretName)
+	l.str("CSP_CERTIFICATE_NAME_TAG", &cfg.Certificate.ACMCertificateName)
+	l.str("K8S_CERTIFICATE_NAMESPACE", &cfg.Certificate.K8sCertificateNamespace)
+	l.str("K8S_CERT_SECRET_NAME", &cfg.Certificate.K8sCertSecretName)
+	l.boolean("CREATE_K8S_CERT_SECRET", &cfg.Certificate.CreateK8sCertSecret)
+	l.str("CERTIFICATE_FILE", &cfg.Certificate.TLSCertPath)
+	l.str("PRIVATE_KEY_FILE", &cfg.Certificate.TLSKeyPath)
+	l.str("CA_CERTIFICATE_FILE", &cfg.Certificate.CACertPath)
+	l.str("INTERMEDIATE1_CERT_URL", &cfg.Certificate.Intermediate1URL)
+	l.str("INTERMEDIATE2_CERT_URL", &cfg.Certificate.Intermediate2URL)
+	l.str("ROOT_CERT_URL", &cfg.Certificate.RootURL)
+	l.boolean("DISABLE_CERT_MATCH_CHECKS", &cfg.Certificate.DisableCertMatchChecks)
+	l.boolean("ACM_IMPORT_IF_NOT_EXISTS", &cfg.Certificate.ACMImportIfNotExists)
+	l.integer("POD_FILE_UPDATE_TIMEOUT_SECS", &cfg.Certificate.PodFileUpdateTimeoutSecs)
+
+	l.boolean("DISABLE_CERT_EXPIRY_MONITOR", &cfg.ExpiryMonitor.Disabled)
+	l.integer("CERT_EXPIRY_CHECK_INTERVAL_MINS", &cfg.ExpiryMonitor.CheckIntervalMins)
+	l.integer("CERT_EXPIRY_WARN_DAYS", &cfg.ExpiryMonitor.WarnDays)
+	l.integer("CERT_EXPIRY_CRITICAL_DAYS", &cfg.ExpiryMonitor.CriticalDays)
+	l.boolean("CERT_EXPIRY_K8S_EVENTS", &cfg.ExpiryMonitor.K8sEvents)
+	l.str("CERT_EXPIRY_WEBHOOK_URL", &cfg.ExpiryMonitor.WebhookURL)
+	l.boolean("CERT_EXPIRY_AUTO_REIMPORT", &cfg.ExpiryMonitor.AutoReimport)
+
+	l.boolean("LEGACY_API_ENABLED", &cfg.API.LegacyEnabled)
+	l.str("API_AUTH_MODE", &cfg.API.AuthMode)
+	l.str("API_JWT_PUBLIC_KEY_FILE", &cfg.API.JWTPublicKeyFile)
+	l.str("API_JWT_ISSUER", &cfg.API.JWTIssuer)
+	l.str("API_JWT_AUDIENCE", &cfg.API.JWTAudience)
+	if bindings := os.Getenv("API_ROLE_BINDINGS"); bindings != "" {
+		if err := yaml.Unmarshal([]byte(bindings), &cfg.API.RoleBindings); err != nil {
+			l.errs = append(l.errs, fmt.Sprintf("API_ROLE_BINDINGS: %v", err))
+		}
+	}
+
+	if len(l.errs) > 0 {
+		return fmt.Errorf("invalid environment: %s", strings.Join(l.errs, "; "))
+	}
+	return nil
+}
+
+func (cfg Config) Validate() error {
+	return validation.ValidateStruct(&cfg,
+		validation.Field(&cfg.SecretNamespace, validation.Required, is.DNSName),
+		validation.Field(&cfg.AWS),
+		validation.Field(&cfg.Certificate),
+		validation.Field(&cfg.ExpiryMonitor),
+		validation.Field(&cfg.API),
+	)
+}
+
+func (c AWSConfig) Validate() error {
+	return validation.ValidateStruct(&c,
+		validation.Field(&c.Region, validation.Required, validation.Length(1, 50), is.ASCII),
+		validation.Field(&c.R53Domain, validation.Required, is.Domain),
+		validation.Field(&c.AccessKeyID, validation.When(c.SecretAccessKey != "", validation.Required)),
+		validation.Field(&c.SecretAccessKey, validation.When(c.AccessKeyID != "", validation.Required)),
+	)
+}
+
+func (c CertificateConfig) Validate() error {
+	return validation.ValidateStruct(&c,
+		validation.Field(&c.AutoCertSecretName, validation.Required, is.DNSName),
+		validation.Field(&c.ACMCertificateName, validation.Required, validation.Length(1, 256)),
+		validation.Field(&c.K8sCertificateNamespace, validation.Required, is.DNSName),
+		validation.Field(&c.K8sCertSecretName, validation.Required, is.DNSName),
+		validation.Field(&c.TLSCertPath, validation.Required),
+		validation.Field(&c.TLSKeyPath, validation.Required),
+		validation.Field(&c.CACertPath, validation.Required),
+		validation.Field(&c.Intermediate1URL, validation.Required, is.URL),
+		validation.Field(&c.Intermediate2URL, validation.Required, is.URL),
+		validation.Field(&c.RootURL, validation.Required, is.URL),
+		validation.Field(&c.PodFileUpdateTimeoutSecs, validation.Required, validation.Min(1)),
+	)
+}
+
+func (c ExpiryConfig) Validate() error {
+	return validation.ValidateStruct(&c,
+		validation.Field(&c.CheckIntervalMins, validation.Required, validation.Min(1)),
+		validation.Field(&c.WarnDays, validation.Required, validation.Min(c.CriticalDays).Error("must not be less than criticalDays")),
+		validation.Field(&c.CriticalDays, validation.Required, validation.Min(1)),
+		validation.Field(&c.WebhookURL, is.URL),
+	)
+}
+
+func (c APIConfig) Validate() error {
+	return validation.ValidateStruct(&c,
+		validation.Field(&c.AuthMode, validation.Required, validation.In(AUTH_MODE_SERVICEACCOUNT, AUTH_MODE_JWT, AUTH_MODE_NONE)),
+		validation.Field(&c.JWTPublicKeyFile, validation.When(c.AuthMode == AUTH_MODE_JWT, validation.Required)),
+	)
+}
+
+// Redacted returns a copy of the configuration that is safe to log.
+func (cfg Config) Redacted() Config {
+	if cfg.AWS.AccessKeyID != "" {
+		cfg.AWS.AccessKeyID = REDACTED
+	}
+	if cfg.AWS.SecretAccessKey != "" {
+		cfg.AWS.SecretAccessKey = REDACTED
+	}
+	if cfg.ExpiryMonitor.WebhookURL != "" {
+		cfg.ExpiryMonitor.WebhookURL = REDACTED
+	}
+	return cfg
+}
+
+// YAML renders the redacted configuration, used for startup logging and --print-config.
+func (cfg Config) YAML() string {
+	out, err := yaml.Marshal(cfg.Redacted())
+	if err != nil {
+		return fmt.Sprintf("<unable to render config: %v>", err)
+	}
+	return string(out)
+}
@@ -0,0 +1,297 @@
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	tests := []struct {
+		name  string
+		env   map[string]string
+		file  string
+		check func(t *testing.T, cfg Config)
+	}{
+		{
+			name: "defaults",
+			check: func(t *testing.T, cfg Config) {
+				want := Defaults()
+				want.Certificate.TLSCertPath = DEFAULT_TLS_CERT_PATH
+				want.Certificate.TLSKeyPath = DEFAULT_TLS_KEY_PATH
+				want.Certificate.CACertPath = DEFAULT_CA_CERT_PATH
+				if cfg.YAML() != want.YAML() {
+					t.Errorf("got\n%s\nwant\n%s", cfg.YAML(), want.YAML())
+				}
+				if cfg.API.LegacyEnabled {
+					t.Errorf("legacy API enabled by default")
+				}
+			},
+		},
+		{
+			name: "environment over defaults",
+			env: map[string]string{
+				"AWS_REGION":                   "eu-west-1",
+				"AWS_R53_DOMAIN":               "Orch.Example.COM",
+				"POD_FILE_UPDATE_TIMEOUT_SECS": "30",
+				"ACM_IMPORT_IF_NOT_EXISTS":     "false",
+				"API_AUTH_MODE":                "JWT",
+				"API_ROLE_BINDINGS":            `{"cert-admin": ["cert-admins"]}`,
+			},
+			check: func(t *testing.T, cfg Config) {
+				if cfg.AWS.Region != "eu-west-1" {
+					t.Errorf("region %q, want eu-west-1", cfg.AWS.Region)
+				}
+				if cfg.AWS.R53Domain != "orch.example.com" {
+					t.Errorf("domain %q, want it lowercased", cfg.AWS.R53Domain)
+				}
+				if cfg.Certificate.PodFileUpdateTimeoutSecs != 30 {
+					t.Errorf("pod file update timeout %d, want 30", cfg.Certificate.PodFileUpdateTimeoutSecs)
+				}
+				if cfg.Certificate.ACMImportIfNotExists {
+					t.Errorf("ACM import if not exists is set, want it cleared")
+				}
+				if cfg.API.AuthMode != AUTH_MODE_JWT {
+					t.Errorf("auth mode %q, want %q", cfg.API.AuthMode, AUTH_MODE_JWT)
+				}
+				if got := cfg.API.RoleBindings["cert-admin"]; len(got) != 1 || got[0] != "cert-admins" {
+					t.Errorf("cert-admin bindings %v, want [cert-admins]", got)
+				}
+				if cfg.SecretNamespace != DEFAULT_NAMESPACE {
+					t.Errorf("secret namespace %q, want the default %q", cfg.SecretNamespace, DEFAULT_NAMESPACE)
+				}
+			},
+		},
+		{
+			name: "file over environment",
+			env: map[string]string{
+				"AWS_REGION":                   "eu-west-1",
+				"POD_FILE_UPDATE_TIMEOUT_SECS": "30",
+				"SECRET_NAMESPACE":             "from-env",
+			},
+			file: "aws:\n  region: ap-south-1\ncertificate:\n  podFileUpdateTimeoutSecs: 45\n",
+			check: func(t *testing.T, cfg Config) {
+				if cfg.AWS.Region != "ap-south-1" {
+					t.Errorf("region %q, want the file value ap-south-1", cfg.AWS.Region)
+				}
+				if cfg.Certificate.PodFileUpdateTimeoutSecs != 45 {
+					t.Errorf("pod file update timeout %d, want the file value 45", cfg.Certificate.PodFileUpdateTimeoutSecs)
+				}
+				if cfg.SecretNamespace != "from-env" {
+					t.Errorf("secret namespace %q, want the environment value kept", cfg.SecretNamespace)
+				}
+				if cfg.AWS.R53Domain != DEFAULT_R53_DOMAIN {
+					t.Errorf("domain %q, want the default %q", cfg.AWS.R53Domain, DEFAULT_R53_DOMAIN)
+				}
+			},
+		},
+		{
+			name: "debug certificate paths",
+			env:  map[string]string{"DEBUG": "true", "PRIVATE_KEY_FILE": "/keys/tls.key"},
+			check: func(t *testing.T, cfg Config) {
+				if cfg.Certificate.TLSCertPath != DEBUG_TLS_CERT_PATH || cfg.Certificate.CACertPath != DEBUG_CA_CERT_PATH {
+					t.Errorf("certificate paths %q and %q, want the debug paths", cfg.Certificate.TLSCertPath, cfg.Certificate.CACertPath)
+				}
+				if cfg.Certificate.TLSKeyPath != "/keys/tls.key" {
+					t.Errorf("key path %q, want the environment value", cfg.Certificate.TLSKeyPath)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, val := range tt.env {
+				t.Setenv(name, val)
+			}
+			path := ""
+			if tt.file != "" {
+				path = writeConfigFile(t, tt.file)
+			}
+
+			cfg, err := Load(path)
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			tt.check(t, cfg)
+		})
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		file    string
+		missing bool
+		want    []string
+	}{
+		{
+			name: "malformed variables",
+			env: map[string]string{
+				"POD_FILE_UPDATE_TIMEOUT_SECS": "2m",
+				"DRY_RUN":                      "maybe",
+			},
+			want: []string{`POD_FILE_UPDATE_TIMEOUT_SECS: "2m" is not an integer`, `DRY_RUN: "maybe" is not a boolean`},
+		},
+		{
+			name: "malformed role bindings",
+			env:  map[string]string{"API_ROLE_BINDINGS": "[cert-admin"},
+			want: []string{"API_ROLE_BINDINGS"},
+		},
+		{
+			name: "unknown key in the file",
+			file: "aws:\n  regoin: ap-south-1\n",
+			want: []string{"unable to parse config file", "regoin"},
+		},
+		{
+			name:    "missing file",
+			missing: true,
+			want:    []string{"unable to read config file"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, val := range tt.env {
+				t.Setenv(name, val)
+			}
+			path := ""
+			if tt.file != "" {
+				path = writeConfigFile(t, tt.file)
+			}
+			if tt.missing {
+				path = filepath.Join(t.TempDir(), "missing.yaml")
+			}
+
+			_, err := Load(path)
+			if err == nil {
+				t.Fatalf("Load succeeded, want an error")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() Config {
+		cfg, err := Load("")
+		if err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		return cfg
+	}
+
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+		want   string
+	}{
+		{
+			name:   "defaults",
+			modify: func(cfg *Config) {},
+		},
+		{
+			name:   "jwt auth with a public key",
+			modify: func(cfg *Config) { cfg.API.AuthMode = AUTH_MODE_JWT; cfg.API.JWTPublicKeyFile = "/etc/jwt/key.pem" },
+		},
+		{
+			name:   "jwt auth without a public key",
+			modify: func(cfg *Config) { cfg.API.AuthMode = AUTH_MODE_JWT },
+			want:   "jwtPublicKeyFile",
+		},
+		{
+			name:   "unknown auth mode",
+			modify: func(cfg *Config) { cfg.API.AuthMode = "basic" },
+			want:   "authMode",
+		},
+		{
+			name:   "invalid domain",
+			modify: func(cfg *Config) { cfg.AWS.R53Domain = "not a domain" },
+			want:   "r53Domain",
+		},
+		{
+			name:   "access key without a secret",
+			modify: func(cfg *Config) { cfg.AWS.AccessKeyID = "AKIAEXAMPLE" },
+			want:   "secretAccessKey",
+		},
+		{
+			name:   "zero pod file update timeout",
+			modify: func(cfg *Config) { cfg.Certificate.PodFileUpdateTimeoutSecs = 0 },
+			want:   "podFileUpdateTimeoutSecs",
+		},
+		{
+			name:   "invalid root certificate url",
+			modify: func(cfg *Config) { cfg.Certificate.RootURL = "isrgrootx1" },
+			want:   "rootUrl",
+		},
+		{
+			name:   "warning after the critical threshold",
+			modify: func(cfg *Config) { cfg.ExpiryMonitor.WarnDays = 3 },
+			want:   "warnDays",
+		},
+		{
+			name:   "invalid webhook url",
+			modify: func(cfg *Config) { cfg.ExpiryMonitor.WebhookURL = "hooks" },
+			want:   "webhookUrl",
+		},
+		{
+			name:   "invalid secret namespace",
+			modify: func(cfg *Config) { cfg.SecretNamespace = "orch_gateway!" },
+			want:   "secretNamespace",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid()
+			tt.modify(&cfg)
+
+			err := cfg.Validate()
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("Validate: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Validate succeeded, want an error on %s", tt.want)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error %q does not name %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedacted(t *testing.T) {
+	cfg := Defaults()
+	cfg.AWS.AccessKeyID = "AKIAEXAMPLE"
+	cfg.AWS.SecretAccessKey = "wJalrXUtnFEMI"
+	cfg.ExpiryMonitor.WebhookURL = "https://hooks.example.com/token"
+
+	out := cfg.YAML()
+	for _, secret := range []string{"AKIAEXAMPLE", "wJalrXUtnFEMI", "hooks.example.com"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("rendered configuration contains %q:\n%s", secret, out)
+		}
+	}
+	if cfg.AWS.SecretAccessKey != "wJalrXUtnFEMI" {
+		t.Errorf("Redacted modified the configuration")
+	}
+}
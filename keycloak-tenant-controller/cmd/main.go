@@ -7,6 +7,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"math/rand/v2"
 	"os"
@@ -27,6 +28,8 @@ var (
 	Commit         string
 	logLevel       = flag.String("loglevel", "info", "Sets logging level.")
 	ktcStressCalls = flag.Int("stressktc", 0, "KTC performs a stress test on startup. The number passed is the number of orgs and projects created.")
+	dryRun         = flag.Bool("dryrun", false, "KTC logs the roles, groups and role mappings it would remove from Keycloak, without removing them.")
+	orphanReport   = flag.Bool("orphanreport", false, "KTC prints the roles and groups it created for orgs and projects that no longer exist, as JSON, and exits.")
 )
 
 func main() {
@@ -50,7 +53,7 @@ func main() {
 		panic("Error initialising required secrets")
 	}
 
-	kcClient := keycloak.NewClient()
+	kcClient := keycloak.NewClient(keycloak.Options{DryRun: *dryRun})
 	if err := kcClient.Init(); err != nil {
 		log.Errorf("Error initialising Keycloak Client: %v", err)
 		panic("Error initialising Keycloak Client")
@@ -63,6 +66,22 @@ func main() {
 	}
 
 	tdmclient := tdmclient.NewMTClient(common.AppName, kcClient)
+
+	if *orphanReport {
+		report, err := tdmclient.OrphanReport()
+		if err != nil {
+			log.Errorf("Error building the orphan report: %v", err)
+			os.Exit(1)
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			log.Errorf("Error printing the orphan report: %v", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := tdmclient.Init(); err != nil {
 		log.Errorf("Error initialising TDM Client: %v", err)
 		panic("Error initialising TDM Client")
@@ -112,7 +131,7 @@ func stressKtc(client keycloak.Client, numCreates int) {
 			go func() {
 				defer waitGroup.Done()
 				randomSleep()
-				if err := client.DeleteProject(projId); err != nil {
+				if err := client.DeleteProject(orgId, projId); err != nil {
 					log.Errorf("Delete proj error: %v", err)
 				}
 			}()
@@ -6,8 +6,9 @@
 package keycloak
 
 import (
+	"cmp"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"slices"
@@ -15,7 +16,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/common"
 	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/log"
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/templates"
 )
 
 const (
@@ -26,11 +29,14 @@ const (
 
 	envKeycloakUrl   = "KEYCLOAK_URL"
 	envKeycloakRealm = "KEYCLOAK_REALM"
-	envOrgGroups     = "KEYCLOAK_ORG_GROUPS"
-	envProjGroups    = "KEYCLOAK_PROJ_GROUPS"
 
-	orgPrefix  = "<org-id>"
-	projPrefix = "<project-id>"
+	orgPrefix  = templates.OrgPrefix
+	projPrefix = templates.ProjPrefix
+
+	// Attributes of the roles and groups created by KTC. tenant-id is the id of the org or project the role
+	// or group belongs to, and is not set on the roles shared by several tenants.
+	attrManagedBy = "managed-by"
+	attrTenantID  = "tenant-id"
 
 	retryAttempts  = 10
 	retrySleep     = 3 * time.Second
@@ -42,21 +48,61 @@ type Client interface {
 	CreateOrg(orgId string) error
 	DeleteOrg(orgId string) error
 	CreateProject(orgId string, projId string) error
-	DeleteProject(projId string) error
+	DeleteProject(orgId string, projId string) error
+	SuspendOrg(orgId string) error
+	ResumeOrg(orgId string) error
+	ArchiveProject(orgId string, projId string) error
+	UnarchiveProject(orgId string, projId string) error
+	SetTemplates(t *templates.Templates)
+	ReconcileSI() error
+	ReconcileOrg(orgId string) error
+	ReconcileProject(orgId string, projId string) error
+	OrphanReport(tenantIds []string) (*OrphanReport, error)
+}
+
+// Options configures the Keycloak client.
+type Options struct {
+	// DryRun logs the roles, groups and role mappings that would be removed from Keycloak, without removing them.
+	DryRun bool
+}
+
+// OrphanReport lists the roles, groups, realms and Organizations created by KTC for tenants that no longer exist.
+type OrphanReport struct {
+	Roles         []Orphan `json:"roles,omitempty"`
+	Groups        []Orphan `json:"groups,omitempty"`
+	Realms        []Orphan `json:"realms,omitempty"`
+	Organizations []Orphan `json:"organizations,omitempty"`
+}
+
+// Orphan is a role, group, realm or Organization of the tenant of TenantID. Realm is set for the roles and
+// groups of the realm of an org.
+type Orphan struct {
+	Name     string `json:"name"`
+	TenantID string `json:"tenantId"`
+	Realm    string `json:"realm,omitempty"`
 }
 
 type client struct {
-	mu            sync.Mutex
-	session       keycloakSession
-	orgGroups     map[string][]string
-	projGroups    map[string][]string
-	siGroups      map[string][]string
-	keycloakurl   string
-	keycloakRealm string
+	mu                   sync.Mutex
+	session              keycloakSession
+	orgGroups            map[string][]string
+	projGroups           map[string][]string
+	siGroups             map[string][]string
+	pruneMappings        bool
+	realmTemplate        string
+	organizationTemplate string
+	dryRun               bool
+	tenantMode           string
+	keycloakurl          string
+	keycloakRealm        string
 }
 
-func NewClient() Client {
+var errGroupNotFound = errors.New("group not found")
+
+func NewClient(opts Options) Client {
 	return &client{
+		dryRun:        opts.DryRun,
+		tenantMode:    ModeSingleRealm,
 		keycloakurl:   defaultKeycloakUrl,
 		keycloakRealm: defaultKeycloakRealm,
 	}
@@ -73,28 +119,33 @@ func (c *client) Init() error {
 
 /*
 CreateOrg will create the appropriate roles and groups for a new org.
-The roles and groups are read from a JSON environment variable, defined above.
+The roles and groups are those of the org templates.
 Roles and groups containing an org id prefix will have that prefix replaced with the orgID passed into this function.
+In the org-realm and organization modes, the realm or the Organization of the org is provisioned first.
 */
 func (c *client) CreateOrg(orgID string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	if err := c.provisionOrg(orgID); err != nil {
+		return err
+	}
 	return c.createRolesAndGroups(orgID, "", c.orgGroups)
 }
 
 /*
-DeleteOrg takes an orgID and will delete all groups and roles prepended with this ID.
+DeleteOrg takes an orgID and will delete the groups and roles of the org templates named with this ID.
+In the org-realm mode, the realm of the org is deleted instead, with all its roles and groups, and in the
+organization mode, the Organization of the org is deleted after its roles and groups.
 */
 func (c *client) DeleteOrg(orgID string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	return c.deleteRolesAndGroups(orgID)
+	return c.deleteOrg(orgID)
 }
 
 /*
 CreateProject will create the appropriate roles and groups for a new project.
-The roles and groups are read from a JSON environment variable, defined above.
-"KEYCLOAK_PROJ_GROUPS" at time of writing
+The roles and groups are those of the project templates.
 Roles and groups containing a project id prefix will have that prefix replaced with the projectID passed into this function.
 */
 func (c *client) CreateProject(orgID string, projID string) error {
@@ -104,12 +155,104 @@ func (c *client) CreateProject(orgID string, projID string) error {
 }
 
 /*
-DeleteProject takes a projID and will delete all groups and roles prepended with this ID.
+DeleteProject takes a projID and will delete the groups and roles of the project templates named with this ID.
+*/
+func (c *client) DeleteProject(orgID string, projID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.deleteRolesAndGroups(orgID, projID, c.projGroups)
+}
+
+/*
+SuspendOrg takes an orgID and will remove the roles from the groups of the org templates named with this ID.
+The roles and groups are kept, so that ResumeOrg can restore them.
+*/
+func (c *client) SuspendOrg(orgID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.removeRolesFromGroups(orgID, "", c.orgGroups)
+}
+
+/*
+ResumeOrg will add the roles of a suspended org back to its groups.
+*/
+func (c *client) ResumeOrg(orgID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.createRolesAndGroups(orgID, "", c.orgGroups)
+}
+
+/*
+ArchiveProject takes a projID and will remove the roles from the groups of the project templates named with this ID.
+The roles and groups are kept, so that UnarchiveProject can restore them.
+*/
+func (c *client) ArchiveProject(orgID string, projID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.removeRolesFromGroups(orgID, projID, c.projGroups)
+}
+
+/*
+UnarchiveProject will add the roles of an archived project back to its groups.
+*/
+func (c *client) UnarchiveProject(orgID string, projID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.createRolesAndGroups(orgID, projID, c.projGroups)
+}
+
+/*
+SetTemplates replaces the templates with t, for the orgs and projects created from now on.
+The existing ones are updated by ReconcileSI, ReconcileOrg and ReconcileProject.
+*/
+func (c *client) SetTemplates(t *templates.Templates) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setTemplates(t)
+}
+
+/*
+ReconcileSI creates the roles, groups and role mappings of the SI templates that do not exist yet.
+The SI groups are shared, and their other role mappings are never pruned.
+*/
+func (c *client) ReconcileSI() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.createRolesAndGroups("", "", c.siGroups)
+}
+
+/*
+ReconcileOrg creates the roles, groups and role mappings of the org templates that the org lacks.
+With pruneMappings, the roles mapped to its groups that the templates do not list are removed from them.
+The realm or the Organization of the org is provisioned if it lacks one.
+*/
+func (c *client) ReconcileOrg(orgID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.provisionOrg(orgID); err != nil {
+		return err
+	}
+	return c.reconcileRolesAndGroups(orgID, "", c.orgGroups)
+}
+
+/*
+ReconcileProject creates the roles, groups and role mappings of the project templates that the project lacks.
+With pruneMappings, the roles mapped to its groups that the templates do not list are removed from them.
 */
-func (c *client) DeleteProject(projID string) error {
+func (c *client) ReconcileProject(orgID string, projID string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	return c.deleteRolesAndGroups(projID)
+	return c.reconcileRolesAndGroups(orgID, projID, c.projGroups)
+}
+
+/*
+OrphanReport lists the roles, groups, realms and Organizations created by KTC whose tenant-id is none of
+tenantIDs, the ids of the existing orgs and projects. It lists whole realms, and is not to be run on each event.
+*/
+func (c *client) OrphanReport(tenantIDs []string) (*OrphanReport, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.orphanReport(tenantIDs)
 }
 
 /*
@@ -126,29 +269,26 @@ func (c *client) init() error {
 	}
 	log.Infof("Keycloak realm: %s", c.keycloakRealm)
 
-	if err := json.Unmarshal([]byte(os.Getenv(envOrgGroups)), &c.orgGroups); err != nil {
-		log.Errorf("Error unmarshalling org groups: %v", err)
-	}
-	log.Infof("Per org groups:")
-	for groupName, roleNames := range c.orgGroups {
-		log.Infof("   %s", groupName)
-		for _, roleName := range roleNames {
-			log.Infof("      %s", roleName)
+	if envVar := os.Getenv(envTenantMode); envVar != "" {
+		if err := validateTenantMode(envVar); err != nil {
+			log.Errorf("%v", err)
+			return err
 		}
+		c.tenantMode = envVar
 	}
+	log.Infof("Keycloak tenant mode: %s", c.tenantMode)
 
-	if err := json.Unmarshal([]byte(os.Getenv(envProjGroups)), &c.projGroups); err != nil {
-		log.Errorf("Error unmarshalling proj groups: %v", err)
+	if c.dryRun {
+		log.Infof("Dry run: roles, groups, role mappings, realms and organizations are not removed from Keycloak")
 	}
-	log.Infof("Per proj groups:")
-	for groupName, roleNames := range c.projGroups {
-		log.Infof("   %s", groupName)
-		for _, roleName := range roleNames {
-			log.Infof("      %s", roleName)
-		}
+
+	t, err := templates.FromEnv()
+	if err != nil {
+		log.Errorf("Error reading the templates: %v", err)
+		return err
 	}
+	c.setTemplates(t)
 
-	var err error
 	c.session, err = startKeycloakSession(c.keycloakRealm, c.keycloakurl)
 	if err != nil {
 		log.Errorf("Error starting keycloak session: %v", err)
@@ -162,26 +302,110 @@ func (c *client) init() error {
 	return nil
 }
 
+/*
+setTemplates does the work for SetTemplates()
+*/
+func (c *client) setTemplates(t *templates.Templates) {
+	c.siGroups = t.SIGroups
+	c.orgGroups = t.OrgGroups
+	c.projGroups = t.ProjGroups
+	c.pruneMappings = t.PruneMappings
+	c.realmTemplate = t.RealmTemplate
+	c.organizationTemplate = t.OrganizationTemplate
+
+	logGroups := func(title string, groups map[string][]string) {
+		log.Infof("%s groups:", title)
+		for groupName, roleNames := range groups {
+			log.Infof("   %s", groupName)
+			for _, roleName := range roleNames {
+				log.Infof("      %s", roleName)
+			}
+		}
+	}
+	logGroups("Cross SI", c.siGroups)
+	logGroups("Per org", c.orgGroups)
+	logGroups("Per proj", c.projGroups)
+	log.Infof("Prune role mappings: %t", c.pruneMappings)
+}
+
+/*
+deleteOrg does the work for DeleteOrg()
+*/
+func (c *client) deleteOrg(orgID string) error {
+	if c.tenantMode != ModeOrgRealm {
+		if err := c.deleteRolesAndGroups(orgID, "", c.orgGroups); err != nil {
+			return err
+		}
+	}
+	return c.deprovisionOrg(orgID)
+}
+
+/*
+reconcileRolesAndGroups does the work for ReconcileOrg() and ReconcileProject()
+*/
+func (c *client) reconcileRolesAndGroups(orgID string, projID string, groups map[string][]string) error {
+	if err := c.createRolesAndGroups(orgID, projID, groups); err != nil {
+		return err
+	}
+	if !c.pruneMappings {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+	realm := c.realmOf(orgID)
+
+	for groupName, roleNames := range groups {
+		groupName = templates.Expand(groupName, orgID, projID)
+
+		var keptRoleNames []string
+		for _, roleName := range roleNames {
+			keptRoleNames = append(keptRoleNames, templates.Expand(roleName, orgID, projID))
+		}
+
+		group, err := getGroupByName(c.session, ctx, realm, groupName)
+		if err != nil {
+			log.Errorf("Error getting group %s: %v", groupName, err)
+			return err
+		}
+
+		removed, err := removeOtherRolesFromGroup(c.session, ctx, realm, *group.ID, keptRoleNames, c.dryRun)
+		if err != nil {
+			log.Errorf("Error pruning roles from group %s: %v", groupName, err)
+			return err
+		}
+		for _, roleName := range removed {
+			if c.dryRun {
+				log.Infof("Dry run: role %s would be removed from group %s", roleName, groupName)
+			} else {
+				log.Infof("Role %s removed from group %s", roleName, groupName)
+			}
+		}
+	}
+
+	return nil
+}
+
 /*
 createRolesAndGroups does the work for CreateOrg() and CreateProject()
 */
 func (c *client) createRolesAndGroups(orgID string, projID string, groups map[string][]string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
 	defer cancel()
+	realm := c.realmOf(orgID)
 
-	_, err := ensureRealmExists(c.session, ctx, c.keycloakRealm)
+	_, err := ensureRealmExists(c.session, ctx, realm)
 	if err != nil {
-		log.Errorf("Error checking if realm %s exists: %v", c.keycloakRealm, err)
+		log.Errorf("Error checking if realm %s exists: %v", realm, err)
 		return err
 	}
 
 	var count int
 	for {
-		for groupName, roleNames := range groups {
-			groupName = strings.ReplaceAll(groupName, orgPrefix, orgID)
-			groupName = strings.ReplaceAll(groupName, projPrefix, projID)
+		for groupTemplate, roleNames := range groups {
+			groupName := templates.Expand(groupTemplate, orgID, projID)
 
-			if err = createGroup(c.session, ctx, c.keycloakRealm, groupName); err != nil {
+			if err = createGroup(c.session, ctx, realm, groupName, managedAttributes(groupTemplate, orgID, projID)); err != nil {
 				if isErrorForAlreadyExists(err) {
 					log.Infof("Group %s already exists", groupName)
 				} else {
@@ -193,11 +417,10 @@ func (c *client) createRolesAndGroups(orgID string, projID string, groups map[st
 			}
 
 			var updatedRoleNames []string
-			for _, roleName := range roleNames {
-				roleName = strings.ReplaceAll(roleName, orgPrefix, orgID)
-				roleName = strings.ReplaceAll(roleName, projPrefix, projID)
+			for _, roleTemplate := range roleNames {
+				roleName := templates.Expand(roleTemplate, orgID, projID)
 
-				if err = createRole(c.session, ctx, c.keycloakRealm, roleName); err != nil {
+				if err = createRole(c.session, ctx, realm, roleName, managedAttributes(roleTemplate, orgID, projID)); err != nil {
 					if isErrorForAlreadyExists(err) {
 						log.Infof("Role %s already exists", roleName)
 					} else {
@@ -211,7 +434,7 @@ func (c *client) createRolesAndGroups(orgID string, projID string, groups map[st
 				updatedRoleNames = append(updatedRoleNames, roleName)
 			}
 
-			if err := addRolesToGroup(c.session, ctx, c.keycloakRealm, groupName, updatedRoleNames); err != nil {
+			if err := addRolesToGroup(c.session, ctx, realm, groupName, updatedRoleNames); err != nil {
 				log.Errorf("Error adding roles to group %s : %v", groupName, err)
 				return err
 			}
@@ -239,60 +462,222 @@ func (c *client) createRolesAndGroups(orgID string, projID string, groups map[st
 
 /*
 deleteRolesAndGroups does the work for DeleteOrg() and DeleteProject()
+The groups and roles of the templates named with the id of the tenant are deleted, by their exact name,
+and not those whose attributes tie them to another tenant or to another application. So are those KTC
+created for the tenant from a template that was removed since, found by their attributes.
 */
-func (c *client) deleteRolesAndGroups(id string) error {
+func (c *client) deleteRolesAndGroups(orgID string, projID string, groups map[string][]string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
 	defer cancel()
+	realm := c.realmOf(orgID)
 
-	_, err := ensureRealmExists(c.session, ctx, c.keycloakRealm)
+	_, err := ensureRealmExists(c.session, ctx, realm)
 	if err != nil {
-		log.Errorf("Error checking if realm %s exists: %v", c.keycloakRealm, err)
+		log.Errorf("Error checking if realm %s exists: %v", realm, err)
 		return err
 	}
 
-	roles, err := getRoles(c.session, ctx, c.keycloakRealm)
+	tenantID := tenantOf(orgID, projID)
+	groupNames, roleNames := tenantNames(orgID, projID, groups)
+	managedGroupNames, managedRoleNames, err := c.managedNames(ctx, realm, tenantID)
 	if err != nil {
-		log.Errorf("Error getting Keycloak roles: %v", err)
 		return err
 	}
+	groupNames = appendMissing(groupNames, managedGroupNames)
+	roleNames = appendMissing(roleNames, managedRoleNames)
+
+	for _, groupName := range groupNames {
+		group, err := getGroupByName(c.session, ctx, realm, groupName)
+		if errors.Is(err, errGroupNotFound) {
+			log.Infof("Group %s already deleted", groupName)
+			continue
+		} else if err != nil {
+			log.Errorf("Error getting group %s: %v", groupName, err)
+			return err
+		}
 
-	for _, role := range roles {
-		if strings.Contains(role, id) {
-			if err = deleteRole(c.session, ctx, c.keycloakRealm, role); err != nil {
-				log.Errorf("Error deleting role %s: %v", role, err)
-				return err
-			} else {
-				log.Infof("Role %s deleted", role)
-			}
+		if !ownedBy(group.Attributes, tenantID) {
+			log.Warnf("Group %s is not managed for tenant %s, it is kept", groupName, tenantID)
+			continue
 		}
+
+		if c.dryRun {
+			log.Infof("Dry run: group %s would be deleted", groupName)
+			continue
+		}
+		if err = deleteGroup(c.session, ctx, realm, *group.ID); err != nil {
+			log.Errorf("Error deleting group %s: %v", groupName, err)
+			return err
+		}
+		log.Infof("Group %s deleted", groupName)
 	}
 
-	groups, err := getGroups(c.session, ctx, c.keycloakRealm)
+	for _, roleName := range roleNames {
+		role, err := getRoleByName(c.session, ctx, realm, roleName)
+		if err != nil && isErrorForNotFound(err) {
+			log.Infof("Role %s already deleted", roleName)
+			continue
+		} else if err != nil {
+			log.Errorf("Error getting role %s: %v", roleName, err)
+			return err
+		}
+
+		if !ownedBy(role.Attributes, tenantID) {
+			log.Warnf("Role %s is not managed for tenant %s, it is kept", roleName, tenantID)
+			continue
+		}
+
+		if c.dryRun {
+			log.Infof("Dry run: role %s would be deleted", roleName)
+			continue
+		}
+		if err = deleteRole(c.session, ctx, realm, roleName); err != nil {
+			log.Errorf("Error deleting role %s: %v", roleName, err)
+			return err
+		}
+		log.Infof("Role %s deleted", roleName)
+	}
+
+	return nil
+}
+
+/*
+managedNames returns the names of the groups and roles of realm that KTC created for the tenant tenantID.
+*/
+func (c *client) managedNames(ctx context.Context, realm string, tenantID string) ([]string, []string, error) {
+	var groupNames, roleNames []string
+
+	groups, err := getGroups(c.session, ctx, realm)
 	if err != nil {
-		log.Errorf("Error getting Keycloak groups: %v", err)
+		log.Errorf("Error getting Keycloak groups of realm %s: %v", realm, err)
+		return nil, nil, err
+	}
+	for _, group := range groups {
+		if slices.Contains(group.Attributes[attrManagedBy], common.AppName) &&
+			slices.Contains(group.Attributes[attrTenantID], tenantID) {
+			groupNames = append(groupNames, group.Name)
+		}
+	}
+
+	roles, err := getManagedRoles(c.session, ctx, realm, common.AppName)
+	if err != nil {
+		log.Errorf("Error getting Keycloak roles of realm %s: %v", realm, err)
+		return nil, nil, err
+	}
+	for roleName, attributes := range roles {
+		if slices.Contains(attributes[attrTenantID], tenantID) {
+			roleNames = append(roleNames, roleName)
+		}
+	}
+	slices.Sort(roleNames)
+
+	return groupNames, roleNames, nil
+}
+
+/*
+removeRolesFromGroups does the work for SuspendOrg() and ArchiveProject()
+*/
+func (c *client) removeRolesFromGroups(orgID string, projID string, groups map[string][]string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+	realm := c.realmOf(orgID)
+
+	_, err := ensureRealmExists(c.session, ctx, realm)
+	if err != nil {
+		log.Errorf("Error checking if realm %s exists: %v", realm, err)
 		return err
 	}
 
-	for _, group := range groups {
-		if strings.Contains(group.Name, id) {
-			if err = deleteGroup(c.session, ctx, c.keycloakRealm, group.ID); err != nil {
-				log.Errorf("Error deleting group %s: %v", group, err)
-				return err
-			} else {
-				log.Infof("Group %s deleted", group.Name)
-			}
+	groupNames, _ := tenantNames(orgID, projID, groups)
+	for _, groupName := range groupNames {
+		group, err := getGroupByName(c.session, ctx, realm, groupName)
+		if errors.Is(err, errGroupNotFound) {
+			log.Infof("Group %s does not exist", groupName)
+			continue
+		} else if err != nil {
+			log.Errorf("Error getting group %s: %v", groupName, err)
+			return err
 		}
+
+		if c.dryRun {
+			log.Infof("Dry run: roles would be removed from group %s", groupName)
+			continue
+		}
+		if err = removeRolesFromGroup(c.session, ctx, realm, *group.ID); err != nil {
+			log.Errorf("Error removing roles from group %s: %v", groupName, err)
+			return err
+		}
+		log.Infof("Roles removed from group %s", groupName)
 	}
 
 	return nil
 }
 
+/*
+orphanReport does the work for OrphanReport()
+*/
+func (c *client) orphanReport(tenantIDs []string) (*OrphanReport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	report := &OrphanReport{}
+	orphanOf := func(attributes map[string][]string) (string, bool) {
+		if !slices.Contains(attributes[attrManagedBy], common.AppName) || len(attributes[attrTenantID]) == 0 {
+			return "", false
+		}
+		tenantID := attributes[attrTenantID][0]
+		return tenantID, !slices.Contains(tenantIDs, tenantID)
+	}
+
+	realms, err := c.orphanIsolation(ctx, tenantIDs, report)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, realm := range realms {
+		orphanRealm := ""
+		if realm != c.keycloakRealm {
+			orphanRealm = realm
+		}
+
+		roles, err := getManagedRoles(c.session, ctx, realm, common.AppName)
+		if err != nil {
+			log.Errorf("Error getting Keycloak roles of realm %s: %v", realm, err)
+			return nil, err
+		}
+		for roleName, attributes := range roles {
+			if tenantID, orphan := orphanOf(attributes); orphan {
+				report.Roles = append(report.Roles, Orphan{Name: roleName, TenantID: tenantID, Realm: orphanRealm})
+			}
+		}
+
+		groups, err := getGroups(c.session, ctx, realm)
+		if err != nil {
+			log.Errorf("Error getting Keycloak groups of realm %s: %v", realm, err)
+			return nil, err
+		}
+		for _, group := range groups {
+			if tenantID, orphan := orphanOf(group.Attributes); orphan {
+				report.Groups = append(report.Groups, Orphan{Name: group.Name, TenantID: tenantID, Realm: orphanRealm})
+			}
+		}
+	}
+
+	for _, orphans := range [][]Orphan{report.Roles, report.Groups, report.Realms, report.Organizations} {
+		slices.SortFunc(orphans, func(a, b Orphan) int {
+			return cmp.Or(strings.Compare(a.Realm, b.Realm), strings.Compare(a.Name, b.Name))
+		})
+	}
+	return report, nil
+}
+
 /*
 checkRolesAndGroupsCreated was introduced after discovering a bug where Keycloak returns 200, but roles and groups were not created
 */
 func (c *client) checkRolesAndGroupsCreated(orgID string, projID string, groups map[string][]string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
 	defer cancel()
+	realm := c.realmOf(orgID)
 
 	contains := func(existingGroups []*GroupRepresentation, groupName string) bool {
 		for _, existingGroup := range existingGroups {
@@ -303,12 +688,12 @@ func (c *client) checkRolesAndGroupsCreated(orgID string, projID string, groups
 		return false
 	}
 
-	existingGroups, err := getGroups(c.session, ctx, c.keycloakRealm)
+	existingGroups, err := getGroups(c.session, ctx, realm)
 	if err != nil {
 		return err
 	}
 
-	existingRoles, err := getRoles(c.session, ctx, c.keycloakRealm)
+	existingRoles, err := getRoles(c.session, ctx, realm)
 	if err != nil {
 		return err
 	}
@@ -318,14 +703,14 @@ func (c *client) checkRolesAndGroupsCreated(orgID string, projID string, groups
 		groupName = strings.ReplaceAll(groupName, projPrefix, projID)
 
 		if !contains(existingGroups, groupName) {
-			return fmt.Errorf("keycloak realm %s does not contain group %s", c.keycloakRealm, groupName)
+			return fmt.Errorf("keycloak realm %s does not contain group %s", realm, groupName)
 		}
 
 		for _, roleName := range roleNames {
 			roleName = strings.ReplaceAll(roleName, orgPrefix, orgID)
 			roleName = strings.ReplaceAll(roleName, projPrefix, projID)
 			if !slices.Contains(existingRoles, roleName) {
-				return fmt.Errorf("keycloak realm %s does not contain role %s", c.keycloakRealm, roleName)
+				return fmt.Errorf("keycloak realm %s does not contain role %s", realm, roleName)
 			}
 		}
 	}
@@ -333,6 +718,91 @@ func (c *client) checkRolesAndGroupsCreated(orgID string, projID string, groups
 	return nil
 }
 
+/*
+tenantOf returns the id of the tenant of the roles and groups of the org orgID, or of its project projID
+*/
+func tenantOf(orgID string, projID string) string {
+	if projID != "" {
+		return projID
+	}
+	return orgID
+}
+
+/*
+managedAttributes returns the attributes of the role or group of a template name, for the org orgID or its project projID.
+Only the names holding the prefix of the tenant belong to it: the others are shared.
+*/
+func managedAttributes(template string, orgID string, projID string) map[string][]string {
+	attributes := map[string][]string{attrManagedBy: {common.AppName}}
+	switch {
+	case projID != "" && strings.Contains(template, projPrefix):
+		attributes[attrTenantID] = []string{projID}
+	case projID == "" && orgID != "" && strings.Contains(template, orgPrefix):
+		attributes[attrTenantID] = []string{orgID}
+	}
+	return attributes
+}
+
+/*
+tenantNames returns the exact names of the groups and roles of the templates that belong to the org orgID, or to its project projID.
+*/
+func tenantNames(orgID string, projID string, groups map[string][]string) ([]string, []string) {
+	prefix := orgPrefix
+	if projID != "" {
+		prefix = projPrefix
+	}
+
+	var groupNames, roleNames []string
+	for groupTemplate, roleTemplates := range groups {
+		if strings.Contains(groupTemplate, prefix) {
+			groupNames = append(groupNames, templates.Expand(groupTemplate, orgID, projID))
+		}
+		for _, roleTemplate := range roleTemplates {
+			roleName := templates.Expand(roleTemplate, orgID, projID)
+			if strings.Contains(roleTemplate, prefix) && !slices.Contains(roleNames, roleName) {
+				roleNames = append(roleNames, roleName)
+			}
+		}
+	}
+	return groupNames, roleNames
+}
+
+/*
+appendMissing appends the names that names does not hold yet.
+*/
+func appendMissing(names []string, others []string) []string {
+	for _, name := range others {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+/*
+ownedBy returns true if the attributes of a role or group do not tie it to another application or tenant than tenantID.
+Roles and groups created before KTC set attributes have none, and are matched by their exact name only.
+*/
+func ownedBy(attributes *map[string][]string, tenantID string) bool {
+	if attributes == nil {
+		return true
+	}
+	if managedBy, ok := (*attributes)[attrManagedBy]; ok && !slices.Contains(managedBy, common.AppName) {
+		return false
+	}
+	if tenantIDs, ok := (*attributes)[attrTenantID]; ok && !slices.Contains(tenantIDs, tenantID) {
+		return false
+	}
+	return true
+}
+
+/*
+isErrorForNotFound returns true if the error provided is a 404 "not found"
+*/
+func isErrorForNotFound(err error) bool {
+	return strings.Contains(err.Error(), "404")
+}
+
 /*
 isErrorForAlreadyExists returns true if the error provided is a 409 "already exists"
 */
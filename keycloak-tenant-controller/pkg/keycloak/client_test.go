@@ -0,0 +1,199 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keycloak
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/common"
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/templates"
+)
+
+var testTemplates = &templates.Templates{
+	OrgGroups: map[string][]string{
+		"<org-id>_Project-Manager-Group": {"<org-id>_project-read-role", "<org-id>_project-write-role"},
+	},
+	ProjGroups: map[string][]string{
+		"<org-id>_<project-id>_Edge-Operator-Group": {"<org-id>_<project-id>_cat-r", "account/view-profile"},
+	},
+}
+
+func TestOwnedBy(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes *map[string][]string
+		want       bool
+	}{
+		{
+			name: "created before the attributes",
+			want: true,
+		},
+		{
+			name:       "managed for the tenant",
+			attributes: &map[string][]string{attrManagedBy: {common.AppName}, attrTenantID: {"acme"}},
+			want:       true,
+		},
+		{
+			name:       "shared by the tenants",
+			attributes: &map[string][]string{attrManagedBy: {common.AppName}},
+			want:       true,
+		},
+		{
+			name:       "managed for another tenant",
+			attributes: &map[string][]string{attrManagedBy: {common.AppName}, attrTenantID: {"acme-eu"}},
+			want:       false,
+		},
+		{
+			name:       "managed by another application",
+			attributes: &map[string][]string{attrManagedBy: {"other-app"}},
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ownedBy(tt.attributes, "acme"); got != tt.want {
+				t.Errorf("ownedBy = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeleteOrgKeepsOtherTenants(t *testing.T) {
+	c, keycloak := newTestClient(t, ModeSingleRealm, testTemplates, false)
+	keycloak.addTenant(testRealm, "acme", "", testTemplates.OrgGroups)
+	keycloak.addTenant(testRealm, "acme-eu", "", testTemplates.OrgGroups)
+	keycloak.addTenant(testRealm, "xacme", "", testTemplates.OrgGroups)
+
+	if err := c.DeleteOrg("acme"); err != nil {
+		t.Fatalf("DeleteOrg: %v", err)
+	}
+
+	wantGroups := []string{"acme-eu_Project-Manager-Group", "xacme_Project-Manager-Group"}
+	if got := keycloak.groupNames(testRealm); !reflect.DeepEqual(got, wantGroups) {
+		t.Errorf("groups %v, want %v", got, wantGroups)
+	}
+	wantRoles := []string{
+		"acme-eu_project-read-role", "acme-eu_project-write-role",
+		"xacme_project-read-role", "xacme_project-write-role",
+	}
+	if got := keycloak.roleNames(testRealm); !reflect.DeepEqual(got, wantRoles) {
+		t.Errorf("roles %v, want %v", got, wantRoles)
+	}
+}
+
+func TestDeleteOrgKeepsGroupsAndRolesOwnedByOthers(t *testing.T) {
+	c, keycloak := newTestClient(t, ModeSingleRealm, testTemplates, false)
+	keycloak.addGroup(testRealm, "acme_Project-Manager-Group", managed("acme-eu"))
+	keycloak.addRole(testRealm, "acme_project-read-role", map[string][]string{attrManagedBy: {"other-app"}})
+	keycloak.addRole(testRealm, "acme_project-write-role", nil)
+
+	if err := c.DeleteOrg("acme"); err != nil {
+		t.Fatalf("DeleteOrg: %v", err)
+	}
+
+	wantGroups := []string{"acme_Project-Manager-Group"}
+	if got := keycloak.groupNames(testRealm); !reflect.DeepEqual(got, wantGroups) {
+		t.Errorf("groups %v, want the group of another tenant kept", got)
+	}
+	// The role without attributes predates them, and is matched by its exact name.
+	wantRoles := []string{"acme_project-read-role"}
+	if got := keycloak.roleNames(testRealm); !reflect.DeepEqual(got, wantRoles) {
+		t.Errorf("roles %v, want the role of another application kept", got)
+	}
+}
+
+func TestDeleteOrgDeletesGroupsAndRolesOfRemovedTemplates(t *testing.T) {
+	tmpl := *testTemplates
+	tmpl.OrgGroups = map[string][]string{
+		"<org-id>_Project-Manager-Group": {"<org-id>_project-read-role", "<org-id>_project-write-role"},
+		"<org-id>_Retired-Group":         {"<org-id>_retired-role"},
+	}
+	c, keycloak := newTestClient(t, ModeSingleRealm, &tmpl, false)
+	keycloak.addTenant(testRealm, "acme-eu", "", tmpl.OrgGroups)
+	if err := c.CreateOrg("acme"); err != nil {
+		t.Fatalf("CreateOrg: %v", err)
+	}
+
+	// The retired group and its role are removed from the templates once the org is created.
+	c.SetTemplates(testTemplates)
+	if err := c.DeleteOrg("acme"); err != nil {
+		t.Fatalf("DeleteOrg: %v", err)
+	}
+
+	wantGroups := []string{"acme-eu_Project-Manager-Group", "acme-eu_Retired-Group"}
+	if got := keycloak.groupNames(testRealm); !reflect.DeepEqual(got, wantGroups) {
+		t.Errorf("groups %v, want %v", got, wantGroups)
+	}
+	wantRoles := []string{"acme-eu_project-read-role", "acme-eu_project-write-role", "acme-eu_retired-role"}
+	if got := keycloak.roleNames(testRealm); !reflect.DeepEqual(got, wantRoles) {
+		t.Errorf("roles %v, want %v", got, wantRoles)
+	}
+}
+
+func TestDeleteProjectKeepsOtherProjectsAndSharedRoles(t *testing.T) {
+	c, keycloak := newTestClient(t, ModeSingleRealm, testTemplates, false)
+	keycloak.addRole(testRealm, "account/view-profile", map[string][]string{attrManagedBy: {common.AppName}})
+	keycloak.addTenant(testRealm, "acme", "p1", testTemplates.ProjGroups)
+	keycloak.addTenant(testRealm, "acme", "p10", testTemplates.ProjGroups)
+
+	if err := c.DeleteProject("acme", "p1"); err != nil {
+		t.Fatalf("DeleteProject: %v", err)
+	}
+
+	wantGroups := []string{"acme_p10_Edge-Operator-Group"}
+	if got := keycloak.groupNames(testRealm); !reflect.DeepEqual(got, wantGroups) {
+		t.Errorf("groups %v, want %v", got, wantGroups)
+	}
+	wantRoles := []string{"account/view-profile", "acme_p10_cat-r"}
+	if got := keycloak.roleNames(testRealm); !reflect.DeepEqual(got, wantRoles) {
+		t.Errorf("roles %v, want %v", got, wantRoles)
+	}
+}
+
+func TestDryRunRemovesNothing(t *testing.T) {
+	tmpl := *testTemplates
+	tmpl.PruneMappings = true
+	c, keycloak := newTestClient(t, ModeSingleRealm, &tmpl, true)
+	// The group of the org is mapped to a role its template no longer lists.
+	keycloak.addTenant(testRealm, "acme", "", map[string][]string{
+		"<org-id>_Project-Manager-Group": {
+			"<org-id>_project-read-role", "<org-id>_project-write-role", "<org-id>_retired-role",
+		},
+	})
+	keycloak.addTenant(testRealm, "acme", "p1", tmpl.ProjGroups)
+	groups, roles := keycloak.groupNames(testRealm), keycloak.roleNames(testRealm)
+	mappings := keycloak.groupRoles(testRealm, "acme_Project-Manager-Group")
+
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{"ReconcileOrg", func() error { return c.ReconcileOrg("acme") }},
+		{"ArchiveProject", func() error { return c.ArchiveProject("acme", "p1") }},
+		{"DeleteProject", func() error { return c.DeleteProject("acme", "p1") }},
+		{"SuspendOrg", func() error { return c.SuspendOrg("acme") }},
+		{"DeleteOrg", func() error { return c.DeleteOrg("acme") }},
+	}
+	for _, step := range steps {
+		if err := step.run(); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+	}
+
+	if got := keycloak.removalRequests(); len(got) != 0 {
+		t.Errorf("dry run sent %v", got)
+	}
+	if got := keycloak.groupNames(testRealm); !reflect.DeepEqual(got, groups) {
+		t.Errorf("groups %v, want %v kept", got, groups)
+	}
+	if got := keycloak.roleNames(testRealm); !reflect.DeepEqual(got, roles) {
+		t.Errorf("roles %v, want %v kept", got, roles)
+	}
+	if got := keycloak.groupRoles(testRealm, "acme_Project-Manager-Group"); !reflect.DeepEqual(got, mappings) {
+		t.Errorf("roles of the org group %v, want %v kept", got, mappings)
+	}
+}
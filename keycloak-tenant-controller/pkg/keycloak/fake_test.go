@@ -0,0 +1,453 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keycloak
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Clarilab/gocloaksession"
+	"github.com/Nerzal/gocloak/v13"
+
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/internal/pointer"
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/common"
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/templates"
+)
+
+const testRealm = "master"
+
+// fakeSession is a Keycloak session on a fake Keycloak, with a token that never expires.
+type fakeSession struct {
+	gocloaksession.GoCloakSession
+	keycloak *gocloak.GoCloak
+}
+
+func (s *fakeSession) GetKeycloakAuthToken() (*gocloak.JWT, error) {
+	return &gocloak.JWT{AccessToken: "token"}, nil
+}
+
+func (s *fakeSession) GetGoCloakInstance() *gocloak.GoCloak {
+	return s.keycloak
+}
+
+/*
+fakeRealm is the state of a realm of the fake Keycloak. The role mappings are the role names of each group ID,
+and the Organizations are kept as JSON objects, by ID.
+*/
+type fakeRealm struct {
+	realm         gocloak.RealmRepresentation
+	roles         map[string]gocloak.Role
+	groups        map[string]gocloak.Group
+	mappings      map[string][]string
+	organizations map[string]map[string]any
+}
+
+/*
+fakeKeycloak serves the parts of the Keycloak admin API used by the client from memory, and records the
+requests removing something from it.
+*/
+type fakeKeycloak struct {
+	mu       sync.Mutex
+	realms   map[string]*fakeRealm
+	nextID   int
+	removals []string
+}
+
+func newFakeKeycloak() *fakeKeycloak {
+	k := &fakeKeycloak{realms: map[string]*fakeRealm{}}
+	k.addRealm(testRealm, nil)
+	return k
+}
+
+func (k *fakeKeycloak) addRealm(name string, attributes map[string]string) *fakeRealm {
+	realm := &fakeRealm{
+		realm:         gocloak.RealmRepresentation{Realm: pointer.Of(name), Enabled: pointer.Of(true)},
+		roles:         map[string]gocloak.Role{},
+		groups:        map[string]gocloak.Group{},
+		mappings:      map[string][]string{},
+		organizations: map[string]map[string]any{},
+	}
+	if attributes != nil {
+		realm.realm.Attributes = &attributes
+	}
+	k.realms[name] = realm
+	return realm
+}
+
+func (k *fakeKeycloak) newID() string {
+	k.nextID++
+	return fmt.Sprintf("id-%d", k.nextID)
+}
+
+// addGroup adds a group with the roles mapped to it to realm.
+func (k *fakeKeycloak) addGroup(realm, name string, attributes map[string][]string, roleNames ...string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	id := k.newID()
+	k.realms[realm].groups[id] = gocloak.Group{ID: pointer.Of(id), Name: pointer.Of(name), Attributes: &attributes}
+	k.realms[realm].mappings[id] = roleNames
+}
+
+func (k *fakeKeycloak) addRole(realm, name string, attributes map[string][]string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.realms[realm].roles[name] = gocloak.Role{ID: pointer.Of(k.newID()), Name: pointer.Of(name), Attributes: &attributes}
+}
+
+// addTenant adds the groups and roles of the templates created by KTC for the org orgID, or its project projID,
+// to realm.
+func (k *fakeKeycloak) addTenant(realm, orgID, projID string, groups map[string][]string) {
+	for groupTemplate, roleTemplates := range groups {
+		var roleNames []string
+		for _, roleTemplate := range roleTemplates {
+			roleName := templates.Expand(roleTemplate, orgID, projID)
+			k.addRole(realm, roleName, managedAttributes(roleTemplate, orgID, projID))
+			roleNames = append(roleNames, roleName)
+		}
+		k.addGroup(realm, templates.Expand(groupTemplate, orgID, projID),
+			managedAttributes(groupTemplate, orgID, projID), roleNames...)
+	}
+}
+
+func (k *fakeKeycloak) groupNames(realm string) []string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	var names []string
+	for _, group := range k.realms[realm].groups {
+		names = append(names, *group.Name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (k *fakeKeycloak) roleNames(realm string) []string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	var names []string
+	for name := range k.realms[realm].roles {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// groupRoles returns the names of the roles mapped to the group of name in realm.
+func (k *fakeKeycloak) groupRoles(realm, name string) []string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	for id, group := range k.realms[realm].groups {
+		if *group.Name == name {
+			return slices.Sorted(slices.Values(k.realms[realm].mappings[id]))
+		}
+	}
+	return nil
+}
+
+func (k *fakeKeycloak) realmNames() []string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return slices.Sorted(maps.Keys(k.realms))
+}
+
+// addOrganization adds an Organization, with its alias and attributes, to realm.
+func (k *fakeKeycloak) addOrganization(realm, alias string, attributes map[string][]string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	id := k.newID()
+	k.realms[realm].organizations[id] = map[string]any{"id": id, "name": alias, "alias": alias, "attributes": attributes}
+}
+
+func (k *fakeKeycloak) organizations(realm string) []map[string]any {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return slices.Collect(maps.Values(k.realms[realm].organizations))
+}
+
+// realm returns the representation of the realm of name, or nil if it does not exist.
+func (k *fakeKeycloak) realm(name string) *gocloak.RealmRepresentation {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if realm, ok := k.realms[name]; ok {
+		return &realm.realm
+	}
+	return nil
+}
+
+func (k *fakeKeycloak) removalRequests() []string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return slices.Clone(k.removals)
+}
+
+func (k *fakeKeycloak) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	path, ok := strings.CutPrefix(r.URL.Path, "/admin/realms")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	segments := strings.FieldsFunc(path, func(c rune) bool { return c == '/' })
+	if r.Method == http.MethodDelete {
+		k.removals = append(k.removals, r.Method+" "+r.URL.Path)
+	}
+
+	if len(segments) == 0 {
+		k.serveRealms(w, r)
+		return
+	}
+	realm, ok := k.realms[segments[0]]
+	if !ok {
+		http.Error(w, `{"error":"Realm not found."}`, http.StatusNotFound)
+		return
+	}
+	switch {
+	case len(segments) == 1:
+		k.serveRealm(w, r, realm)
+	case segments[1] == "roles":
+		k.serveRoles(w, r, realm, segments[2:])
+	case segments[1] == "groups":
+		k.serveGroups(w, r, realm, segments[2:])
+	case segments[1] == "organizations":
+		k.serveOrganizations(w, r, realm, segments[2:])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (k *fakeKeycloak) serveRealms(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		var realms []gocloak.RealmRepresentation
+		for _, realm := range k.realms {
+			realms = append(realms, realm.realm)
+		}
+		writeJSON(w, realms)
+	case http.MethodPost:
+		var rep gocloak.RealmRepresentation
+		if !readJSON(w, r, &rep) {
+			return
+		}
+		if _, ok := k.realms[*rep.Realm]; ok {
+			http.Error(w, `{"errorMessage":"Conflict detected."}`, http.StatusConflict)
+			return
+		}
+		k.addRealm(*rep.Realm, nil).realm = rep
+		w.WriteHeader(http.StatusCreated)
+	default:
+		http.Error(w, "", http.StatusMethodNotAllowed)
+	}
+}
+
+func (k *fakeKeycloak) serveRealm(w http.ResponseWriter, r *http.Request, realm *fakeRealm) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, realm.realm)
+	case http.MethodDelete:
+		delete(k.realms, *realm.realm.Realm)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "", http.StatusMethodNotAllowed)
+	}
+}
+
+func (k *fakeKeycloak) serveRoles(w http.ResponseWriter, r *http.Request, realm *fakeRealm, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		roles := []gocloak.Role{}
+		for _, role := range realm.roles {
+			roles = append(roles, role)
+		}
+		writeJSON(w, roles)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var role gocloak.Role
+		if !readJSON(w, r, &role) {
+			return
+		}
+		if _, ok := realm.roles[*role.Name]; ok {
+			http.Error(w, `{"errorMessage":"Role already exists"}`, http.StatusConflict)
+			return
+		}
+		role.ID = pointer.Of(k.newID())
+		realm.roles[*role.Name] = role
+		w.Header().Set("Location", r.URL.String()+"/"+*role.Name)
+		w.WriteHeader(http.StatusCreated)
+	case len(segments) == 1:
+		role, ok := realm.roles[segments[0]]
+		if !ok {
+			http.Error(w, `{"error":"Could not find role"}`, http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodDelete {
+			delete(realm.roles, segments[0])
+			for id, roleNames := range realm.mappings {
+				realm.mappings[id] = slices.DeleteFunc(roleNames, func(name string) bool { return name == segments[0] })
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(w, role)
+	default:
+		http.Error(w, "", http.StatusMethodNotAllowed)
+	}
+}
+
+func (k *fakeKeycloak) serveGroups(w http.ResponseWriter, r *http.Request, realm *fakeRealm, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		// Keycloak searches the group names for a substring, ignoring the case.
+		search := strings.ToLower(r.URL.Query().Get("search"))
+		groups := []gocloak.Group{}
+		for _, group := range realm.groups {
+			if strings.Contains(strings.ToLower(*group.Name), search) {
+				groups = append(groups, group)
+			}
+		}
+		writeJSON(w, groups)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var group gocloak.Group
+		if !readJSON(w, r, &group) {
+			return
+		}
+		for _, existing := range realm.groups {
+			if *existing.Name == *group.Name {
+				http.Error(w, `{"errorMessage":"Top level group named already exists."}`, http.StatusConflict)
+				return
+			}
+		}
+		group.ID = pointer.Of(k.newID())
+		realm.groups[*group.ID] = group
+		w.Header().Set("Location", r.URL.String()+"/"+*group.ID)
+		w.WriteHeader(http.StatusCreated)
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		if _, ok := realm.groups[segments[0]]; !ok {
+			http.Error(w, `{"error":"Could not find group by id"}`, http.StatusNotFound)
+			return
+		}
+		delete(realm.groups, segments[0])
+		delete(realm.mappings, segments[0])
+		w.WriteHeader(http.StatusNoContent)
+	case len(segments) >= 2 && segments[1] == "role-mappings":
+		k.serveRoleMappings(w, r, realm, segments[0])
+	default:
+		http.Error(w, "", http.StatusMethodNotAllowed)
+	}
+}
+
+func (k *fakeKeycloak) serveRoleMappings(w http.ResponseWriter, r *http.Request, realm *fakeRealm, groupID string) {
+	if _, ok := realm.groups[groupID]; !ok {
+		http.Error(w, `{"error":"Could not find group by id"}`, http.StatusNotFound)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		roles := []gocloak.Role{}
+		for _, roleName := range realm.mappings[groupID] {
+			roles = append(roles, realm.roles[roleName])
+		}
+		writeJSON(w, gocloak.MappingsRepresentation{RealmMappings: &roles})
+	case http.MethodPost, http.MethodDelete:
+		var roles []gocloak.Role
+		if !readJSON(w, r, &roles) {
+			return
+		}
+		for _, role := range roles {
+			mapped := slices.Contains(realm.mappings[groupID], *role.Name)
+			switch {
+			case r.Method == http.MethodPost && !mapped:
+				realm.mappings[groupID] = append(realm.mappings[groupID], *role.Name)
+			case r.Method == http.MethodDelete:
+				realm.mappings[groupID] = slices.DeleteFunc(realm.mappings[groupID],
+					func(name string) bool { return name == *role.Name })
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "", http.StatusMethodNotAllowed)
+	}
+}
+
+func (k *fakeKeycloak) serveOrganizations(w http.ResponseWriter, r *http.Request, realm *fakeRealm, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		search := r.URL.Query().Get("search")
+		organizations := []map[string]any{}
+		for _, organization := range realm.organizations {
+			if search == "" || organization["name"] == search || organization["alias"] == search {
+				organizations = append(organizations, organization)
+			}
+		}
+		writeJSON(w, organizations)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var organization map[string]any
+		if !readJSON(w, r, &organization) {
+			return
+		}
+		for _, existing := range realm.organizations {
+			if existing["name"] == organization["name"] || existing["alias"] == organization["alias"] {
+				http.Error(w, `{"errorMessage":"A organization with the same name already exists."}`, http.StatusConflict)
+				return
+			}
+		}
+		id := k.newID()
+		organization["id"] = id
+		realm.organizations[id] = organization
+		w.WriteHeader(http.StatusCreated)
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		if _, ok := realm.organizations[segments[0]]; !ok {
+			http.Error(w, `{"error":"Organization not found"}`, http.StatusNotFound)
+			return
+		}
+		delete(realm.organizations, segments[0])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "", http.StatusMethodNotAllowed)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// newTestClient returns a client of a new fake Keycloak, isolating the tenants in mode, with the templates tmpl.
+func newTestClient(t *testing.T, mode string, tmpl *templates.Templates, dryRun bool) (*client, *fakeKeycloak) {
+	t.Helper()
+	keycloak := newFakeKeycloak()
+	server := httptest.NewServer(keycloak)
+	t.Cleanup(server.Close)
+
+	c := &client{
+		session:       &fakeSession{keycloak: gocloak.NewClient(server.URL)},
+		dryRun:        dryRun,
+		tenantMode:    mode,
+		keycloakurl:   server.URL,
+		keycloakRealm: testRealm,
+	}
+	c.setTemplates(tmpl)
+	return c, keycloak
+}
+
+// managed returns the attributes set by KTC on the roles and groups of tenantID.
+func managed(tenantID string) map[string][]string {
+	return map[string][]string{attrManagedBy: {common.AppName}, attrTenantID: {tenantID}}
+}
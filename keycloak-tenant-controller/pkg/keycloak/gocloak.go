@@ -8,6 +8,9 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/Clarilab/gocloaksession"
 	"github.com/Nerzal/gocloak/v13"
@@ -19,8 +22,9 @@ type keycloakSession gocloaksession.GoCloakSession
 
 // https://www.keycloak.org/docs-api/latest/rest-api/index.html#GroupRepresentation
 type GroupRepresentation struct {
-	ID   string
-	Name string
+	ID         string
+	Name       string
+	Attributes map[string][]string
 }
 
 /*
@@ -63,9 +67,9 @@ func ensureRealmExists(session keycloakSession, ctx context.Context, realmName s
 }
 
 /*
-createRole creates a new role with a sepcified name within a specified realm in Keycloak
+createRole creates a new role with a sepcified name and specified attributes within a specified realm in Keycloak
 */
-func createRole(session keycloakSession, ctx context.Context, realm string, role string) error {
+func createRole(session keycloakSession, ctx context.Context, realm string, role string, attributes map[string][]string) error {
 	keycloakClient := session.GetGoCloakInstance()
 
 	jwt, err := session.GetKeycloakAuthToken()
@@ -74,7 +78,8 @@ func createRole(session keycloakSession, ctx context.Context, realm string, role
 	}
 
 	newRole := gocloak.Role{
-		Name: &role,
+		Name:       &role,
+		Attributes: &attributes,
 	}
 
 	if _, err := keycloakClient.CreateRealmRole(ctx, jwt.AccessToken, realm, newRole); err != nil {
@@ -107,6 +112,53 @@ func getRoles(session gocloaksession.GoCloakSession, ctx context.Context, realm
 	return rolesStr, nil
 }
 
+/*
+getRoleByName returns the role, with its attributes, that matches a specified role name within a specified realm in Keycloak
+*/
+func getRoleByName(session keycloakSession, ctx context.Context, realm string, roleName string) (*gocloak.Role, error) {
+	keycloakClient := session.GetGoCloakInstance()
+
+	jwt, err := session.GetKeycloakAuthToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get json web token: %v", err)
+	}
+
+	role, err := keycloakClient.GetRealmRole(ctx, jwt.AccessToken, realm, roleName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role %s in realm %s: %v", roleName, realm, err)
+	}
+
+	return role, nil
+}
+
+/*
+getManagedRoles returns the name and the attributes of the roles with a specified managed-by attribute within a specified realm in Keycloak
+*/
+func getManagedRoles(session keycloakSession, ctx context.Context, realm string, managedBy string) (map[string]map[string][]string, error) {
+	keycloakClient := session.GetGoCloakInstance()
+
+	jwt, err := session.GetKeycloakAuthToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get json web token: %v", err)
+	}
+
+	roles, err := keycloakClient.GetRealmRoles(ctx, jwt.AccessToken, realm, gocloak.GetRoleParams{
+		BriefRepresentation: gocloak.BoolP(false),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles in realm %s: %v", realm, err)
+	}
+
+	managedRoles := map[string]map[string][]string{}
+	for _, role := range roles {
+		if role.Name == nil || role.Attributes == nil || !slices.Contains((*role.Attributes)[attrManagedBy], managedBy) {
+			continue
+		}
+		managedRoles[*role.Name] = *role.Attributes
+	}
+	return managedRoles, nil
+}
+
 /*
 deleteRole deletes a specified role within a specified realm in Keycloak
 */
@@ -126,9 +178,9 @@ func deleteRole(session keycloakSession, ctx context.Context, realm string, role
 }
 
 /*
-createGroup creates a new group within a specified realm in Keycloak
+createGroup creates a new group with specified attributes within a specified realm in Keycloak
 */
-func createGroup(session keycloakSession, ctx context.Context, realm, groupName string) error {
+func createGroup(session keycloakSession, ctx context.Context, realm, groupName string, attributes map[string][]string) error {
 	keycloakClient := session.GetGoCloakInstance()
 
 	jwt, err := session.GetKeycloakAuthToken()
@@ -137,7 +189,8 @@ func createGroup(session keycloakSession, ctx context.Context, realm, groupName
 	}
 
 	group := gocloak.Group{
-		Name: &groupName,
+		Name:       &groupName,
+		Attributes: &attributes,
 	}
 
 	if _, err := keycloakClient.CreateGroup(ctx, jwt.AccessToken, realm, group); err != nil {
@@ -148,7 +201,7 @@ func createGroup(session keycloakSession, ctx context.Context, realm, groupName
 }
 
 /*
-getGroups returns a list of all groups within a specified realm in Keycloak
+getGroups returns a list of all groups, with their attributes, within a specified realm in Keycloak
 */
 func getGroups(session keycloakSession, ctx context.Context, realm string) ([]*GroupRepresentation, error) {
 	keycloakClient := session.GetGoCloakInstance()
@@ -158,7 +211,9 @@ func getGroups(session keycloakSession, ctx context.Context, realm string) ([]*G
 		return nil, fmt.Errorf("failed to get json web token: %v", err)
 	}
 
-	groups, err := keycloakClient.GetGroups(ctx, jwt.AccessToken, realm, gocloak.GetGroupsParams{})
+	groups, err := keycloakClient.GetGroups(ctx, jwt.AccessToken, realm, gocloak.GetGroupsParams{
+		BriefRepresentation: gocloak.BoolP(false),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get groups in realm %s: %v", realm, err)
 	}
@@ -169,6 +224,9 @@ func getGroups(session keycloakSession, ctx context.Context, realm string) ([]*G
 			Name: *group.Name,
 			ID:   *group.ID,
 		}
+		if group.Attributes != nil {
+			g.Attributes = *group.Attributes
+		}
 		groupReps = append(groupReps, g)
 	}
 
@@ -221,6 +279,74 @@ func addRolesToGroup(session keycloakSession, ctx context.Context, realm string,
 	return nil
 }
 
+/*
+removeRolesFromGroup removes all the realm roles mapped to a specified group within a specified realm in Keycloak
+*/
+func removeRolesFromGroup(session keycloakSession, ctx context.Context, realm, groupID string) error {
+	keycloakClient := session.GetGoCloakInstance()
+
+	jwt, err := session.GetKeycloakAuthToken()
+	if err != nil {
+		return fmt.Errorf("failed to get json web token: %v", err)
+	}
+
+	mappings, err := keycloakClient.GetRoleMappingByGroupID(ctx, jwt.AccessToken, realm, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to get role mappings of group %s in realm %s: %v", groupID, realm, err)
+	}
+
+	if mappings.RealmMappings == nil || len(*mappings.RealmMappings) == 0 {
+		return nil
+	}
+
+	if err := keycloakClient.DeleteRealmRoleFromGroup(ctx, jwt.AccessToken, realm, groupID, *mappings.RealmMappings); err != nil {
+		return fmt.Errorf("failed to remove roles from group %s in realm %s: %v", groupID, realm, err)
+	}
+
+	return nil
+}
+
+/*
+removeOtherRolesFromGroup removes the realm roles mapped to a specified group that are not in a specified list of role names,
+within a specified realm in Keycloak, and returns the names of the removed roles. With dryRun, they are only returned.
+*/
+func removeOtherRolesFromGroup(session keycloakSession, ctx context.Context, realm, groupID string, roleNames []string, dryRun bool) ([]string, error) {
+	keycloakClient := session.GetGoCloakInstance()
+
+	jwt, err := session.GetKeycloakAuthToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get json web token: %v", err)
+	}
+
+	mappings, err := keycloakClient.GetRoleMappingByGroupID(ctx, jwt.AccessToken, realm, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role mappings of group %s in realm %s: %v", groupID, realm, err)
+	}
+
+	if mappings.RealmMappings == nil {
+		return nil, nil
+	}
+
+	var rolesToRemove []gocloak.Role
+	var removedRoleNames []string
+	for _, role := range *mappings.RealmMappings {
+		if role.Name != nil && !slices.Contains(roleNames, *role.Name) {
+			rolesToRemove = append(rolesToRemove, role)
+			removedRoleNames = append(removedRoleNames, *role.Name)
+		}
+	}
+
+	if len(rolesToRemove) == 0 || dryRun {
+		return removedRoleNames, nil
+	}
+
+	if err := keycloakClient.DeleteRealmRoleFromGroup(ctx, jwt.AccessToken, realm, groupID, rolesToRemove); err != nil {
+		return nil, fmt.Errorf("failed to remove roles from group %s in realm %s: %v", groupID, realm, err)
+	}
+
+	return removedRoleNames, nil
+}
+
 /*
 getRolesByNames returns an array of role objects that match specified role names within a specified realm in Keycloak
 */
@@ -257,7 +383,7 @@ func getRolesByNames(session keycloakSession, ctx context.Context, realm string,
 }
 
 /*
-getGroupByName returns a role objects that matches a specified group name within a specified realm in Keycloak
+getGroupByName returns a group object, with its attributes, that matches a specified group name within a specified realm in Keycloak
 */
 func getGroupByName(session keycloakSession, ctx context.Context, realm, groupName string) (*gocloak.Group, error) {
 	keycloakClient := session.GetGoCloakInstance()
@@ -268,7 +394,8 @@ func getGroupByName(session keycloakSession, ctx context.Context, realm, groupNa
 	}
 
 	params := gocloak.GetGroupsParams{
-		Search: &groupName,
+		Search:              &groupName,
+		BriefRepresentation: gocloak.BoolP(false),
 	}
 
 	groups, err := keycloakClient.GetGroups(ctx, jwt.AccessToken, realm, params)
@@ -282,5 +409,180 @@ func getGroupByName(session keycloakSession, ctx context.Context, realm, groupNa
 		}
 	}
 
-	return nil, fmt.Errorf("group %s not found in realm %s", groupName, realm)
+	return nil, fmt.Errorf("group %s in realm %s: %w", groupName, realm, errGroupNotFound)
+}
+
+/*
+createRealm creates a new realm from a specified realm representation in Keycloak
+*/
+func createRealm(session keycloakSession, ctx context.Context, realm gocloak.RealmRepresentation) error {
+	keycloakClient := session.GetGoCloakInstance()
+
+	jwt, err := session.GetKeycloakAuthToken()
+	if err != nil {
+		return fmt.Errorf("failed to get json web token: %v", err)
+	}
+
+	if _, err := keycloakClient.CreateRealm(ctx, jwt.AccessToken, realm); err != nil {
+		return fmt.Errorf("failed to create realm %s: %v", *realm.Realm, err)
+	}
+
+	return nil
+}
+
+/*
+getRealm returns the representation of a specified realm in Keycloak
+*/
+func getRealm(session keycloakSession, ctx context.Context, realmName string) (*gocloak.RealmRepresentation, error) {
+	keycloakClient := session.GetGoCloakInstance()
+
+	jwt, err := session.GetKeycloakAuthToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get json web token: %v", err)
+	}
+
+	realm, err := keycloakClient.GetRealm(ctx, jwt.AccessToken, realmName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get realm %s: %v", realmName, err)
+	}
+
+	return realm, nil
+}
+
+/*
+getManagedRealms returns the name and the attributes of the realms with a specified managed-by attribute in Keycloak
+*/
+func getManagedRealms(session keycloakSession, ctx context.Context, managedBy string) (map[string]map[string]string, error) {
+	keycloakClient := session.GetGoCloakInstance()
+
+	jwt, err := session.GetKeycloakAuthToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get json web token: %v", err)
+	}
+
+	realms, err := keycloakClient.GetRealms(ctx, jwt.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get realms: %v", err)
+	}
+
+	managedRealms := map[string]map[string]string{}
+	for _, realm := range realms {
+		if realm.Realm == nil || realm.Attributes == nil || (*realm.Attributes)[attrManagedBy] != managedBy {
+			continue
+		}
+		managedRealms[*realm.Realm] = *realm.Attributes
+	}
+	return managedRealms, nil
+}
+
+/*
+deleteRealm deletes a specified realm in Keycloak
+*/
+func deleteRealm(session keycloakSession, ctx context.Context, realmName string) error {
+	keycloakClient := session.GetGoCloakInstance()
+
+	jwt, err := session.GetKeycloakAuthToken()
+	if err != nil {
+		return fmt.Errorf("failed to get json web token: %v", err)
+	}
+
+	if err := keycloakClient.DeleteRealm(ctx, jwt.AccessToken, realmName); err != nil {
+		return fmt.Errorf("failed to delete realm %s: %v", realmName, err)
+	}
+
+	return nil
+}
+
+// https://www.keycloak.org/docs-api/latest/rest-api/index.html#OrganizationRepresentation
+type OrganizationRepresentation struct {
+	ID         string              `json:"id,omitempty"`
+	Name       string              `json:"name"`
+	Alias      string              `json:"alias,omitempty"`
+	Attributes map[string][]string `json:"attributes,omitempty"`
+}
+
+/*
+createOrganization creates a new organization from a specified JSON representation within a specified realm in Keycloak.
+gocloak has no support for the organizations, which were introduced in Keycloak 26.
+*/
+func createOrganization(session keycloakSession, ctx context.Context, keycloakurl, realm string, organization map[string]any) error {
+	keycloakClient := session.GetGoCloakInstance()
+
+	jwt, err := session.GetKeycloakAuthToken()
+	if err != nil {
+		return fmt.Errorf("failed to get json web token: %v", err)
+	}
+
+	resp, err := keycloakClient.GetRequestWithBearerAuth(ctx, jwt.AccessToken).
+		SetBody(organization).
+		Post(organizationsURL(keycloakurl, realm))
+	if err != nil {
+		return fmt.Errorf("failed to create organization %v in realm %s: %v", organization["alias"], realm, err)
+	}
+	if resp.IsError() {
+		return fmt.Errorf("failed to create organization %v in realm %s: %d %s", organization["alias"], realm, resp.StatusCode(), resp.String())
+	}
+
+	return nil
+}
+
+/*
+getOrganizations returns the organizations, with their attributes, that match a specified search within a specified realm in Keycloak.
+An empty search returns all the organizations.
+*/
+func getOrganizations(session keycloakSession, ctx context.Context, keycloakurl, realm, search string) ([]OrganizationRepresentation, error) {
+	keycloakClient := session.GetGoCloakInstance()
+
+	jwt, err := session.GetKeycloakAuthToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get json web token: %v", err)
+	}
+
+	var organizations []OrganizationRepresentation
+	req := keycloakClient.GetRequestWithBearerAuth(ctx, jwt.AccessToken).
+		SetQueryParam("briefRepresentation", "false").
+		SetResult(&organizations)
+	if search != "" {
+		req.SetQueryParam("search", search).SetQueryParam("exact", "true")
+	}
+
+	resp, err := req.Get(organizationsURL(keycloakurl, realm))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organizations in realm %s: %v", realm, err)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("failed to get organizations in realm %s: %d %s", realm, resp.StatusCode(), resp.String())
+	}
+
+	return organizations, nil
+}
+
+/*
+deleteOrganization deletes a specified organization within a specified realm in Keycloak
+*/
+func deleteOrganization(session keycloakSession, ctx context.Context, keycloakurl, realm, organizationID string) error {
+	keycloakClient := session.GetGoCloakInstance()
+
+	jwt, err := session.GetKeycloakAuthToken()
+	if err != nil {
+		return fmt.Errorf("failed to get json web token: %v", err)
+	}
+
+	resp, err := keycloakClient.GetRequestWithBearerAuth(ctx, jwt.AccessToken).
+		Delete(organizationsURL(keycloakurl, realm) + "/" + organizationID)
+	if err != nil {
+		return fmt.Errorf("failed to delete organization %s in realm %s: %v", organizationID, realm, err)
+	}
+	if resp.IsError() {
+		return fmt.Errorf("failed to delete organization %s in realm %s: %d %s", organizationID, realm, resp.StatusCode(), resp.String())
+	}
+
+	return nil
+}
+
+/*
+organizationsURL returns the URL of the organizations of a specified realm in Keycloak
+*/
+func organizationsURL(keycloakurl, realm string) string {
+	return strings.TrimSuffix(keycloakurl, "/") + "/admin/realms/" + url.PathEscape(realm) + "/organizations"
 }
@@ -0,0 +1,262 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/Nerzal/gocloak/v13"
+
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/internal/pointer"
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/common"
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/log"
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/templates"
+)
+
+const (
+	envTenantMode = "KEYCLOAK_TENANT_MODE"
+)
+
+// Modes of isolation of the tenants in Keycloak.
+const (
+	// ModeSingleRealm creates the roles and groups of every org and project in the realm of KEYCLOAK_REALM.
+	ModeSingleRealm = "single-realm"
+	// ModeOrgRealm provisions a realm for each org, named after its id, from the realm template, and creates
+	// the roles and groups of the org and its projects in it.
+	ModeOrgRealm = "org-realm"
+	// ModeOrganization provisions a Keycloak Organization for each org, from the organization template, in the
+	// realm of KEYCLOAK_REALM, where the roles and groups of the org and its projects are created.
+	ModeOrganization = "organization"
+)
+
+/*
+validateTenantMode returns an error if mode is not one of the modes of isolation of the tenants
+*/
+func validateTenantMode(mode string) error {
+	switch mode {
+	case ModeSingleRealm, ModeOrgRealm, ModeOrganization:
+		return nil
+	}
+	return fmt.Errorf("invalid %s %q, expected %s, %s or %s", envTenantMode, mode,
+		ModeSingleRealm, ModeOrgRealm, ModeOrganization)
+}
+
+/*
+realmOf returns the realm of the roles and groups of the org orgID and its projects, and of the SI for an empty orgID
+*/
+func (c *client) realmOf(orgID string) string {
+	if c.tenantMode == ModeOrgRealm && orgID != "" {
+		return orgID
+	}
+	return c.keycloakRealm
+}
+
+/*
+provisionOrg creates the realm or the Organization of the org orgID from its template, unless it exists.
+There is nothing to provision in the single realm mode.
+*/
+func (c *client) provisionOrg(orgID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	switch c.tenantMode {
+	case ModeOrgRealm:
+		return c.provisionOrgRealm(ctx, orgID)
+	case ModeOrganization:
+		return c.provisionOrganization(ctx, orgID)
+	}
+	return nil
+}
+
+/*
+provisionOrgRealm creates the realm of the org orgID from the realm template. Its name, its enablement and
+its managed-by and tenant-id attributes are set by KTC.
+*/
+func (c *client) provisionOrgRealm(ctx context.Context, orgID string) error {
+	var realm gocloak.RealmRepresentation
+	if c.realmTemplate != "" {
+		if err := json.Unmarshal([]byte(templates.Expand(c.realmTemplate, orgID, "")), &realm); err != nil {
+			return fmt.Errorf("invalid realm template: %w", err)
+		}
+	}
+	realm.Realm = pointer.Of(c.realmOf(orgID))
+	realm.Enabled = pointer.Of(true)
+	attributes := map[string]string{}
+	if realm.Attributes != nil {
+		attributes = *realm.Attributes
+	}
+	attributes[attrManagedBy] = common.AppName
+	attributes[attrTenantID] = orgID
+	realm.Attributes = &attributes
+
+	if err := createRealm(c.session, ctx, realm); err != nil {
+		if isErrorForAlreadyExists(err) {
+			log.Infof("Realm %s already exists", *realm.Realm)
+			return nil
+		}
+		log.Errorf("Error creating realm %s: %v", *realm.Realm, err)
+		return err
+	}
+	log.Infof("Realm %s created", *realm.Realm)
+	return nil
+}
+
+/*
+provisionOrganization creates the Organization of the org orgID from the organization template, in the realm
+of KEYCLOAK_REALM. Its alias, named after the org id, and its managed-by and tenant-id attributes are set by KTC.
+*/
+func (c *client) provisionOrganization(ctx context.Context, orgID string) error {
+	organization := map[string]any{}
+	if c.organizationTemplate != "" {
+		if err := json.Unmarshal([]byte(templates.Expand(c.organizationTemplate, orgID, "")), &organization); err != nil {
+			return fmt.Errorf("invalid organization template: %w", err)
+		}
+	}
+	if _, ok := organization["name"]; !ok {
+		organization["name"] = orgID
+	}
+	organization["alias"] = orgID
+	attributes, _ := organization["attributes"].(map[string]any)
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	attributes[attrManagedBy] = []string{common.AppName}
+	attributes[attrTenantID] = []string{orgID}
+	organization["attributes"] = attributes
+
+	if err := createOrganization(c.session, ctx, c.keycloakurl, c.keycloakRealm, organization); err != nil {
+		if isErrorForAlreadyExists(err) {
+			log.Infof("Organization %s already exists", orgID)
+			return nil
+		}
+		log.Errorf("Error creating organization %s: %v", orgID, err)
+		return err
+	}
+	log.Infof("Organization %s created", orgID)
+	return nil
+}
+
+/*
+deprovisionOrg deletes the realm or the Organization of the org orgID, unless its attributes tie it to another
+application or tenant. Deleting the realm deletes the roles and groups of the org and its projects with it.
+*/
+func (c *client) deprovisionOrg(orgID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	switch c.tenantMode {
+	case ModeOrgRealm:
+		return c.deprovisionOrgRealm(ctx, orgID)
+	case ModeOrganization:
+		return c.deprovisionOrganization(ctx, orgID)
+	}
+	return nil
+}
+
+func (c *client) deprovisionOrgRealm(ctx context.Context, orgID string) error {
+	realmName := c.realmOf(orgID)
+	realm, err := getRealm(c.session, ctx, realmName)
+	if err != nil && isErrorForNotFound(err) {
+		log.Infof("Realm %s already deleted", realmName)
+		return nil
+	} else if err != nil {
+		log.Errorf("Error getting realm %s: %v", realmName, err)
+		return err
+	}
+
+	if realm.Attributes == nil || (*realm.Attributes)[attrManagedBy] != common.AppName ||
+		(*realm.Attributes)[attrTenantID] != orgID {
+		log.Warnf("Realm %s is not managed for tenant %s, it is kept", realmName, orgID)
+		return nil
+	}
+
+	if c.dryRun {
+		log.Infof("Dry run: realm %s would be deleted", realmName)
+		return nil
+	}
+	if err := deleteRealm(c.session, ctx, realmName); err != nil {
+		log.Errorf("Error deleting realm %s: %v", realmName, err)
+		return err
+	}
+	log.Infof("Realm %s deleted", realmName)
+	return nil
+}
+
+func (c *client) deprovisionOrganization(ctx context.Context, orgID string) error {
+	organizations, err := getOrganizations(c.session, ctx, c.keycloakurl, c.keycloakRealm, orgID)
+	if err != nil {
+		log.Errorf("Error getting organization %s: %v", orgID, err)
+		return err
+	}
+
+	for _, organization := range organizations {
+		if organization.Alias != orgID {
+			continue
+		}
+		if !ownedBy(&organization.Attributes, orgID) {
+			log.Warnf("Organization %s is not managed for tenant %s, it is kept", orgID, orgID)
+			return nil
+		}
+
+		if c.dryRun {
+			log.Infof("Dry run: organization %s would be deleted", orgID)
+			return nil
+		}
+		if err := deleteOrganization(c.session, ctx, c.keycloakurl, c.keycloakRealm, organization.ID); err != nil {
+			log.Errorf("Error deleting organization %s: %v", orgID, err)
+			return err
+		}
+		log.Infof("Organization %s deleted", orgID)
+		return nil
+	}
+
+	log.Infof("Organization %s already deleted", orgID)
+	return nil
+}
+
+/*
+orphanIsolation adds the realms or Organizations of the orgs that no longer exist to report, and returns the
+realms to look for orphan roles and groups in: the realm of KEYCLOAK_REALM and the realms of the existing orgs.
+*/
+func (c *client) orphanIsolation(ctx context.Context, tenantIDs []string, report *OrphanReport) ([]string, error) {
+	realms := []string{c.keycloakRealm}
+
+	switch c.tenantMode {
+	case ModeOrgRealm:
+		managedRealms, err := getManagedRealms(c.session, ctx, common.AppName)
+		if err != nil {
+			log.Errorf("Error getting Keycloak realms: %v", err)
+			return nil, err
+		}
+		for realmName, attributes := range managedRealms {
+			if tenantID := attributes[attrTenantID]; tenantID != "" && !slices.Contains(tenantIDs, tenantID) {
+				report.Realms = append(report.Realms, Orphan{Name: realmName, TenantID: tenantID})
+			} else {
+				realms = append(realms, realmName)
+			}
+		}
+	case ModeOrganization:
+		organizations, err := getOrganizations(c.session, ctx, c.keycloakurl, c.keycloakRealm, "")
+		if err != nil {
+			log.Errorf("Error getting Keycloak organizations: %v", err)
+			return nil, err
+		}
+		for _, organization := range organizations {
+			tenantIDsOf := organization.Attributes[attrTenantID]
+			if !slices.Contains(organization.Attributes[attrManagedBy], common.AppName) || len(tenantIDsOf) == 0 {
+				continue
+			}
+			if !slices.Contains(tenantIDs, tenantIDsOf[0]) {
+				report.Organizations = append(report.Organizations, Orphan{Name: organization.Alias, TenantID: tenantIDsOf[0]})
+			}
+		}
+	}
+
+	return realms, nil
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keycloak
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/common"
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/templates"
+)
+
+func TestInitSelectsTenantMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    string
+		want    string
+		wantErr bool
+	}{
+		{name: "default", want: ModeSingleRealm},
+		{name: "single realm", mode: ModeSingleRealm, want: ModeSingleRealm},
+		{name: "org realm", mode: ModeOrgRealm, want: ModeOrgRealm},
+		{name: "organization", mode: ModeOrganization, want: ModeOrganization},
+		{name: "unknown", mode: "realm-per-project", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(newFakeKeycloak())
+			t.Cleanup(server.Close)
+			t.Setenv(envKeycloakUrl, server.URL)
+			t.Setenv(envTenantMode, tt.mode)
+
+			c := NewClient(Options{}).(*client)
+			err := c.Init()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Init succeeded with mode %q, want an error", tt.mode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Init: %v", err)
+			}
+			if c.tenantMode != tt.want {
+				t.Errorf("mode %q, want %q", c.tenantMode, tt.want)
+			}
+		})
+	}
+}
+
+func TestRealmOf(t *testing.T) {
+	for mode, want := range map[string]string{
+		ModeSingleRealm:  testRealm,
+		ModeOrgRealm:     "acme",
+		ModeOrganization: testRealm,
+	} {
+		c := &client{tenantMode: mode, keycloakRealm: testRealm}
+		if got := c.realmOf("acme"); got != want {
+			t.Errorf("realm of acme in mode %s is %s, want %s", mode, got, want)
+		}
+		if got := c.realmOf(""); got != testRealm {
+			t.Errorf("realm of the SI in mode %s is %s, want %s", mode, got, testRealm)
+		}
+	}
+}
+
+// createTenants creates the org acme and its project p1.
+func createTenants(t *testing.T, c *client) {
+	t.Helper()
+	if err := c.CreateOrg("acme"); err != nil {
+		t.Fatalf("CreateOrg: %v", err)
+	}
+	if err := c.CreateProject("acme", "p1"); err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+}
+
+// deleteTenants deletes the project p1 and its org acme.
+func deleteTenants(t *testing.T, c *client) {
+	t.Helper()
+	if err := c.DeleteProject("acme", "p1"); err != nil {
+		t.Fatalf("DeleteProject: %v", err)
+	}
+	if err := c.DeleteOrg("acme"); err != nil {
+		t.Fatalf("DeleteOrg: %v", err)
+	}
+}
+
+var tenantGroups = []string{"acme_Project-Manager-Group", "acme_p1_Edge-Operator-Group"}
+
+func TestSingleRealmMode(t *testing.T) {
+	c, keycloak := newTestClient(t, ModeSingleRealm, testTemplates, false)
+
+	createTenants(t, c)
+	if got := keycloak.groupNames(testRealm); !reflect.DeepEqual(got, tenantGroups) {
+		t.Errorf("groups %v, want %v", got, tenantGroups)
+	}
+	if got := keycloak.groupRoles(testRealm, "acme_p1_Edge-Operator-Group"); !reflect.DeepEqual(got,
+		[]string{"account/view-profile", "acme_p1_cat-r"}) {
+		t.Errorf("roles of the project group %v", got)
+	}
+	if got := keycloak.realmNames(); !reflect.DeepEqual(got, []string{testRealm}) {
+		t.Errorf("realms %v, want no realm provisioned", got)
+	}
+	if got := keycloak.organizations(testRealm); len(got) != 0 {
+		t.Errorf("organizations %v, want none provisioned", got)
+	}
+
+	deleteTenants(t, c)
+	if got := keycloak.groupNames(testRealm); len(got) != 0 {
+		t.Errorf("groups %v, want them deleted", got)
+	}
+	if got := keycloak.roleNames(testRealm); !reflect.DeepEqual(got, []string{"account/view-profile"}) {
+		t.Errorf("roles %v, want the shared role only", got)
+	}
+}
+
+func TestOrgRealmMode(t *testing.T) {
+	tmpl := *testTemplates
+	tmpl.RealmTemplate = `{"displayName": "<org-id> tenant", "attributes": {"team": "edge", "tenant-id": "other"}}`
+	c, keycloak := newTestClient(t, ModeOrgRealm, &tmpl, false)
+	keycloak.addRealm("globex", map[string]string{attrManagedBy: common.AppName, attrTenantID: "initech"})
+
+	createTenants(t, c)
+	realm := keycloak.realm("acme")
+	if realm == nil {
+		t.Fatalf("realm acme not provisioned, realms %v", keycloak.realmNames())
+	}
+	if realm.DisplayName == nil || *realm.DisplayName != "acme tenant" || realm.Enabled == nil || !*realm.Enabled {
+		t.Errorf("realm %+v, want it enabled from the template", realm)
+	}
+	wantAttributes := map[string]string{"team": "edge", attrManagedBy: common.AppName, attrTenantID: "acme"}
+	if realm.Attributes == nil || !reflect.DeepEqual(*realm.Attributes, wantAttributes) {
+		t.Errorf("realm attributes %v, want %v", realm.Attributes, wantAttributes)
+	}
+	if got := keycloak.groupNames("acme"); !reflect.DeepEqual(got, tenantGroups) {
+		t.Errorf("groups of the realm acme %v, want %v", got, tenantGroups)
+	}
+	if got := keycloak.groupNames(testRealm); len(got) != 0 {
+		t.Errorf("groups of the realm %s %v, want none", testRealm, got)
+	}
+
+	deleteTenants(t, c)
+	if got := keycloak.realmNames(); !reflect.DeepEqual(got, []string{"globex", testRealm}) {
+		t.Errorf("realms %v, want the realm acme deleted", got)
+	}
+	// The roles and groups of the org are deleted with its realm, not one by one.
+	removals := keycloak.removalRequests()
+	if len(removals) == 0 || removals[len(removals)-1] != "DELETE /admin/realms/acme" {
+		t.Errorf("removals %v, want the realm acme deleted last", removals)
+	}
+	for _, removal := range removals {
+		if strings.Contains(removal, "Project-Manager") || strings.Contains(removal, "acme_project-") {
+			t.Errorf("removal %s of a role or group of the org, want them deleted with the realm", removal)
+		}
+	}
+
+	// The realm of another tenant is kept.
+	if err := c.DeleteOrg("globex"); err != nil {
+		t.Fatalf("DeleteOrg: %v", err)
+	}
+	if keycloak.realm("globex") == nil {
+		t.Errorf("realm globex of the tenant initech deleted")
+	}
+}
+
+func TestOrganizationMode(t *testing.T) {
+	tmpl := *testTemplates
+	tmpl.OrganizationTemplate = `{"description": "<org-id> tenant", "domains": [{"name": "<org-id>.example.com"}]}`
+	c, keycloak := newTestClient(t, ModeOrganization, &tmpl, false)
+	keycloak.addOrganization(testRealm, "globex", map[string][]string{attrManagedBy: {"other-app"}})
+
+	createTenants(t, c)
+	var organization map[string]any
+	for _, o := range keycloak.organizations(testRealm) {
+		if o["alias"] == "acme" {
+			organization = o
+		}
+	}
+	if organization == nil {
+		t.Fatalf("organization acme not provisioned, organizations %v", keycloak.organizations(testRealm))
+	}
+	if organization["name"] != "acme" || organization["description"] != "acme tenant" {
+		t.Errorf("organization %v, want it named after the org from the template", organization)
+	}
+	wantAttributes := map[string]any{attrManagedBy: []any{common.AppName}, attrTenantID: []any{"acme"}}
+	if !reflect.DeepEqual(organization["attributes"], wantAttributes) {
+		t.Errorf("organization attributes %v, want %v", organization["attributes"], wantAttributes)
+	}
+	if got := keycloak.groupNames(testRealm); !reflect.DeepEqual(got, tenantGroups) {
+		t.Errorf("groups %v, want %v in the realm %s", got, tenantGroups, testRealm)
+	}
+	if got := keycloak.realmNames(); !reflect.DeepEqual(got, []string{testRealm}) {
+		t.Errorf("realms %v, want no realm provisioned", got)
+	}
+
+	deleteTenants(t, c)
+	if got := keycloak.groupNames(testRealm); len(got) != 0 {
+		t.Errorf("groups %v, want them deleted", got)
+	}
+	organizations := keycloak.organizations(testRealm)
+	if len(organizations) != 1 || organizations[0]["alias"] != "globex" {
+		t.Errorf("organizations %v, want the organization acme deleted", organizations)
+	}
+
+	// The Organization of another application is kept.
+	if err := c.DeleteOrg("globex"); err != nil {
+		t.Fatalf("DeleteOrg: %v", err)
+	}
+	if len(keycloak.organizations(testRealm)) != 1 {
+		t.Errorf("organization globex of another application deleted")
+	}
+}
+
+func TestProvisionIsIdempotent(t *testing.T) {
+	for _, mode := range []string{ModeOrgRealm, ModeOrganization} {
+		t.Run(mode, func(t *testing.T) {
+			c, keycloak := newTestClient(t, mode, &templates.Templates{}, false)
+			for range 2 {
+				if err := c.ReconcileOrg("acme"); err != nil {
+					t.Fatalf("ReconcileOrg: %v", err)
+				}
+			}
+			realms, organizations := len(keycloak.realmNames()), len(keycloak.organizations(testRealm))
+			if realms+organizations != 2 {
+				t.Errorf("%d realms and %d organizations, want the org provisioned once", realms, organizations)
+			}
+		})
+	}
+}
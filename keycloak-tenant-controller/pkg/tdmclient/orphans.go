@@ -0,0 +1,62 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tdmclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/keycloak"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+)
+
+/*
+OrphanReport lists the roles and groups created in Keycloak for orgs and projects that no longer exist.
+The orgs and projects being deleted still exist. It does not need Init, and does not register the watchers.
+*/
+func (tc *tdmclient) OrphanReport() (*keycloak.OrphanReport, error) {
+	if tc.nexusClient == nil {
+		config, err := getK8sConfig()
+		if err != nil {
+			return nil, fmt.Errorf("error getting kubeconfig %w", err)
+		}
+		if tc.nexusClient, err = nexus_client.NewForConfig(config); err != nil {
+			return nil, fmt.Errorf("error creating nexus client %w", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), watcherTimeout)
+	defer cancel()
+
+	runtime, err := tc.nexusClient.TenancyMultiTenancy().GetRuntime(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while looking up the runtime: %w", err)
+	}
+	orgs, err := runtime.GetAllOrgs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while listing the runtime orgs: %w", err)
+	}
+
+	var tenantIDs []string
+	for _, org := range orgs {
+		tenantIDs = append(tenantIDs, string(org.UID))
+		folders, err := org.GetAllFolders(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while listing the runtime folders of org %s: %w", org.DisplayName(), err)
+		}
+		for _, folder := range folders {
+			projects, err := folder.GetAllProjects(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error while listing the runtime projects of org %s: %w", org.DisplayName(), err)
+			}
+			for _, proj := range projects {
+				tenantIDs = append(tenantIDs, string(proj.UID))
+			}
+		}
+	}
+
+	return tc.kcClient.OrphanReport(tenantIDs)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/keycloak"
 	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/log"
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/templates"
 	orgActiveWatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgactivewatcher.edge-orchestrator.intel.com/v1"
 	orgwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgwatcher.edge-orchestrator.intel.com/v1"
 	projectActiveWatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectactivewatcher.edge-orchestrator.intel.com/v1"
@@ -33,24 +34,30 @@ const (
 type TdmClient interface {
 	Init() error
 	Stop()
+	OrphanReport() (*keycloak.OrphanReport, error)
 }
 
 type tdmclient struct {
-	nexusClient *nexus_client.Clientset
-	appName     string
-	kcClient    keycloak.Client
+	nexusClient      *nexus_client.Clientset
+	appName          string
+	kcClient         keycloak.Client
+	templatesWatcher *templates.Watcher
+	// tenantLocks serializes the events of each org and project, by uid, with their reconciliation.
+	tenantLocks *keyedMutex
 }
 
 func NewMTClient(appName string, kcClient keycloak.Client) TdmClient {
 	return &tdmclient{
-		appName:  appName,
-		kcClient: kcClient,
+		appName:     appName,
+		kcClient:    kcClient,
+		tenantLocks: newKeyedMutex(),
 	}
 }
 
 // Callback function to be invoked when Org is added.
 func (tc *tdmclient) processRuntimeOrgsAdd(org *nexus_client.RuntimeorgRuntimeOrg) {
 	log.Infof("Processing RuntimeOrgsAdd for: %+v\n", *org)
+	defer tc.tenantLocks.lock(string(org.UID))()
 
 	// Get watcher object if it exists and set the status to IN-PROGRESS.
 	err := tc.updateOrgWatcherStatus(org, orgActiveWatcherv1.StatusIndicationInProgress, "Creating")
@@ -85,8 +92,9 @@ func (tc *tdmclient) processRuntimeOrgsAdd(org *nexus_client.RuntimeorgRuntimeOr
 }
 
 // Callback function to be invoked when Org is deleted.
-func (tc *tdmclient) processRuntimeOrgsUpdate(_, org *nexus_client.RuntimeorgRuntimeOrg) {
+func (tc *tdmclient) processRuntimeOrgsUpdate(old, org *nexus_client.RuntimeorgRuntimeOrg) {
 	log.Infof("Processing RuntimeOrgsUpdate for: %+v\n", *org)
+	defer tc.tenantLocks.lock(string(org.UID))()
 
 	if org.Spec.Deleted {
 		log.Debugf("Orgs: %+v marked for deletion\n", org.DisplayName())
@@ -104,6 +112,20 @@ func (tc *tdmclient) processRuntimeOrgsUpdate(_, org *nexus_client.RuntimeorgRun
 			return
 		}
 		log.Debugf("Active watcher %s deleted for Org %s\n", tc.appName, org.DisplayName())
+	} else if old.Spec.Suspended != org.Spec.Suspended {
+		// A suspended org keeps its groups and roles, only the roles of its groups are removed.
+		var err error
+		if org.Spec.Suspended {
+			log.Debugf("Orgs: %+v suspended\n", org.DisplayName())
+			err = tc.kcClient.SuspendOrg(string(org.UID))
+		} else {
+			log.Debugf("Orgs: %+v resumed\n", org.DisplayName())
+			err = tc.kcClient.ResumeOrg(string(org.UID))
+		}
+		if err != nil {
+			log.Errorf("Failed to update the suspension of org %s in Keycloak with an error: %v", org.DisplayName(), err)
+			return
+		}
 	}
 
 	log.Infof("RuntimeOrgsUpdate event handled for: %+v\n", *org)
@@ -112,6 +134,7 @@ func (tc *tdmclient) processRuntimeOrgsUpdate(_, org *nexus_client.RuntimeorgRun
 // Callback function to be invoked when Project is added.
 func (tc *tdmclient) processRuntimeProjectsAdd(proj *nexus_client.RuntimeprojectRuntimeProject) {
 	log.Debugf("Processing RuntimeProjectsAdd for: %+v\n", *proj)
+	defer tc.tenantLocks.lock(string(proj.UID))()
 
 	// Get watcher object if it exists and set the status to IN-PROGRESS.
 	err := tc.updateProjWatcherStatus(proj, projectActiveWatcherv1.StatusIndicationInProgress, "Creating")
@@ -159,13 +182,20 @@ func (tc *tdmclient) processRuntimeProjectsAdd(proj *nexus_client.Runtimeproject
 }
 
 // Callback function to be invoked when Project is deleted.
-func (tc *tdmclient) processRuntimeProjectsUpdate(_, proj *nexus_client.RuntimeprojectRuntimeProject) {
+func (tc *tdmclient) processRuntimeProjectsUpdate(old, proj *nexus_client.RuntimeprojectRuntimeProject) {
 	log.Infof("Processing RuntimeProjectsUpdate for: %+v\n", *proj)
+	defer tc.tenantLocks.lock(string(proj.UID))()
 
 	if proj.Spec.Deleted {
 		log.Debugf("Project: %+v marked for deletion\n", proj.DisplayName())
 
-		err := tc.kcClient.DeleteProject(string(proj.UID))
+		orgID, err := tc.projectOrgID(proj)
+		if err != nil {
+			log.Errorf("Failed to look up the org of project %s: %v", proj.DisplayName(), err)
+			return
+		}
+
+		err = tc.kcClient.DeleteProject(orgID, string(proj.UID))
 		if err != nil {
 			log.Errorf("Failed to delete project %s in Keycloak with an error: %v", proj.DisplayName(), err)
 			return
@@ -182,11 +212,44 @@ func (tc *tdmclient) processRuntimeProjectsUpdate(_, proj *nexus_client.Runtimep
 			return
 		}
 		log.Debugf("Active watcher %s deleted for project %s\n", tc.appName, proj.DisplayName())
+	} else if old.Spec.Archived != proj.Spec.Archived {
+		// An archived project keeps its groups and roles, only the roles of its groups are removed.
+		orgID, err := tc.projectOrgID(proj)
+		if err != nil {
+			log.Errorf("Failed to look up the org of project %s: %v", proj.DisplayName(), err)
+			return
+		}
+		if proj.Spec.Archived {
+			log.Debugf("Project: %+v archived\n", proj.DisplayName())
+			err = tc.kcClient.ArchiveProject(orgID, string(proj.UID))
+		} else {
+			log.Debugf("Project: %+v unarchived\n", proj.DisplayName())
+			err = tc.kcClient.UnarchiveProject(orgID, string(proj.UID))
+		}
+		if err != nil {
+			log.Errorf("Failed to update the archival of project %s in Keycloak with an error: %v", proj.DisplayName(), err)
+			return
+		}
 	}
 
 	log.Infof("RuntimeProjectsUpdate event handled for: %+v\n", *proj)
 }
 
+// projectOrgID returns the id of the org of a project.
+func (tc *tdmclient) projectOrgID(proj *nexus_client.RuntimeprojectRuntimeProject) (string, error) {
+	folderOrgs, err := proj.GetParent(context.Background())
+	if err != nil {
+		return "", fmt.Errorf("error while looking up Iam runtime object: %w", err)
+	}
+
+	org, err := folderOrgs.GetParent(context.Background())
+	if err != nil {
+		return "", fmt.Errorf("error while looking up Iam runtime org object: %w", err)
+	}
+
+	return string(org.UID), nil
+}
+
 func (tc *tdmclient) Init() error {
 
 	// Initialize Nexus SDK, by pointing it to the K8s API endpoint where CRD's are to be stored.
@@ -204,6 +267,18 @@ func (tc *tdmclient) Init() error {
 	// This sync is done in the background.
 	tc.nexusClient.SubscribeAll()
 
+	// Watch the templates ConfigMap, and reconcile every org and project with the templates when they change.
+	// The templates are read before the callbacks are registered, so that the new orgs and projects get them.
+	tc.templatesWatcher, err = templates.NewWatcher(config, tc.kcClient.SetTemplates, tc.reconcileTemplates)
+	if err != nil {
+		return fmt.Errorf("failed to create templates watcher: %w", err)
+	}
+	if tc.templatesWatcher != nil {
+		if err := tc.templatesWatcher.Start(); err != nil {
+			return fmt.Errorf("failed to start templates watcher: %w", err)
+		}
+	}
+
 	// Create a watcher for Project
 	if err := tc.addProjectWatcher(); err != nil {
 		return fmt.Errorf("failed to create project watcher: %w", err)
@@ -342,6 +417,9 @@ func (tc *tdmclient) setProjWatcherStatus(watcherObj *nexus_client.Projectactive
 }
 
 func (tc *tdmclient) Stop() {
+	if tc.templatesWatcher != nil {
+		tc.templatesWatcher.Stop()
+	}
 	tc.nexusClient.UnsubscribeAll()
 	if err := tc.deleteProjectWatcher(); err != nil {
 		log.Infof("Failed to delete Project watcher: %v", err)
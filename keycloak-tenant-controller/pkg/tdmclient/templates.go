@@ -0,0 +1,154 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tdmclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/log"
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/templates"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+)
+
+// tenant is an org or a project to reconcile with the templates.
+type tenant struct {
+	name string
+	// lock is the key of the tenant in the tenant locks, its uid.
+	lock string
+	// active re-reads the tenant, and reports whether it is still to be reconciled. It is nil for the SI.
+	active    func(ctx context.Context) (bool, error)
+	reconcile func() error
+}
+
+/*
+reconcileTemplates reconciles the cross SI groups, then every org and project, with the templates applied
+to the Keycloak client. Deleted orgs and projects are skipped, as are suspended orgs and archived projects,
+whose groups have no roles: they get the roles of the current templates once resumed or unarchived.
+Each tenant is re-read just before it is reconciled, under the lock its events take, so that a tenant deleted
+since it was listed does not get its roles and groups back.
+*/
+func (tc *tdmclient) reconcileTemplates(ctx context.Context, progress func(templates.Progress)) error {
+	tenants, err := tc.listTenants(ctx)
+	if err != nil {
+		return err
+	}
+
+	p := templates.Progress{Total: len(tenants)}
+	progress(p)
+	for _, tenant := range tenants {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := tc.reconcileTenant(ctx, tenant); err != nil {
+			log.Errorf("Failed to reconcile %s with the templates: %v", tenant.name, err)
+			p.Failed = append(p.Failed, tenant.name)
+		}
+		p.Done++
+		progress(p)
+	}
+
+	if len(p.Failed) > 0 {
+		return fmt.Errorf("failed to reconcile %d of %d tenants", len(p.Failed), p.Total)
+	}
+	return nil
+}
+
+// reconcileTenant reconciles tenant with the templates if it is still active.
+func (tc *tdmclient) reconcileTenant(ctx context.Context, tenant tenant) error {
+	defer tc.tenantLocks.lock(tenant.lock)()
+	if tenant.active != nil {
+		active, err := tenant.active(ctx)
+		if err != nil {
+			return err
+		}
+		if !active {
+			log.Infof("Skipping %s, deleted, suspended or archived since the tenants were listed", tenant.name)
+			return nil
+		}
+	}
+	return tenant.reconcile()
+}
+
+/*
+listTenants returns the cross SI groups, named "si", and the orgs and projects to reconcile with the templates,
+named "org/<org>" and "project/<org>/<project>".
+*/
+func (tc *tdmclient) listTenants(ctx context.Context) ([]tenant, error) {
+	runtime, err := tc.nexusClient.TenancyMultiTenancy().GetRuntime(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while looking up the runtime: %w", err)
+	}
+
+	orgs, err := runtime.GetAllOrgs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while listing the runtime orgs: %w", err)
+	}
+
+	tenants := []tenant{{name: "si", lock: "si", reconcile: tc.kcClient.ReconcileSI}}
+	for _, org := range orgs {
+		if org.Spec.Deleted {
+			continue
+		}
+		orgID, orgName := string(org.UID), org.Name
+		if !org.Spec.Suspended {
+			tenants = append(tenants, tenant{
+				name:      "org/" + org.DisplayName(),
+				lock:      orgID,
+				active:    func(ctx context.Context) (bool, error) { return tc.orgActive(ctx, orgName) },
+				reconcile: func() error { return tc.kcClient.ReconcileOrg(orgID) },
+			})
+		}
+
+		folders, err := org.GetAllFolders(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while listing the runtime folders of org %s: %w", org.DisplayName(), err)
+		}
+		for _, folder := range folders {
+			projects, err := folder.GetAllProjects(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error while listing the runtime projects of org %s: %w", org.DisplayName(), err)
+			}
+			for _, proj := range projects {
+				if proj.Spec.Deleted || proj.Spec.Archived {
+					continue
+				}
+				projID, projName := string(proj.UID), proj.Name
+				tenants = append(tenants, tenant{
+					name:      "project/" + org.DisplayName() + "/" + proj.DisplayName(),
+					lock:      projID,
+					active:    func(ctx context.Context) (bool, error) { return tc.projectActive(ctx, projName) },
+					reconcile: func() error { return tc.kcClient.ReconcileProject(orgID, projID) },
+				})
+			}
+		}
+	}
+	return tenants, nil
+}
+
+// orgActive reports whether the runtime org of hashedName still exists, and is neither deleted nor suspended.
+func (tc *tdmclient) orgActive(ctx context.Context, hashedName string) (bool, error) {
+	org, err := tc.nexusClient.Runtimeorg().GetRuntimeOrgByName(ctx, hashedName)
+	if nexus_client.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error while looking up the runtime org: %w", err)
+	}
+	return !org.Spec.Deleted && !org.Spec.Suspended, nil
+}
+
+// projectActive reports whether the runtime project of hashedName still exists, and is neither deleted nor archived.
+func (tc *tdmclient) projectActive(ctx context.Context, hashedName string) (bool, error) {
+	proj, err := tc.nexusClient.Runtimeproject().GetRuntimeProjectByName(ctx, hashedName)
+	if nexus_client.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error while looking up the runtime project: %w", err)
+	}
+	return !proj.Spec.Deleted && !proj.Spec.Archived, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tdmclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	runtimeorgv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimeorg.edge-orchestrator.intel.com/v1"
+	runtimeprojectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimeproject.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestClient(t *testing.T) *tdmclient {
+	t.Helper()
+	return &tdmclient{nexusClient: nexus_client.NewFakeClient(), tenantLocks: newKeyedMutex()}
+}
+
+func TestOrgActive(t *testing.T) {
+	tc := newTestClient(t)
+	ctx := context.Background()
+	for name, spec := range map[string]runtimeorgv1.RuntimeOrgSpec{
+		"active":    {},
+		"deleted":   {Deleted: true},
+		"suspended": {Suspended: true},
+	} {
+		if _, err := tc.nexusClient.Runtimeorg().CreateRuntimeOrgByName(ctx, &runtimeorgv1.RuntimeOrg{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       spec,
+		}); err != nil {
+			t.Fatalf("CreateRuntimeOrgByName: %v", err)
+		}
+	}
+
+	for name, want := range map[string]bool{"active": true, "deleted": false, "suspended": false, "gone": false} {
+		active, err := tc.orgActive(ctx, name)
+		if err != nil {
+			t.Fatalf("orgActive(%s): %v", name, err)
+		}
+		if active != want {
+			t.Errorf("org %s active %t, want %t", name, active, want)
+		}
+	}
+}
+
+func TestProjectActive(t *testing.T) {
+	tc := newTestClient(t)
+	ctx := context.Background()
+	for name, spec := range map[string]runtimeprojectv1.RuntimeProjectSpec{
+		"active":   {},
+		"deleted":  {Deleted: true},
+		"archived": {Archived: true},
+	} {
+		if _, err := tc.nexusClient.Runtimeproject().CreateRuntimeProjectByName(ctx, &runtimeprojectv1.RuntimeProject{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       spec,
+		}); err != nil {
+			t.Fatalf("CreateRuntimeProjectByName: %v", err)
+		}
+	}
+
+	for name, want := range map[string]bool{"active": true, "deleted": false, "archived": false, "gone": false} {
+		active, err := tc.projectActive(ctx, name)
+		if err != nil {
+			t.Fatalf("projectActive(%s): %v", name, err)
+		}
+		if active != want {
+			t.Errorf("project %s active %t, want %t", name, active, want)
+		}
+	}
+}
+
+func TestReconcileTenantSkipsInactiveTenants(t *testing.T) {
+	tc := newTestClient(t)
+	for _, active := range []bool{true, false} {
+		reconciled := false
+		err := tc.reconcileTenant(context.Background(), tenant{
+			name:      "org/acme",
+			lock:      "acme",
+			active:    func(context.Context) (bool, error) { return active, nil },
+			reconcile: func() error { reconciled = true; return nil },
+		})
+		if err != nil {
+			t.Fatalf("reconcileTenant: %v", err)
+		}
+		if reconciled != active {
+			t.Errorf("reconciled %t the tenant active %t", reconciled, active)
+		}
+	}
+}
+
+func TestReconcileTenantWaitsForTheTenantEvents(t *testing.T) {
+	tc := newTestClient(t)
+	// An event of the tenant is being handled.
+	unlock := tc.tenantLocks.lock("acme")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = tc.reconcileTenant(context.Background(), tenant{
+			name:      "org/acme",
+			lock:      "acme",
+			reconcile: func() error { return nil },
+		})
+	}()
+
+	select {
+	case <-done:
+		t.Fatalf("reconciled the tenant while its event was handled")
+	case <-time.After(100 * time.Millisecond):
+	}
+	unlock()
+	<-done
+}
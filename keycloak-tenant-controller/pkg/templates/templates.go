@@ -0,0 +1,161 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package templates
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	envSIGroups   = "KEYCLOAK_SI_GROUPS"
+	envOrgGroups  = "KEYCLOAK_ORG_GROUPS"
+	envProjGroups = "KEYCLOAK_PROJ_GROUPS"
+
+	envRealmTemplate        = "KEYCLOAK_REALM_TEMPLATE"
+	envOrganizationTemplate = "KEYCLOAK_ORGANIZATION_TEMPLATE"
+
+	// Keys of the templates ConfigMap.
+	keySIGroups      = "si-groups"
+	keyOrgGroups     = "org-groups"
+	keyProjGroups    = "project-groups"
+	keyPruneMappings = "prune-mappings"
+
+	keyRealmTemplate        = "realm-template"
+	keyOrganizationTemplate = "organization-template"
+
+	// OrgPrefix and ProjPrefix are replaced with the org and project ids in the names of the groups and roles.
+	OrgPrefix  = "<org-id>"
+	ProjPrefix = "<project-id>"
+)
+
+/*
+Templates are the groups created in Keycloak, each with the roles mapped to it: once for the SI, and for each
+org and each project. With PruneMappings, the roles mapped to the groups of an org or a project that its
+template no longer lists are removed from them when the templates are reconciled.
+RealmTemplate and OrganizationTemplate are the JSON representations of the realm, or of the Keycloak
+Organization, provisioned for each org when the tenants are isolated in their own realm or Organization.
+*/
+type Templates struct {
+	SIGroups             map[string][]string `json:"siGroups,omitempty"`
+	OrgGroups            map[string][]string `json:"orgGroups,omitempty"`
+	ProjGroups           map[string][]string `json:"projGroups,omitempty"`
+	PruneMappings        bool                `json:"pruneMappings,omitempty"`
+	RealmTemplate        string              `json:"realmTemplate,omitempty"`
+	OrganizationTemplate string              `json:"organizationTemplate,omitempty"`
+}
+
+/*
+FromEnv reads the templates from the JSON environment variables, used until the templates ConfigMap is read
+and when there is none. Unset variables are empty templates.
+*/
+func FromEnv() (*Templates, error) {
+	t := &Templates{}
+	for env, groups := range map[string]*map[string][]string{
+		envSIGroups:   &t.SIGroups,
+		envOrgGroups:  &t.OrgGroups,
+		envProjGroups: &t.ProjGroups,
+	} {
+		value := os.Getenv(env)
+		if value == "" {
+			continue
+		}
+		if err := json.Unmarshal([]byte(value), groups); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", env, err)
+		}
+	}
+	t.RealmTemplate = os.Getenv(envRealmTemplate)
+	t.OrganizationTemplate = os.Getenv(envOrganizationTemplate)
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Parse reads the templates from the data of the templates ConfigMap.
+func Parse(data map[string]string) (*Templates, error) {
+	t := &Templates{}
+	for key, groups := range map[string]*map[string][]string{
+		keySIGroups:   &t.SIGroups,
+		keyOrgGroups:  &t.OrgGroups,
+		keyProjGroups: &t.ProjGroups,
+	} {
+		value := data[key]
+		if value == "" {
+			continue
+		}
+		if err := json.Unmarshal([]byte(value), groups); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", key, err)
+		}
+	}
+	if value := data[keyPruneMappings]; value != "" {
+		prune, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", keyPruneMappings, err)
+		}
+		t.PruneMappings = prune
+	}
+	t.RealmTemplate = data[keyRealmTemplate]
+	t.OrganizationTemplate = data[keyOrganizationTemplate]
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+/*
+validate checks that the group names of the org and project templates hold the id of the org or project,
+so that no group is shared by several tenants, whose mappings they would prune from each other, and that
+the realm and Organization templates are JSON objects.
+*/
+func (t *Templates) validate() error {
+	for groupName := range t.OrgGroups {
+		if !strings.Contains(groupName, OrgPrefix) {
+			return fmt.Errorf("org group %s does not contain %s", groupName, OrgPrefix)
+		}
+	}
+	for groupName := range t.ProjGroups {
+		if !strings.Contains(groupName, ProjPrefix) {
+			return fmt.Errorf("project group %s does not contain %s", groupName, ProjPrefix)
+		}
+	}
+	for name, template := range map[string]string{
+		"realm template":        t.RealmTemplate,
+		"organization template": t.OrganizationTemplate,
+	} {
+		if template == "" {
+			continue
+		}
+		var object map[string]any
+		if err := json.Unmarshal([]byte(template), &object); err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+/*
+Expand replaces the org and project prefixes in name with orgID and projID.
+*/
+func Expand(name string, orgID string, projID string) string {
+	name = strings.ReplaceAll(name, OrgPrefix, orgID)
+	return strings.ReplaceAll(name, ProjPrefix, projID)
+}
+
+/*
+hash returns a digest of the data of the templates ConfigMap, which tells whether it changed.
+*/
+func hash(data map[string]string) string {
+	// Maps are marshalled with sorted keys.
+	marshaled, _ := json.Marshal(data)
+	sum := sha256.Sum256(marshaled)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package templates
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	data := map[string]string{
+		keySIGroups:             `{"sre-admins": ["admin"]}`,
+		keyOrgGroups:            `{"<org-id>_Project-Manager-Group": ["<org-id>_project-read-role", "<org-id>_project-write-role"]}`,
+		keyProjGroups:           `{"<org-id>_<project-id>_Edge-Operator-Group": ["<org-id>_<project-id>_cat-r"]}`,
+		keyPruneMappings:        "true",
+		keyRealmTemplate:        `{"enabled": true}`,
+		keyOrganizationTemplate: `{"description": "tenant"}`,
+	}
+
+	got, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	want := &Templates{
+		SIGroups: map[string][]string{"sre-admins": {"admin"}},
+		OrgGroups: map[string][]string{
+			"<org-id>_Project-Manager-Group": {"<org-id>_project-read-role", "<org-id>_project-write-role"},
+		},
+		ProjGroups:           map[string][]string{"<org-id>_<project-id>_Edge-Operator-Group": {"<org-id>_<project-id>_cat-r"}},
+		PruneMappings:        true,
+		RealmTemplate:        `{"enabled": true}`,
+		OrganizationTemplate: `{"description": "tenant"}`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseEmpty(t *testing.T) {
+	got, err := Parse(nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if !reflect.DeepEqual(got, &Templates{}) {
+		t.Errorf("Parse = %+v, want empty templates", got)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]string
+		want string
+	}{
+		{
+			name: "malformed groups",
+			data: map[string]string{keyOrgGroups: `{"<org-id>_Group": "role"}`},
+			want: keyOrgGroups,
+		},
+		{
+			name: "org group without the org id",
+			data: map[string]string{keyOrgGroups: `{"Project-Manager-Group": ["project-read-role"]}`},
+			want: "Project-Manager-Group",
+		},
+		{
+			name: "project group without the project id",
+			data: map[string]string{keyProjGroups: `{"<org-id>_Edge-Operator-Group": ["cat-r"]}`},
+			want: "<org-id>_Edge-Operator-Group",
+		},
+		{
+			name: "malformed prune mappings",
+			data: map[string]string{keyPruneMappings: "sometimes"},
+			want: keyPruneMappings,
+		},
+		{
+			name: "realm template not an object",
+			data: map[string]string{keyRealmTemplate: `["enabled"]`},
+			want: "realm template",
+		},
+		{
+			name: "malformed organization template",
+			data: map[string]string{keyOrganizationTemplate: `{"description":`},
+			want: "organization template",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.data)
+			if err == nil {
+				t.Fatalf("Parse succeeded, want an error")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error %q does not name %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv(envOrgGroups, `{"<org-id>_Project-Manager-Group": ["<org-id>_project-read-role"]}`)
+	t.Setenv(envProjGroups, "")
+
+	got, err := FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv: %v", err)
+	}
+	if len(got.OrgGroups) != 1 || got.ProjGroups != nil {
+		t.Errorf("FromEnv = %+v, want the org groups only", got)
+	}
+
+	t.Setenv(envProjGroups, `{"Edge-Operator-Group": ["cat-r"]}`)
+	if _, err := FromEnv(); err == nil {
+		t.Errorf("FromEnv succeeded with a project group without the project id")
+	}
+}
+
+func TestExpand(t *testing.T) {
+	got := Expand("<org-id>_<project-id>_Edge-Operator-Group", "a1b2", "c3d4")
+	if got != "a1b2_c3d4_Edge-Operator-Group" {
+		t.Errorf("Expand = %q", got)
+	}
+}
+
+func TestHash(t *testing.T) {
+	data := map[string]string{keyOrgGroups: "{}", keyProjGroups: "{}"}
+	if hash(data) != hash(map[string]string{keyProjGroups: "{}", keyOrgGroups: "{}"}) {
+		t.Errorf("hash depends on the order of the keys")
+	}
+	if hash(data) == hash(map[string]string{keyOrgGroups: "{}"}) {
+		t.Errorf("hash did not change with the data")
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package templates
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/open-edge-platform/orch-utils/keycloak-tenant-controller/pkg/log"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	envConfigMap = "KEYCLOAK_TEMPLATES_CONFIGMAP"
+	envNamespace = "POD_NAMESPACE"
+
+	// StatusAnnotation holds the Status of the reconciliation of the templates, as JSON, on the templates ConfigMap.
+	StatusAnnotation = "keycloak-tenant-controller.edge-orchestrator.intel.com/status"
+
+	// progressInterval is the number of tenants reconciled between two updates of the status.
+	progressInterval = 10
+	statusTimeout    = 10 * time.Second
+)
+
+// Phases of the reconciliation of the templates.
+const (
+	PhaseReconciling = "Reconciling"
+	PhaseReconciled  = "Reconciled"
+	PhaseFailed      = "Failed"
+	PhaseInvalid     = "Invalid"
+)
+
+/*
+Status is the progress of the reconciliation of the tenants with the templates of the ConfigMap data of Hash.
+Failed names the tenants that could not be reconciled.
+*/
+type Status struct {
+	Phase     string      `json:"phase"`
+	Hash      string      `json:"hash"`
+	Total     int         `json:"total"`
+	Done      int         `json:"done"`
+	Failed    []string    `json:"failed,omitempty"`
+	Message   string      `json:"message,omitempty"`
+	UpdatedAt metav1.Time `json:"updatedAt"`
+}
+
+// Progress is the number of tenants reconciled so far, out of Total, and those that failed.
+type Progress struct {
+	Total  int
+	Done   int
+	Failed []string
+}
+
+/*
+Reconciler reconciles every existing tenant with the templates last applied, and reports its progress after each.
+It stops with the error of ctx once ctx is cancelled, when newer templates supersede them.
+*/
+type Reconciler func(ctx context.Context, progress func(Progress)) error
+
+/*
+Watcher watches the templates ConfigMap, and runs the Reconciler each time its data changes, and once when
+the Watcher starts. A change cancels the reconciliation in progress, as it is superseded.
+*/
+type Watcher struct {
+	clientset kubernetes.Interface
+	namespace string
+	name      string
+	apply     func(*Templates)
+	reconcile Reconciler
+
+	ctx     context.Context
+	stop    context.CancelFunc
+	wake    chan struct{}
+	mu      sync.Mutex
+	latest  string
+	pending string
+	cancel  context.CancelFunc
+}
+
+/*
+NewWatcher returns a Watcher of the templates ConfigMap named by the KEYCLOAK_TEMPLATES_CONFIGMAP environment
+variable, in the namespace of POD_NAMESPACE. It returns nil when there is no templates ConfigMap.
+apply is called with the templates as soon as they are read, so that the new tenants get them, and
+reconcile then updates the existing ones.
+*/
+func NewWatcher(config *rest.Config, apply func(*Templates), reconcile Reconciler) (*Watcher, error) {
+	name := os.Getenv(envConfigMap)
+	if name == "" {
+		log.Infof("No templates ConfigMap, the templates are read from the environment only")
+		return nil, nil
+	}
+	namespace := os.Getenv(envNamespace)
+	if namespace == "" {
+		return nil, fmt.Errorf("%s is required to watch the templates ConfigMap %s", envNamespace, name)
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating kubernetes client: %w", err)
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	return &Watcher{
+		clientset: clientset,
+		namespace: namespace,
+		name:      name,
+		apply:     apply,
+		reconcile: reconcile,
+		ctx:       ctx,
+		stop:      stop,
+		wake:      make(chan struct{}, 1),
+	}, nil
+}
+
+/*
+Start watches the templates ConfigMap until Stop. It returns once the templates of the ConfigMap, if it exists,
+are applied.
+*/
+func (w *Watcher) Start() error {
+	factory := informers.NewSharedInformerFactoryWithOptions(w.clientset, 0,
+		informers.WithNamespace(w.namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", w.name).String()
+		}))
+	informer := factory.Core().V1().ConfigMaps().Informer()
+	registration, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: w.observe,
+		UpdateFunc: func(_, obj any) {
+			w.observe(obj)
+		},
+		DeleteFunc: func(any) {
+			log.Warnf("Templates ConfigMap %s/%s deleted, the current templates are kept", w.namespace, w.name)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error watching the templates ConfigMap %s/%s: %w", w.namespace, w.name, err)
+	}
+
+	go w.run()
+	factory.Start(w.ctx.Done())
+	if !cache.WaitForCacheSync(w.ctx.Done(), registration.HasSynced) {
+		return fmt.Errorf("error reading the templates ConfigMap %s/%s", w.namespace, w.name)
+	}
+	log.Infof("Watching the templates ConfigMap %s/%s", w.namespace, w.name)
+	return nil
+}
+
+/*
+Stop stops watching the templates ConfigMap, and cancels the reconciliation in progress.
+*/
+func (w *Watcher) Stop() {
+	w.stop()
+}
+
+/*
+observe applies the templates of the ConfigMap and queues them for reconciliation when its data changed.
+The updates of its status, and the periodic resyncs, leave the data as it is and are ignored.
+*/
+func (w *Watcher) observe(obj any) {
+	configMap, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return
+	}
+	dataHash := hash(configMap.Data)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if dataHash == w.latest {
+		return
+	}
+	w.latest = dataHash
+
+	t, err := Parse(configMap.Data)
+	if err != nil {
+		log.Errorf("Invalid templates in ConfigMap %s/%s, the current templates are kept: %v", w.namespace, w.name, err)
+		go w.setStatus(Status{Phase: PhaseInvalid, Hash: dataHash, Message: err.Error()})
+		return
+	}
+
+	log.Infof("Templates ConfigMap %s/%s changed, reconciling all the tenants", w.namespace, w.name)
+	w.apply(t)
+	w.pending = dataHash
+	if w.cancel != nil {
+		w.cancel()
+	}
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+/*
+run reconciles the pending templates, one at a time, until Stop.
+*/
+func (w *Watcher) run() {
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-w.wake:
+		}
+
+		w.mu.Lock()
+		pending := w.pending
+		w.pending = ""
+		ctx, cancel := context.WithCancel(w.ctx)
+		w.cancel = cancel
+		w.mu.Unlock()
+
+		if pending != "" {
+			w.reconcilePending(ctx, pending)
+		}
+		cancel()
+	}
+}
+
+func (w *Watcher) reconcilePending(ctx context.Context, pending string) {
+	status := Status{Phase: PhaseReconciling, Hash: pending}
+	w.setStatus(status)
+
+	err := w.reconcile(ctx, func(progress Progress) {
+		status.Total, status.Done, status.Failed = progress.Total, progress.Done, progress.Failed
+		if progress.Done%progressInterval == 0 && progress.Done < progress.Total {
+			w.setStatus(status)
+		}
+	})
+	if ctx.Err() != nil {
+		log.Infof("Reconciliation of the templates %s superseded", pending)
+		return
+	}
+
+	if err != nil {
+		log.Errorf("Failed to reconcile the tenants with the templates %s: %v", pending, err)
+		status.Phase = PhaseFailed
+		status.Message = err.Error()
+	} else {
+		log.Infof("Reconciled %d tenants with the templates %s", status.Total, pending)
+		status.Phase = PhaseReconciled
+	}
+	w.setStatus(status)
+}
+
+/*
+setStatus writes status to the annotation of the templates ConfigMap. A failure is only logged: the status
+is overwritten by the next one.
+*/
+func (w *Watcher) setStatus(status Status) {
+	ctx, cancel := context.WithTimeout(w.ctx, statusTimeout)
+	defer cancel()
+
+	status.UpdatedAt = metav1.Now()
+	marshaled, err := json.Marshal(status)
+	if err != nil {
+		log.Errorf("Failed to marshal the templates status: %v", err)
+		return
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{StatusAnnotation: string(marshaled)},
+		},
+	})
+	if err != nil {
+		log.Errorf("Failed to marshal the templates status patch: %v", err)
+		return
+	}
+
+	if _, err := w.clientset.CoreV1().ConfigMaps(w.namespace).Patch(ctx, w.name, types.MergePatchType, patch,
+		metav1.PatchOptions{}); err != nil {
+		log.Errorf("Failed to update the status of the templates ConfigMap %s/%s: %v", w.namespace, w.name, err)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package templates
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const (
+	testNamespace = "orch-platform"
+	testConfigMap = "keycloak-templates"
+	testTimeout   = 10 * time.Second
+)
+
+// fakeTenants records the templates applied by a Watcher, and the reconciliations it runs.
+type fakeTenants struct {
+	mu         sync.Mutex
+	applied    []*Templates
+	reconciled int
+}
+
+func (f *fakeTenants) apply(t *Templates) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.applied = append(f.applied, t)
+}
+
+func (f *fakeTenants) reconcile(_ context.Context, progress func(Progress)) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reconciled++
+	progress(Progress{Total: 2, Done: 2})
+	return nil
+}
+
+func (f *fakeTenants) state() ([]*Templates, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*Templates(nil), f.applied...), f.reconciled
+}
+
+func templatesConfigMap(orgGroups string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testConfigMap},
+		Data:       map[string]string{keyOrgGroups: orgGroups},
+	}
+}
+
+func startWatcher(t *testing.T, configMap *corev1.ConfigMap) (*Watcher, *fake.Clientset, *fakeTenants) {
+	t.Helper()
+	clientset := fake.NewClientset(configMap)
+	tenants := &fakeTenants{}
+	ctx, stop := context.WithCancel(context.Background())
+	w := &Watcher{
+		clientset: clientset,
+		namespace: testNamespace,
+		name:      testConfigMap,
+		apply:     tenants.apply,
+		reconcile: tenants.reconcile,
+		ctx:       ctx,
+		stop:      stop,
+		wake:      make(chan struct{}, 1),
+	}
+	if err := w.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	t.Cleanup(w.Stop)
+	return w, clientset, tenants
+}
+
+// waitForStatus waits until the status of the templates ConfigMap is in phase, and returns it.
+func waitForStatus(t *testing.T, clientset *fake.Clientset, phase, dataHash string) Status {
+	t.Helper()
+	var status Status
+	deadline := time.Now().Add(testTimeout)
+	for time.Now().Before(deadline) {
+		configMap, err := clientset.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), testConfigMap,
+			metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		status = Status{}
+		if annotation := configMap.Annotations[StatusAnnotation]; annotation != "" {
+			if err := json.Unmarshal([]byte(annotation), &status); err != nil {
+				t.Fatalf("status annotation %q: %v", annotation, err)
+			}
+		}
+		if status.Phase == phase && status.Hash == dataHash {
+			return status
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("status %+v, want phase %s for %s", status, phase, dataHash)
+	return status
+}
+
+func TestWatcherReconcilesChanges(t *testing.T) {
+	initial := templatesConfigMap(`{"<org-id>_Project-Manager-Group": ["<org-id>_project-read-role"]}`)
+	_, clientset, tenants := startWatcher(t, initial)
+
+	applied, _ := tenants.state()
+	if len(applied) != 1 || len(applied[0].OrgGroups["<org-id>_Project-Manager-Group"]) != 1 {
+		t.Fatalf("applied %+v on start, want the templates of the ConfigMap", applied)
+	}
+	status := waitForStatus(t, clientset, PhaseReconciled, hash(initial.Data))
+	if status.Total != 2 || status.Done != 2 {
+		t.Errorf("status %+v, want the progress of the reconciliation", status)
+	}
+
+	updated := templatesConfigMap(`{"<org-id>_Project-Manager-Group": ["<org-id>_project-read-role", ` +
+		`"<org-id>_project-write-role"]}`)
+	if _, err := clientset.CoreV1().ConfigMaps(testNamespace).Update(context.Background(), updated,
+		metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	waitForStatus(t, clientset, PhaseReconciled, hash(updated.Data))
+
+	applied, reconciled := tenants.state()
+	if len(applied) != 2 || len(applied[1].OrgGroups["<org-id>_Project-Manager-Group"]) != 2 {
+		t.Errorf("applied %+v, want the updated templates applied without a restart", applied)
+	}
+	if reconciled != 2 {
+		t.Errorf("reconciled %d times, want once on start and once on the change", reconciled)
+	}
+}
+
+func TestWatcherKeepsTemplatesOnInvalidChange(t *testing.T) {
+	initial := templatesConfigMap(`{"<org-id>_Project-Manager-Group": ["<org-id>_project-read-role"]}`)
+	_, clientset, tenants := startWatcher(t, initial)
+	waitForStatus(t, clientset, PhaseReconciled, hash(initial.Data))
+
+	invalid := templatesConfigMap(`{"Project-Manager-Group": ["project-read-role"]}`)
+	if _, err := clientset.CoreV1().ConfigMaps(testNamespace).Update(context.Background(), invalid,
+		metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	status := waitForStatus(t, clientset, PhaseInvalid, hash(invalid.Data))
+	if status.Message == "" {
+		t.Errorf("status %+v, want the validation error", status)
+	}
+
+	applied, reconciled := tenants.state()
+	if len(applied) != 1 || reconciled != 1 {
+		t.Errorf("applied %d templates and reconciled %d times, want the invalid templates ignored",
+			len(applied), reconciled)
+	}
+}
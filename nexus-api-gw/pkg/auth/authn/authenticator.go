@@ -17,9 +17,11 @@ import (
 	jwt "github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/cache"
+	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/config"
 	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/reconciler"
 	tenancy_nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	"github.com/open-edge-platform/orch-library/go/pkg/auth"
@@ -33,6 +35,8 @@ const (
 	ProjectPattern = `^/v[a-z0-9\-]+/projects(/.*)?$`
 	// Match string beginning with /v1/projects/ pattern.
 	ProjectOnlyPattern = `^/v[a-z0-9\-]+/projects(/[^/]+/?)?$`
+	// Match string beginning with /v1/folders/ pattern.
+	FolderPattern = `^/v[a-z0-9\-]+/folders(/([^/]+)(/.*)?)?$`
 	// Match string beginning with /v1/orgs/ pattern.
 	OrgPattern = `^/v[a-z0-9\-]+/orgs(/[\w\-]+/?)?$`
 	// Match string beginning with /v1/orgs/ pattern.
@@ -40,11 +44,18 @@ const (
 	// Regular expression to match the pattern.
 	UserRolePattern = `([a-f0-9\-]+)_([a-f0-9\-]+)_(m|member-role)`
 	// Regular expression to match the pattern.
-	ProjectRolePattern = `([a-f0-9\-]+)_project-(read|write|update|delete)-role`
+	ProjectRolePattern = `([a-f0-9\-]+)_project-(read|write|update|delete|force-delete)-role`
+	// Regular expression to match the org and folder roles of a folder.
+	FolderRolePattern = `([a-f0-9\-]+)_(?:[a-z0-9\-]+_folder-admin|folder-(?:read|write|delete))-role`
+	// Match the name of a folder.
+	FolderNamePattern = `^[a-z0-9]([a-z0-9\-]*[a-z0-9])?$`
 	// TenancyManager Reconcile Period.
 	tmReconcileTime = 600 * time.Second
 	OrgCache        = "orgCache"
 	ProjectCache    = "projectCache"
+	// Labels of a config project naming its org and folder.
+	orgLabel    = "orgs.org.edge-orchestrator.intel.com"
+	folderLabel = "folders.folder.edge-orchestrator.intel.com"
 )
 
 // Compile the regex pattern.
@@ -56,6 +67,9 @@ var (
 	orgurire   = regexp.MustCompile(OrgURIPattern)
 	usrRole    = regexp.MustCompile(UserRolePattern)
 	projRole   = regexp.MustCompile(ProjectRolePattern)
+	folderre   = regexp.MustCompile(FolderPattern)
+	folderRole = regexp.MustCompile(FolderRolePattern)
+	folderName = regexp.MustCompile(FolderNamePattern)
 	appName    = "nexus-api-gw-authn"
 	log        = logging.GetLogger(appName)
 )
@@ -94,7 +108,12 @@ type JwtData struct {
 	ActiveProjectID   string
 	ActiveOrgDeleted  bool
 	ActiveProjDeleted bool
-	OrgName           string
+	// ActiveOrgSuspended and ActiveProjArchived are set for suspended orgs and archived projects.
+	ActiveOrgSuspended bool
+	ActiveProjArchived bool
+	OrgName            string
+	// ActiveFolder is the folder of the project, or the folder, the request is for.
+	ActiveFolder string
 }
 
 func VerifyAuthenticationMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
@@ -112,6 +131,9 @@ func extractOrgsUIDFromToken(projects TokenData) string {
 		if matches := projRole.FindStringSubmatch(role); matches != nil {
 			return matches[1]
 		}
+		if matches := folderRole.FindStringSubmatch(role); matches != nil {
+			return matches[1]
+		}
 	}
 	log.InfraError("No roles found to extract Orgs from JWT. Roles from JWT: %#v", projects.RealmAccess.Roles).Msg("")
 	return ""
@@ -147,6 +169,11 @@ func MatchesProjOnlyPattern(url string) bool {
 	return projonlyre.MatchString(url)
 }
 
+// MatchesFolderPattern checks if a URL starts with /v1/folders.
+func MatchesFolderPattern(url string) bool {
+	return folderre.MatchString(url)
+}
+
 // MatchesOrgPattern checks if a URL starts with /v1/orgs/.
 func MatchesOrgPattern(url string) bool {
 	return orgre.MatchString(url)
@@ -192,6 +219,18 @@ func getFromGlobalCache(objType, key string, tenancyNC *tenancy_nexus_client.Cli
 	return "", "", false
 }
 
+// GetSuspensionStatus retrieves whether the org of orgID is suspended and its project projName archived from the cache.
+func getSuspensionStatus(orgID, projName string) (bool, bool) {
+	orgSuspended, projArchived := false, false
+	if value, ok := cache.GlobalOrgCache.Get(orgID); ok {
+		orgSuspended = value.Suspended
+	}
+	if value, ok := cache.GlobalProjectCache.Get(fmt.Sprintf("%s_%s", orgID, projName)); ok {
+		projArchived = value.Archived
+	}
+	return orgSuspended, projArchived
+}
+
 // GetActiveProjectIDs retrieves project ID from the cache.
 func getActiveProjectIDs(tenancyNC *tenancy_nexus_client.Clientset, orgID, projName string) (string, bool) {
 	key := fmt.Sprintf("%s_%s", orgID, projName)
@@ -200,7 +239,10 @@ func getActiveProjectIDs(tenancyNC *tenancy_nexus_client.Clientset, orgID, projN
 }
 
 // ParseClaimForAuthZ parses the claim map for authorization info.
-func parseClaimForAuthZ(tenancyNC *tenancy_nexus_client.Clientset, uri, method string, claimsMap jwt.MapClaims) JwtData {
+// requestFolder is the folder of the request query, used for projects that do not exist yet.
+func parseClaimForAuthZ(tenancyNC *tenancy_nexus_client.Clientset, uri, method, requestFolder string,
+	claimsMap jwt.MapClaims,
+) JwtData {
 	var jwtData JwtData
 
 	// Marshal claimsMap to JSON
@@ -229,15 +271,71 @@ func parseClaimForAuthZ(tenancyNC *tenancy_nexus_client.Clientset, uri, method s
 		jwtData.ActiveProjectID = projID
 		jwtData.ActiveProjDeleted = deleted
 		_, orgName, jwtData.ActiveOrgDeleted = getActiveOrgDetails(tenancyNC, orgUID)
+		folder, found := getActiveProjectFolder(tenancyNC, orgUID, orgName, projName)
+		if !found {
+			// A new project is created in the folder of the request, and the projects listed are those of the folder.
+			folder = requestFolder
+		}
+		jwtData.ActiveFolder = folder
+		jwtData.ActiveOrgSuspended, jwtData.ActiveProjArchived = getSuspensionStatus(orgUID, projName)
 		jwtData.ActiveOrgID = orgUID
 		log.Debug().Msgf("orgUID=%v, orgName=%s, extracted from jwt", orgUID, orgName)
 	}
 
+	// Process folder pattern
+	if matches := folderre.FindStringSubmatch(uri); matches != nil {
+		orgUID := extractOrgsUIDFromToken(jwtData.Claims)
+		_, orgName, jwtData.ActiveOrgDeleted = getActiveOrgDetails(tenancyNC, orgUID)
+		jwtData.ActiveOrgSuspended, _ = getSuspensionStatus(orgUID, "")
+		jwtData.ActiveOrgID = orgUID
+		jwtData.ActiveFolder = matches[2]
+	}
+
 	// Set organization name
 	jwtData.OrgName = orgName
 	return jwtData
 }
 
+// getActiveProjectFolder retrieves the folder of a project from the cache, or from the labels of its config
+// project until its runtime project is created. It reports whether the org has a project projName.
+func getActiveProjectFolder(tenancyNC *tenancy_nexus_client.Clientset, orgID, orgName, projName string) (string, bool) {
+	if value, ok := cache.GlobalProjectCache.Get(fmt.Sprintf("%s_%s", orgID, projName)); ok {
+		return value.Folder, true
+	}
+	if projName == "" || orgName == "" {
+		return "", false
+	}
+	// The projects may be listed from the informer cache, which ignores the selector.
+	projects, err := tenancyNC.Project().ListProjects(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", orgLabel, orgName),
+	})
+	if err != nil {
+		// Without the folder of the project, the request folder is not trusted either.
+		log.InfraErr(err).Msgf("Failed to look up the folder of project %s", projName)
+		return "", true
+	}
+	for _, project := range projects {
+		if project.DisplayName() == projName && project.GetLabels()[orgLabel] == orgName {
+			return project.GetLabels()[folderLabel], true
+		}
+	}
+	return "", false
+}
+
+// IsFolderAdmin reports whether the user administers the active folder of the request.
+func IsFolderAdmin(jwtData JwtData) bool {
+	if jwtData.ActiveFolder == "" {
+		return false
+	}
+	role := fmt.Sprintf("%s_%s_folder-admin-role", jwtData.ActiveOrgID, jwtData.ActiveFolder)
+	for _, r := range jwtData.Claims.RealmAccess.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
 func VerifyJWT(c echo.Context, tenancyNC *tenancy_nexus_client.Clientset, backendservice bool) (JwtData, *echo.HTTPError) {
 	var jwtData JwtData
 
@@ -258,7 +356,7 @@ func VerifyJWT(c echo.Context, tenancyNC *tenancy_nexus_client.Clientset, backen
 		return jwtData, newHTTPError(http.StatusUnauthorized, "Expecting \"Bearer\" scheme")
 	}
 
-	claims, err := validateJWT(authToken)
+	claims, err := validateJWT(tenancyNC, authToken)
 	if err != nil {
 		log.Error().Msgf("Given JWT token is invalid or expired: error=%s", err.Error())
 		return jwtData, newHTTPError(http.StatusUnauthorized, "JWT token is invalid or expired")
@@ -270,7 +368,11 @@ func VerifyJWT(c echo.Context, tenancyNC *tenancy_nexus_client.Clientset, backen
 		return jwtData, newHTTPError(http.StatusForbidden, "Error converting claims to a map")
 	}
 
-	jwtData = parseClaimForAuthZ(tenancyNC, strings.Split(c.Request().RequestURI, "?")[0], c.Request().Method, claimsMap)
+	jwtData = parseClaimForAuthZ(tenancyNC, strings.Split(c.Request().RequestURI, "?")[0], c.Request().Method,
+		c.QueryParam("folder"), claimsMap)
+	if !folderName.MatchString(jwtData.ActiveFolder) {
+		jwtData.ActiveFolder = ""
+	}
 
 	if err := validateJWTData(tenancyNC, jwtData, c.Request().RequestURI, c.Request().Method, backendservice); err != nil {
 		log.Error().Msgf("Failed to validate the jwt with err: %s", err.Error())
@@ -300,7 +402,10 @@ func parseAuthHeader(authHeader string) (string, string, error) {
 	return authPair[0], authPair[1], nil
 }
 
-func validateJWT(authToken string) (jwt.Claims, error) {
+func validateJWT(tenancyNC *tenancy_nexus_client.Clientset, authToken string) (jwt.Claims, error) {
+	if realm, ok := orgRealmOf(authToken); ok {
+		return validateOrgRealmJWT(tenancyNC, realm, authToken)
+	}
 	jwtAuth := new(auth.JwtAuthenticator)
 	return jwtAuth.ParseAndValidate(authToken)
 }
@@ -326,6 +431,11 @@ func validateJWTData(
 		return err
 	}
 
+	if err := validateSuspensionStatus(jwtData, method); err != nil {
+		log.Error().Msgf("Failed to validateSuspensionStatus with error='%s'", err.Error())
+		return err
+	}
+
 	return nil
 }
 
@@ -344,7 +454,7 @@ func validateBackendService(jwtData JwtData, uri string, backendservice bool) *e
 }
 
 func validateOrgData(tenancyNC *tenancy_nexus_client.Clientset, jwtData JwtData, uri, method string) *echo.HTTPError {
-	if (jwtData.ActiveOrgID == "" || jwtData.OrgName == "") && MatchesProjPattern(uri) {
+	if (jwtData.ActiveOrgID == "" || jwtData.OrgName == "") && (MatchesProjPattern(uri) || MatchesFolderPattern(uri)) {
 		log.InfraError("Unable to determine the organizations for URI request'%s'. Please check the JWT.", method).Msg("")
 		return newHTTPError(http.StatusBadRequest, "Unable to determine the organizations.")
 	}
@@ -385,6 +495,27 @@ func validateDeletionStatus(jwtData JwtData, method string) *echo.HTTPError {
 	return nil
 }
 
+func validateSuspensionStatus(jwtData JwtData, method string) *echo.HTTPError {
+	// An archived project itself stays writable, to be unarchived.
+	archived := jwtData.ActiveProjArchived && !MatchesProjOnlyPattern(jwtData.URN)
+	if !jwtData.ActiveOrgSuspended && !archived {
+		return nil
+	}
+	if method != http.MethodGet || (config.Cfg != nil && config.Cfg.BlockSuspendedReads) {
+		log.InfraError(
+			"Operation not supported. Requested resource 'URI: %s' is suspended or archived. Please check the JWT.",
+			jwtData.URN).Msg("")
+		return newHTTPError(http.StatusForbidden, "Operation not supported. Requested resource is suspended or archived.")
+	}
+	log.Debug().
+		Msgf(
+			"validateSuspensionStatus success. ActiveOrgSuspended: %t, ActiveProjArchived: %t,  uri: %s",
+			jwtData.ActiveOrgSuspended,
+			jwtData.ActiveProjArchived,
+			jwtData.URN)
+	return nil
+}
+
 func newHTTPError(code int, message string) *echo.HTTPError {
 	return &echo.HTTPError{
 		Code:    code,
@@ -6,6 +6,7 @@
 package authn_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -17,8 +18,12 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/auth/authn"
+	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/cache"
+	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/common"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
 	tenancy_nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
@@ -47,16 +52,19 @@ func createRequestWithUserAgent(userAgent string) *http.Request {
 
 // To generates a valid JWT token for testing purposes.
 func generateValidJWT(tb testing.TB) (jwtStr string, err error) {
+	tb.Helper()
+	return generateJWT(tb, writeRole, readRole)
+}
+
+// To generates a valid JWT token with the given realm roles.
+func generateJWT(tb testing.TB, roles ...string) (jwtStr string, err error) {
 	tb.Helper()
 	claims := &jwt.MapClaims{
 		"iss": "https://keycloak.kind.internal/realms/master",
 		"exp": time.Now().Add(time.Hour).Unix(),
 		"typ": "Bearer",
 		"realm_access": map[string]interface{}{
-			"roles": []string{
-				writeRole,
-				readRole,
-			},
+			"roles": roles,
 		},
 	}
 	tb.Setenv(SharedSecretKey, secretKey)
@@ -240,3 +248,82 @@ func FuzzVerifyJWT(f *testing.F) {
 		}
 	})
 }
+
+func TestVerifyJWTResolvesProjectFolder(t *testing.T) {
+	const orgID = "0f1e2d3c-4b5a-6978-8a9b-0c1d2e3f4a5b"
+	cache.InitializeCaches()
+	cache.GlobalOrgCache.Set(orgID, common.Org{Name: "acme", UID: orgID})
+
+	// The project p1 of the org acme has no runtime project yet, and is only found by its config project.
+	nexusClient := tenancy_nexus_client.NewFakeClient()
+	_, err := nexusClient.Project().CreateProjectByName(context.Background(), &projectv1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "p1-hashed",
+			Labels: map[string]string{
+				"nexus/display_name":                         "p1",
+				"orgs.org.edge-orchestrator.intel.com":       "acme",
+				"folders.folder.edge-orchestrator.intel.com": "team-b",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating the project: %v", err)
+	}
+
+	// The caller administers the folder team-a only.
+	jwtStr, err := generateJWT(t, orgID+"_team-a_folder-admin-role")
+	if err != nil {
+		t.Fatalf("Error signing token: %v", err)
+	}
+	e := echo.New()
+
+	tests := []struct {
+		name        string
+		target      string
+		wantFolder  string
+		folderAdmin bool
+	}{
+		{
+			name:       "existing project in another folder than the request",
+			target:     "/v1/projects/p1?folder=team-a",
+			wantFolder: "team-b",
+		},
+		{
+			name:        "new project in the folder of the request",
+			target:      "/v1/projects/p2?folder=team-a",
+			wantFolder:  "team-a",
+			folderAdmin: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, tt.target, http.NoBody)
+			req.Header.Set("Authorization", "Bearer "+jwtStr)
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			jwtData, _ := authn.VerifyJWT(c, nexusClient, false)
+
+			assert.Equal(t, tt.wantFolder, jwtData.ActiveFolder)
+			assert.Equal(t, tt.folderAdmin, authn.IsFolderAdmin(jwtData))
+		})
+	}
+}
+
+func TestIsFolderAdmin(t *testing.T) {
+	orgID := "8d3c6b1a-0b2e-4f4e-9d3a-2f6c1b7e5a10"
+	jwtData := authn.JwtData{ActiveOrgID: orgID, ActiveFolder: "team-a"}
+	jwtData.Claims.RealmAccess.Roles = []string{orgID + "_team-a_folder-admin-role"}
+	assert.True(t, authn.IsFolderAdmin(jwtData))
+
+	jwtData.ActiveFolder = "team-b"
+	assert.False(t, authn.IsFolderAdmin(jwtData))
+
+	jwtData.ActiveFolder = ""
+	assert.False(t, authn.IsFolderAdmin(jwtData))
+}
+
+func TestMatchesFolderPattern(t *testing.T) {
+	assert.True(t, authn.MatchesFolderPattern("/v1/folders"))
+	assert.True(t, authn.MatchesFolderPattern("/v1/folders/team-a"))
+	assert.False(t, authn.MatchesFolderPattern("/v1/projects/team-a"))
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/config"
+	tenancy_nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+
+	"github.com/open-edge-platform/orch-library/go/pkg/auth"
+)
+
+const (
+	// realmsPath separates the Keycloak URL from the realm name in OIDC_SERVER_URL and in the token issuers.
+	realmsPath = "/realms/"
+	// certsPath is the path of the signing keys of a realm.
+	certsPath = "/protocol/openid-connect/certs"
+	// jwksTimeout bounds the fetch of the signing keys of a realm.
+	jwksTimeout = 10 * time.Second
+)
+
+var (
+	// orgRealmName matches the realm of an org, named after the org UID.
+	orgRealmName = regexp.MustCompile(`^[a-f0-9\-]+$`)
+	// orgRealmKeys caches the signing keys of the org realms, by realm and key ID.
+	orgRealmKeys   = map[string]map[string]any{}
+	orgRealmKeysMu sync.Mutex
+	jwksClient     = &http.Client{Timeout: jwksTimeout}
+)
+
+/*
+orgRealmOf returns the org realm that issued authToken, without verifying the token. It is only set when
+nexus-api-gw trusts the realms that keycloak-tenant-controller provisions for the orgs in its org-realm mode,
+and the token is issued by another realm than the one of OIDC_SERVER_URL.
+*/
+func orgRealmOf(authToken string) (string, bool) {
+	if config.Cfg == nil || !config.Cfg.TrustOrgRealms {
+		return "", false
+	}
+	_, defaultRealm, found := strings.Cut(os.Getenv(auth.OIDCServerURL), realmsPath)
+	if !found {
+		return "", false
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(authToken, claims); err != nil {
+		return "", false
+	}
+	issuer, err := claims.GetIssuer()
+	if err != nil {
+		return "", false
+	}
+	_, realm, found := strings.Cut(issuer, realmsPath)
+	if !found || realm == defaultRealm || !orgRealmName.MatchString(realm) {
+		return "", false
+	}
+	return realm, true
+}
+
+/*
+validateOrgRealmJWT verifies authToken, issued by the realm of an org, with the signing keys of that realm.
+The keys are fetched from the Keycloak of OIDC_SERVER_URL, never from the host of the issuer, which the
+token sets. The admins of an org realm may create any role in it, so only the roles of the org are kept.
+*/
+func validateOrgRealmJWT(tenancyNC *tenancy_nexus_client.Clientset, realm, authToken string) (jwt.Claims, error) {
+	orgUID, _, _ := getActiveOrgDetails(tenancyNC, realm)
+	if orgUID == "" {
+		return nil, fmt.Errorf("%w: issued by the realm %s of no org", ErrTokenClaimsInvalid, realm)
+	}
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(authToken, claims, func(token *jwt.Token) (any, error) {
+		keyID, _ := token.Header["kid"].(string)
+		return orgRealmKey(realm, keyID)
+	}, jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}),
+		jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+	keepOrgRoles(claims, orgUID)
+	return claims, nil
+}
+
+// orgRealmKey returns the signing key keyID of realm, fetching the keys of the realm again when it is unknown.
+func orgRealmKey(realm, keyID string) (any, error) {
+	orgRealmKeysMu.Lock()
+	defer orgRealmKeysMu.Unlock()
+	if key, ok := orgRealmKeys[realm][keyID]; ok {
+		return key, nil
+	}
+	keys, err := fetchRealmKeys(realm)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrJwksNotInitialized, err)
+	}
+	orgRealmKeys[realm] = keys
+	key, ok := keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown key ID %q of the realm %s", ErrTokenSignatureInvalid, keyID, realm)
+	}
+	return key, nil
+}
+
+// fetchRealmKeys returns the signing keys of realm, by key ID.
+func fetchRealmKeys(realm string) (map[string]any, error) {
+	keycloakURL, _, _ := strings.Cut(os.Getenv(auth.OIDCServerURL), realmsPath)
+	url := keycloakURL + realmsPath + realm + certsPath
+	resp, err := jwksClient.Get(url) //nolint:noctx // Called from the key function of the JWT parser.
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the keys of the realm %s: %w", realm, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to get the keys of the realm %s: %s", realm, resp.Status)
+	}
+	var jwks jose.JSONWebKeySet
+	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
+		return nil, fmt.Errorf("unable to decode the keys of the realm %s: %w", realm, err)
+	}
+	keys := map[string]any{}
+	for _, key := range jwks.Keys {
+		if key.Use == "" || key.Use == "sig" {
+			keys[key.KeyID] = key.Key
+		}
+	}
+	return keys, nil
+}
+
+// keepOrgRoles removes from the realm roles of claims those that are not roles of the org orgUID.
+func keepOrgRoles(claims jwt.MapClaims, orgUID string) {
+	realmAccess, ok := claims["realm_access"].(map[string]any)
+	if !ok {
+		return
+	}
+	roles, _ := realmAccess["roles"].([]any)
+	kept := []any{}
+	for _, role := range roles {
+		if name, ok := role.(string); ok && strings.HasPrefix(name, orgUID+"_") {
+			kept = append(kept, name)
+		}
+	}
+	realmAccess["roles"] = kept
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authn_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/auth/authn"
+	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/cache"
+	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/common"
+	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/config"
+	tenancy_nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/stretchr/testify/assert"
+)
+
+// newOrgRealmKeycloak serves the signing key of the realm of the org orgID, and sets it as the Keycloak of
+// OIDC_SERVER_URL.
+func newOrgRealmKeycloak(t *testing.T, orgID string) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating the key: %v", err)
+	}
+	jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "org-key", Use: "sig"}}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/realms/"+orgID+"/protocol/openid-connect/certs", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(jwks)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	t.Setenv("OIDC_SERVER_URL", server.URL+"/realms/master")
+	return key
+}
+
+// generateRealmJWT signs a token of the realm realm with key, with the given realm roles.
+func generateRealmJWT(t *testing.T, key *rsa.PrivateKey, realm string, roles ...string) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":          "https://keycloak.kind.internal/realms/" + realm,
+		"exp":          time.Now().Add(time.Hour).Unix(),
+		"typ":          "Bearer",
+		"realm_access": map[string]interface{}{"roles": roles},
+	})
+	token.Header["kid"] = "org-key"
+	jwtStr, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("Error signing token: %v", err)
+	}
+	return jwtStr
+}
+
+func TestVerifyJWTOfOrgRealm(t *testing.T) {
+	const (
+		orgID   = "1a2b3c4d-5e6f-4a8b-9c0d-1e2f3a4b5c6d"
+		otherID = "6d5c4b3a-2f1e-4d0c-9b8a-7f6e5d4c3b2a"
+	)
+	cache.InitializeCaches()
+	cache.GlobalOrgCache.Set(orgID, common.Org{Name: "acme", UID: orgID})
+	key := newOrgRealmKeycloak(t, orgID)
+	savedCfg := config.Cfg
+	t.Cleanup(func() { config.Cfg = savedCfg })
+	nexusClient := tenancy_nexus_client.NewFakeClient()
+	e := echo.New()
+
+	// The admins of the realm of the org may create roles of other orgs, and global roles, in it.
+	roles := []string{orgID + "_project-read-role", otherID + "_project-write-role", "org-delete-role"}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating the key: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		trustOrgRealms bool
+		token          string
+		wantStatus     int
+		wantRoles      []string
+	}{
+		{
+			name:           "token of the realm of an org",
+			trustOrgRealms: true,
+			token:          generateRealmJWT(t, key, orgID, roles...),
+			wantStatus:     http.StatusOK,
+			wantRoles:      []string{orgID + "_project-read-role"},
+		},
+		{
+			name:       "org realms not trusted",
+			token:      generateRealmJWT(t, key, orgID, roles...),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "token of the realm of no org",
+			trustOrgRealms: true,
+			token:          generateRealmJWT(t, key, otherID, roles...),
+			wantStatus:     http.StatusUnauthorized,
+		},
+		{
+			name:           "token signed with another key",
+			trustOrgRealms: true,
+			token:          generateRealmJWT(t, otherKey, orgID, roles...),
+			wantStatus:     http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.Cfg = &config.Config{TrustOrgRealms: tt.trustOrgRealms}
+			req := httptest.NewRequest(http.MethodGet, "/v1/orgs", http.NoBody)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			jwtData, httpErr := authn.VerifyJWT(c, nexusClient, false)
+
+			assert.Equal(t, tt.wantStatus, httpErr.Code)
+			if tt.wantRoles != nil {
+				assert.Equal(t, tt.wantRoles, jwtData.Claims.RealmAccess.Roles)
+			}
+		})
+	}
+}
@@ -43,6 +43,7 @@ type PolicyInput struct {
 	Method    string   `json:"method"`
 	OrgID     string   `json:"orgId"`
 	ProjectID string   `json:"projectId"`
+	FolderID  string   `json:"folderId"`
 	Roles     []string `json:"roles"`
 }
 
@@ -82,6 +83,7 @@ func VerifyAuthorization(jwtClaims authn.JwtData) *echo.HTTPError {
 		Method:    strings.ToLower(jwtClaims.Method),
 		OrgID:     jwtClaims.ActiveOrgID,
 		ProjectID: jwtClaims.ActiveProjectID,
+		FolderID:  jwtClaims.ActiveFolder,
 		Roles:     jwtClaims.Claims.RealmAccess.Roles,
 	}
 	log.Debug().Msgf("policyInput for authz rego - %v", policyInput)
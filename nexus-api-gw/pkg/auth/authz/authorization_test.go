@@ -89,6 +89,45 @@ var _ = ginkgo.Describe("Authorization", func() {
 				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusAccepted))
 				gomega.Expect(httpError.Message).To(gomega.Equal(http.StatusText(http.StatusAccepted)))
 			})
+			ginkgo.It("should return accepted for retrying an org", func() {
+				jwtClaims = authn.JwtData{
+					URN:    "/v1/orgs/test-org2/retry",
+					Method: "post",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"org-write-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusAccepted))
+			})
+			ginkgo.It("should return accepted for force-deleting an org", func() {
+				jwtClaims = authn.JwtData{
+					URN:    "/v1/orgs/test-org2/force-delete",
+					Method: "post",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"org-force-delete-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusAccepted))
+			})
+			ginkgo.It("should not allow force-deleting an org with the write or delete role", func() {
+				jwtClaims = authn.JwtData{
+					URN:    "/v1/orgs/test-org2/force-delete",
+					Method: "post",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"org-write-role", "org-delete-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusUnauthorized))
+			})
 		})
 		ginkgo.Context("version changes in api", func() {
 			ginkgo.It("should return accepted for v1beta", func() {
@@ -347,6 +386,34 @@ var _ = ginkgo.Describe("Authorization", func() {
 				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusAccepted))
 				gomega.Expect(httpError.Message).To(gomega.Equal(http.StatusText(http.StatusAccepted)))
 			})
+			ginkgo.It("should return accepted for force-deleting a project", func() {
+				jwtClaims = authn.JwtData{
+					URN:         "/v1/projects/test-proj2/force-delete",
+					Method:      "post",
+					ActiveOrgID: "f829cb3a-6a90-11ef-9f62-e3315546a473",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"f829cb3a-6a90-11ef-9f62-e3315546a473_project-force-delete-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusAccepted))
+			})
+			ginkgo.It("should return unauthorized for force-deleting a project with the delete role", func() {
+				jwtClaims = authn.JwtData{
+					URN:         "/v1/projects/test-proj2/force-delete",
+					Method:      "post",
+					ActiveOrgID: "f829cb3a-6a90-11ef-9f62-e3315546a473",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"f829cb3a-6a90-11ef-9f62-e3315546a473_project-delete-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusUnauthorized))
+			})
 			ginkgo.It("should return unauthorized for updating a project with member access", func() {
 				jwtClaims = authn.JwtData{
 					URN:         "/v1/projects/test-proj",
@@ -1009,6 +1076,187 @@ var _ = ginkgo.Describe("Authorization", func() {
 			})
 		})
 	})
+	ginkgo.Describe("Folder Admin Persona - Tenancy Projects and Folders", func() {
+		var jwtClaims authn.JwtData
+		ginkgo.Context("projects of the folder", func() {
+			ginkgo.It("should return accepted for creating a project in the folder", func() {
+				jwtClaims = authn.JwtData{
+					URN:          "/v1/projects/test-proj",
+					Method:       "put",
+					ActiveOrgID:  "f829cb3a-6a90-11ef-9f62",
+					ActiveFolder: "team-a",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"f829cb3a-6a90-11ef-9f62_team-a_folder-admin-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusAccepted))
+				gomega.Expect(httpError.Message).To(gomega.Equal(http.StatusText(http.StatusAccepted)))
+			})
+			ginkgo.It("should return accepted for deleting a project of the folder", func() {
+				jwtClaims = authn.JwtData{
+					URN:          "/v1/projects/test-proj",
+					Method:       "delete",
+					ActiveOrgID:  "f829cb3a-6a90-11ef-9f62",
+					ActiveFolder: "team-a",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"f829cb3a-6a90-11ef-9f62_team-a_folder-admin-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusAccepted))
+				gomega.Expect(httpError.Message).To(gomega.Equal(http.StatusText(http.StatusAccepted)))
+			})
+			ginkgo.It("should return accepted for the networks of a project of the folder", func() {
+				jwtClaims = authn.JwtData{
+					URN:          "/v1/projects/test-proj/networks/net",
+					Method:       "put",
+					ActiveOrgID:  "f829cb3a-6a90-11ef-9f62",
+					ActiveFolder: "team-a",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"f829cb3a-6a90-11ef-9f62_team-a_folder-admin-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusAccepted))
+				gomega.Expect(httpError.Message).To(gomega.Equal(http.StatusText(http.StatusAccepted)))
+			})
+			ginkgo.It("should return unauthorized for a project of another folder", func() {
+				jwtClaims = authn.JwtData{
+					URN:          "/v1/projects/test-proj",
+					Method:       "delete",
+					ActiveOrgID:  "f829cb3a-6a90-11ef-9f62",
+					ActiveFolder: "team-b",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"f829cb3a-6a90-11ef-9f62_team-a_folder-admin-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusUnauthorized))
+				gomega.Expect(httpError.Message).To(gomega.Equal(http.StatusText(http.StatusUnauthorized)))
+			})
+			ginkgo.It("should return unauthorized for moving a project out of the folder", func() {
+				jwtClaims = authn.JwtData{
+					URN:          "/v1/projects/test-proj/move",
+					Method:       "post",
+					ActiveOrgID:  "f829cb3a-6a90-11ef-9f62",
+					ActiveFolder: "team-a",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"f829cb3a-6a90-11ef-9f62_team-a_folder-admin-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusUnauthorized))
+				gomega.Expect(httpError.Message).To(gomega.Equal(http.StatusText(http.StatusUnauthorized)))
+			})
+			ginkgo.It("should return unauthorized for force-deleting a project of the folder", func() {
+				jwtClaims = authn.JwtData{
+					URN:          "/v1/projects/test-proj/force-delete",
+					Method:       "post",
+					ActiveOrgID:  "f829cb3a-6a90-11ef-9f62",
+					ActiveFolder: "team-a",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"f829cb3a-6a90-11ef-9f62_team-a_folder-admin-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusUnauthorized))
+			})
+			ginkgo.It("should return unauthorized without an active folder", func() {
+				jwtClaims = authn.JwtData{
+					URN:         "/v1/projects/test-proj",
+					Method:      "put",
+					ActiveOrgID: "f829cb3a-6a90-11ef-9f62",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"f829cb3a-6a90-11ef-9f62_team-a_folder-admin-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusUnauthorized))
+				gomega.Expect(httpError.Message).To(gomega.Equal(http.StatusText(http.StatusUnauthorized)))
+			})
+		})
+		ginkgo.Context("folders", func() {
+			ginkgo.It("should return accepted for creating a folder", func() {
+				jwtClaims = authn.JwtData{
+					URN:          "/v1/folders/team-a",
+					Method:       "put",
+					ActiveOrgID:  "f829cb3a-6a90-11ef-9f62",
+					ActiveFolder: "team-a",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"f829cb3a-6a90-11ef-9f62_folder-write-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusAccepted))
+				gomega.Expect(httpError.Message).To(gomega.Equal(http.StatusText(http.StatusAccepted)))
+			})
+			ginkgo.It("should return accepted for deleting a folder", func() {
+				jwtClaims = authn.JwtData{
+					URN:          "/v1/folders/team-a",
+					Method:       "delete",
+					ActiveOrgID:  "f829cb3a-6a90-11ef-9f62",
+					ActiveFolder: "team-a",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"f829cb3a-6a90-11ef-9f62_folder-delete-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusAccepted))
+				gomega.Expect(httpError.Message).To(gomega.Equal(http.StatusText(http.StatusAccepted)))
+			})
+			ginkgo.It("should return unauthorized for a folder admin deleting the folder", func() {
+				jwtClaims = authn.JwtData{
+					URN:          "/v1/folders/team-a",
+					Method:       "delete",
+					ActiveOrgID:  "f829cb3a-6a90-11ef-9f62",
+					ActiveFolder: "team-a",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"f829cb3a-6a90-11ef-9f62_team-a_folder-admin-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusUnauthorized))
+				gomega.Expect(httpError.Message).To(gomega.Equal(http.StatusText(http.StatusUnauthorized)))
+			})
+			ginkgo.It("should return accepted for moving a project", func() {
+				jwtClaims = authn.JwtData{
+					URN:          "/v1/projects/test-proj/move",
+					Method:       "post",
+					ActiveOrgID:  "f829cb3a-6a90-11ef-9f62",
+					ActiveFolder: "team-a",
+					Claims: authn.TokenData{
+						RealmAccess: authn.RealmAccess{
+							Roles: []string{"f829cb3a-6a90-11ef-9f62_project-write-role"},
+						},
+					},
+				}
+				httpError := authz.VerifyAuthorization(jwtClaims)
+				gomega.Expect(httpError.Code).To(gomega.Equal(http.StatusAccepted))
+				gomega.Expect(httpError.Message).To(gomega.Equal(http.StatusText(http.StatusAccepted)))
+			})
+		})
+	})
 })
 
 func FuzzVerifyAuthorization(f *testing.F) {
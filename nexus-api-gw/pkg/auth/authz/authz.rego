@@ -10,11 +10,16 @@ import future.keywords.in
 # rules for specific objects in data model
 rules := {
 	"org-read-role": {"resource": `^/v[a-zA-Z0-9]+/orgs(/[^/]+(/status)?)?$`, "methods": ["get"]},
-	"org-write-role": {"resource": `^/v[a-zA-Z0-9]+/orgs/[^/]+$`, "methods": ["put"]},
+	"org-write-role": {"resource": `^/v[a-zA-Z0-9]+/orgs/[^/]+(/retry)?$`, "methods": ["put","post"]},
 	"org-delete-role": {"resource": `^/v[a-zA-Z0-9]+/orgs/[^/]+$`, "methods": ["delete"]},
+	"org-force-delete-role": {"resource": `^/v[a-zA-Z0-9]+/orgs/[^/]+/force-delete$`, "methods": ["post"]},
 	"project-read-role": {"resource": `^/v[a-zA-Z0-9]+/projects(/[^/]+(/status)?)?$`, "methods": ["get"]},
-	"project-write-role": {"resource": `^/v[a-zA-Z0-9]+/projects/[^/]+$`, "methods": ["put"]},
+	"project-write-role": {"resource": `^/v[a-zA-Z0-9]+/projects/[^/]+(/retry|/move)?$`, "methods": ["put","post"]},
 	"project-delete-role": {"resource": `^/v[a-zA-Z0-9]+/projects/[^/]+$`, "methods": ["delete"]},
+	"project-force-delete-role": {"resource": `^/v[a-zA-Z0-9]+/projects/[^/]+/force-delete$`, "methods": ["post"]},
+	"folder-read-role": {"resource": `^/v[a-zA-Z0-9]+/folders(/[^/]+)?$`, "methods": ["get"]},
+	"folder-write-role": {"resource": `^/v[a-zA-Z0-9]+/folders/[^/]+$`, "methods": ["put","patch"]},
+	"folder-delete-role": {"resource": `^/v[a-zA-Z0-9]+/folders/[^/]+$`, "methods": ["delete"]},
 	"app-deployment-manager-read-role": {"resource": `^/v[a-zA-Z0-9]+/projects/[^/]+/networks(/[^/]+(/status)?)?$`, "methods": ["get"]},
 	"app-deployment-manager-write-role": {"resource": `^/v[a-zA-Z0-9]+/projects/[^/]+/networks/[^/]+$`, "methods": ["put","delete"]},
 }
@@ -24,6 +29,12 @@ member_rules := {
     "member-role": {"resource": `^/v[a-zA-Z0-9]+/projects(/[^/]+(/.*)?)?$`, "methods": ["get","put","post","delete","patch"]},
 }
 
+# rules for the projects of a folder, for the admins of the folder. Moving a project out of it takes the project-write-role,
+# and force-deleting it the project-force-delete-role.
+folder_rules := {
+    "folder-admin-role": {"resource": `^/v[a-zA-Z0-9]+/projects(/[^/]+(/.*)?)?$`, "methods": ["get","put","post","delete","patch"]},
+}
+
 hasSpecificRule if {
     some roleName
     rule := rules[roleName]
@@ -46,7 +57,7 @@ first_matching_role(pattern, roles) = item {
 } else = null
 
 get_claim_name(roleName) = name if {
-    regex.match(`^project-.*$`, roleName)
+    regex.match(`^(project|folder)-.*-role$`, roleName)
     input.orgId != null
     input.orgId != ""
     name = sprintf("%s_%s",[input.orgId,roleName])
@@ -122,6 +133,20 @@ allow = result if {
     claimRole = getValidClaim(claim_name)
     claimRole.present
     result = {"allow": true, "claim": claimRole.claim}
+} else = result if {
+    # then the folder rules, for the projects of the folder the request is for
+    some roleName
+    rule := folder_rules[roleName]
+	regex.match(rule.resource, input.resource)
+    not regex.match(`/(move|force-delete)$`, input.resource)
+	rule.methods[_] == input.method
+    input.orgId != null
+    input.orgId != ""
+    input.folderId != null
+    input.folderId != ""
+    item := first_matching_role(sprintf("^%s_%s_%s$",[input.orgId,input.folderId,roleName]), input.roles)
+    item != null
+    result = {"allow": true, "claim": item}
 } else = result if {
     # if no specific rule matched, check the member_rules
     some roleName
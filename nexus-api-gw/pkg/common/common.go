@@ -27,17 +27,21 @@ var AllowedStates = []string{
 
 // Project represents the structure you want to store.
 type Project struct {
-	UID     string
-	Name    string
-	Org     Org
-	Deleted bool
+	UID      string
+	Name     string
+	Org      Org
+	Deleted  bool
+	Archived bool
+	// Folder is the folder of the config project, which may differ from the runtime folder of a moved project.
+	Folder string
 }
 
 // Org represents organization details.
 type Org struct {
-	Name    string
-	UID     string
-	Deleted bool
+	Name      string
+	UID       string
+	Deleted   bool
+	Suspended bool
 }
 
 // API Remapping.
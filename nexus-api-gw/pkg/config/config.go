@@ -23,6 +23,10 @@ type Config struct {
 	TenancyService     bool         `json:"tenancyService" yaml:"tenancyService,omitempty"`
 	TenantAPIGwDomain  string       `json:"tenantApiGwDomain" yaml:"tenantApiGwDomain,omitempty"`
 	CustomNotFoundPage string       `json:"customNotFoundPage" yaml:"customNotFoundPage,omitempty"`
+	// BlockSuspendedReads rejects reads, as well as writes, of suspended orgs and archived projects.
+	BlockSuspendedReads bool `json:"blockSuspendedReads" yaml:"blockSuspendedReads,omitempty"`
+	// TrustOrgRealms accepts the tokens of the realms keycloak-tenant-controller provisions for the orgs.
+	TrustOrgRealms bool `json:"trustOrgRealms" yaml:"trustOrgRealms,omitempty"`
 }
 
 type ServerConfig struct {
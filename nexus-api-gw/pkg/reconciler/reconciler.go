@@ -138,6 +138,7 @@ func (tdm *TenancyDM) PeriodicReconciler(ctx context.Context) {
 		org.Name = rOrg.DisplayName()
 		org.UID = string(rOrg.UID)
 		org.Deleted = rOrg.Spec.Deleted
+		org.Suspended = rOrg.Spec.Suspended
 		cache.GlobalOrgCache.Set(string(rOrg.UID), org)
 
 		folderOrgs := rOrg.GetAllFoldersIter(ctx)
@@ -149,9 +150,12 @@ func (tdm *TenancyDM) PeriodicReconciler(ctx context.Context) {
 				proj.Name = pObj.DisplayName()
 				proj.UID = string(pObj.UID)
 				proj.Deleted = pObj.Spec.Deleted
+				proj.Archived = pObj.Spec.Archived
+				proj.Folder = projectFolder(pObj, fOrg.DisplayName())
 				proj.Org.Name = rOrg.DisplayName()
 				proj.Org.UID = string(rOrg.UID)
 				proj.Org.Deleted = rOrg.Spec.Deleted
+				proj.Org.Suspended = rOrg.Spec.Suspended
 				projKey := fmt.Sprintf("%s_%s", rOrg.UID, pObj.DisplayName())
 				cache.GlobalProjectCache.Set(projKey, proj)
 			}
@@ -159,6 +163,14 @@ func (tdm *TenancyDM) PeriodicReconciler(ctx context.Context) {
 	}
 }
 
+// projectFolder returns the folder of the config project of a runtime project, created in runtimeFolder.
+func projectFolder(project *nexus_client.RuntimeprojectRuntimeProject, runtimeFolder string) string {
+	if project.Spec.Folder != "" {
+		return project.Spec.Folder
+	}
+	return runtimeFolder
+}
+
 func (tdm *TenancyDM) createProjectFromRuntimeProject(project *nexus_client.RuntimeprojectRuntimeProject,
 ) (*common.Project, error) {
 	log.Info().Msgf("Processing project with UID: %s", project.UID)
@@ -174,13 +186,16 @@ func (tdm *TenancyDM) createProjectFromRuntimeProject(project *nexus_client.Runt
 	}
 
 	proj := &common.Project{
-		Name:    project.DisplayName(),
-		UID:     string(project.UID),
-		Deleted: project.Spec.Deleted,
+		Name:     project.DisplayName(),
+		UID:      string(project.UID),
+		Deleted:  project.Spec.Deleted,
+		Archived: project.Spec.Archived,
+		Folder:   projectFolder(project, folderOrgs.DisplayName()),
 		Org: common.Org{
-			Name:    runtimeOrg.DisplayName(),
-			UID:     string(runtimeOrg.UID),
-			Deleted: runtimeOrg.Spec.Deleted,
+			Name:      runtimeOrg.DisplayName(),
+			UID:       string(runtimeOrg.UID),
+			Deleted:   runtimeOrg.Spec.Deleted,
+			Suspended: runtimeOrg.Spec.Suspended,
 		},
 	}
 
@@ -209,10 +224,15 @@ func (tdm *TenancyDM) UpdateProjectFromCache(_, newObj *nexus_client.Runtimeproj
 		return
 	}
 
-	if value, ok := cache.GlobalProjectCache.Get(string(newObj.UID)); ok {
+	// Projects are cached by the UID of their org and their name.
+	projKey := fmt.Sprintf("%s_%s", proj.Org.UID, proj.Name)
+	if value, ok := cache.GlobalProjectCache.Get(projKey); ok {
 		value.Deleted = proj.Deleted
+		value.Archived = proj.Archived
+		value.Folder = proj.Folder
 		value.Org.Deleted = proj.Org.Deleted
-		cache.GlobalProjectCache.Set(value.UID, value)
+		value.Org.Suspended = proj.Org.Suspended
+		cache.GlobalProjectCache.Set(projKey, value)
 	}
 }
 
@@ -241,6 +261,7 @@ func (tdm *TenancyDM) ADDOrgToCache(orgObj *nexus_client.RuntimeorgRuntimeOrg) {
 	org.Name = orgObj.DisplayName()
 	org.UID = string(orgObj.UID)
 	org.Deleted = orgObj.Spec.Deleted
+	org.Suspended = orgObj.Spec.Suspended
 	cache.GlobalOrgCache.Set(string(orgObj.UID), org)
 }
 
@@ -249,6 +270,7 @@ func (tdm *TenancyDM) UpdateOrgFromCache(_, newObj *nexus_client.RuntimeorgRunti
 
 	if value, ok := cache.GlobalOrgCache.Get(string(newObj.UID)); ok {
 		value.Deleted = newObj.Spec.Deleted
+		value.Suspended = newObj.Spec.Suspended
 		cache.GlobalOrgCache.Set(value.UID, value)
 	}
 }
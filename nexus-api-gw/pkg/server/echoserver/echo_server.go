@@ -220,6 +220,42 @@ func (s *EchoServer) RegisterRouter(restURI nexus.RestURIs) {
 		log.Info().Msgf("Registered Router Path %s Method %s\n", urlPattern, method)
 		nexusContext := s.GetNexusContext(restURI, codes)
 		s.registerRoute(string(method), urlPattern, nexusContext)
+		if method == http.MethodDelete && operationURIs[restURI.Uri] {
+			s.registerOperationRoutes(urlPattern, nexusContext)
+		}
+		if method == http.MethodDelete && restURI.Uri == projectURI {
+			s.registerMoveRoute(urlPattern, nexusContext)
+		}
+	}
+}
+
+// operationURIs are the URIs of the objects that accept retry and force-delete requests.
+var operationURIs = map[string]bool{
+	"/v1/orgs/{org.Org}":             true,
+	"/v1/projects/{project.Project}": true,
+}
+
+func (s *EchoServer) registerOperationRoutes(urlPattern string, nexusContext func(next echo.HandlerFunc) echo.HandlerFunc) {
+	log.Info().Msgf("Registered Router Path %s Method POST\n", urlPattern+"/retry")
+	log.Info().Msgf("Registered Router Path %s Method POST\n", urlPattern+"/force-delete")
+	if common.IsModeAdmin() || common.IsTenancyMode() {
+		s.Echo.POST(urlPattern+"/retry", s.RetryHandler, nexusContext)
+		s.Echo.POST(urlPattern+"/force-delete", s.ForceDeleteHandler, nexusContext)
+	} else {
+		s.Echo.POST(urlPattern+"/retry", s.RetryHandler, authn.VerifyAuthenticationMiddleware, nexusContext)
+		s.Echo.POST(urlPattern+"/force-delete", s.ForceDeleteHandler, authn.VerifyAuthenticationMiddleware, nexusContext)
+	}
+}
+
+// projectURI is the URI of the projects, which accept move requests.
+const projectURI = "/v1/projects/{project.Project}"
+
+func (s *EchoServer) registerMoveRoute(urlPattern string, nexusContext func(next echo.HandlerFunc) echo.HandlerFunc) {
+	log.Info().Msgf("Registered Router Path %s Method POST\n", urlPattern+"/move")
+	if common.IsModeAdmin() || common.IsTenancyMode() {
+		s.Echo.POST(urlPattern+"/move", s.MoveHandler, nexusContext)
+	} else {
+		s.Echo.POST(urlPattern+"/move", s.MoveHandler, authn.VerifyAuthenticationMiddleware, nexusContext)
 	}
 }
 
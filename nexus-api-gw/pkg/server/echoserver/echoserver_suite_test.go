@@ -151,6 +151,14 @@ func constructOrgGVR() schema.GroupVersionResource {
 	}
 }
 
+func constructProjectGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "project.edge-orchestrator.intel.com",
+		Version:  "v1",
+		Resource: "projects",
+	}
+}
+
 func constructUnstructuredOrg(hashedName string) *unstructured.Unstructured {
 	return &unstructured.Unstructured{
 		Object: map[string]interface{}{
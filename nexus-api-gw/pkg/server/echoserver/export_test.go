@@ -0,0 +1,9 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package echoserver
+
+// LockQuota exposes lockQuota to the tests of the package.
+var LockQuota = lockQuota
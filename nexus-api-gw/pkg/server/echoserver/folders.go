@@ -0,0 +1,156 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package echoserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/client"
+	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/model"
+	orgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// folderQueryParam selects the folder of a new project, or of the projects listed.
+	folderQueryParam = "folder"
+	defaultFolder    = "default"
+	projectNodeName  = "project.Project"
+
+	moveRequestedAnnotation = "tenancy-manager.edge-orchestrator.intel.com/move-to"
+)
+
+// MoveRequest names the Folder a Project is moved to.
+type MoveRequest struct {
+	Folder string `json:"folder"`
+}
+
+// requestFolder returns the folder of the project a request is for: the folder of the existing project, else the
+// folder query parameter.
+func requestFolder(nc *NexusContext, orgName string) (string, bool) {
+	if name := nc.Param(projectNodeName); name != "" {
+		objs, err := client.Client.Resource(constructGVR(projectCRDType)).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: k8sLabels.Set{orgCRDType: orgName, "nexus/display_name": name}.String(),
+		})
+		if err != nil {
+			log.Warn().Msgf("Unable to look up the folder of project %s: %s", name, err.Error())
+		} else if folder := projectsFolder(objs.Items); folder != "" {
+			return folder, true
+		}
+	}
+	if nc.QueryParams().Has(folderQueryParam) {
+		return nc.QueryParams().Get(folderQueryParam), true
+	}
+	return "", false
+}
+
+// projectsFolder returns the folder of the config projects of a name, preferring the one not being deleted.
+func projectsFolder(projects []unstructured.Unstructured) string {
+	folder := ""
+	for i := range projects {
+		if projects[i].GetDeletionTimestamp() == nil || folder == "" {
+			folder = projects[i].GetLabels()[folderCRDType]
+		}
+	}
+	return folder
+}
+
+// countProjects returns the number of config projects selected by labels.
+func countProjects(labels k8sLabels.Set) (int, error) {
+	objs, err := client.Client.Resource(constructGVR(projectCRDType)).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: labels.String(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(objs.Items), nil
+}
+
+// orgHasProjects reports whether an org has projects, in any of its folders.
+func orgHasProjects(orgName string) bool {
+	count, err := countProjects(k8sLabels.Set{orgCRDType: orgName})
+	return err == nil && count != 0
+}
+
+// folderDeleteConflict returns a message if the folder of name can't be deleted: the default folder, and
+// the folders with projects.
+func folderDeleteConflict(name, orgName string) (string, error) {
+	if name == defaultFolder {
+		return "The default folder can't be deleted", nil
+	}
+	count, err := countProjects(k8sLabels.Set{orgCRDType: orgName, folderCRDType: name})
+	if err != nil {
+		return "", err
+	}
+	if count != 0 {
+		return "Folder delete not permitted until all its projects are deleted or moved", nil
+	}
+	return "", nil
+}
+
+// MoveHandler is used to process POST <project>/move requests. It asks tenancy-manager to move an IDLE Project
+// to another Folder of its Org. The Project keeps its UID.
+func (s *EchoServer) MoveHandler(c echo.Context) error {
+	nc, ok := c.(*NexusContext)
+	if !ok {
+		return fmt.Errorf("context is not of type *NexusContext")
+	}
+
+	JwtClaims, httpErr := s.Authenticator.AuthenticateAndAuthorize(c, s.TenancyNexusClient)
+	if httpErr != nil {
+		log.Error().Msgf("authenticateAndAuthorize failed with httpErr: %#v", httpErr)
+		return nc.JSON(httpErr.Code, httpErr)
+	}
+	crdName, crdInfo, name, err := getCRDInfoAndName(nc)
+	if err != nil {
+		return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: err.Error()})
+	}
+	var req MoveRequest
+	if err := (&echo.DefaultBinder{}).BindBody(nc, &req); err != nil || req.Folder == "" {
+		return nc.JSON(http.StatusBadRequest, DefaultResponse{
+			Message: `move must name the target folder with {"folder": "<folder>"}`,
+		})
+	}
+
+	hashedName, gvr := getHashedNameAndGVR(crdName, crdInfo, name, JwtClaims.OrgName, nc)
+	obj, err := client.Client.Resource(gvr).Get(context.TODO(), hashedName, metav1.GetOptions{})
+	if err != nil {
+		return handleClientError(nc, err)
+	}
+	if folder := obj.GetLabels()[folderCRDType]; folder == req.Folder {
+		return nc.JSON(http.StatusConflict, DefaultResponse{
+			Message: fmt.Sprintf("%s is already in folder %s", name, folder),
+		})
+	}
+	if indicator := statusIndicator(obj); indicator != string(orgsv1.StatusIndicationIdle) {
+		return nc.JSON(http.StatusConflict, DefaultResponse{
+			Message: fmt.Sprintf("%s is %s, only a project in STATUS_INDICATION_IDLE can be moved", name, indicator),
+		})
+	}
+	folderHashedName, folderGVR := getHashedNameAndGVR(folderCRDType, model.CrdTypeToNodeInfo[folderCRDType],
+		req.Folder, JwtClaims.OrgName, nc)
+	if _, err := client.Client.Resource(folderGVR).Get(context.TODO(), folderHashedName, metav1.GetOptions{}); err != nil {
+		return handleClientError(nc, err)
+	}
+
+	err = annotate(context.TODO(), gvr, hashedName, map[string]string{
+		moveRequestedAnnotation: req.Folder,
+		requestedByAnnotation:   JwtClaims.Claims.PreferredUsername,
+	})
+	if err != nil {
+		log.Error().Msgf("Failed to request the move of %s, err: %s", name, err.Error())
+		return handleClientError(nc, err)
+	}
+	log.Info().Msgf("User %s requested the move of %s to folder %s", JwtClaims.Claims.PreferredUsername, name, req.Folder)
+	return nc.JSON(http.StatusAccepted, DefaultResponse{
+		Message: fmt.Sprintf("Move of %s to folder %s requested", name, req.Folder),
+	})
+}
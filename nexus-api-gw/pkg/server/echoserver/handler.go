@@ -40,6 +40,7 @@ import (
 const (
 	minPathLength          = 2
 	memberRoleQueryParam   = "member-role"
+	labelSelectorParam     = "labelSelector"
 	ProjectReadRolePattern = `([a-f0-9\-]+)_project-read-role`
 )
 
@@ -326,7 +327,10 @@ func (s *EchoServer) ListHandler(c echo.Context) error {
 
 	crdName, crdInfo, labels := getCRDInfoAndLabels(nc, JwtClaims)
 	gvr := constructGVR(crdName)
-	opts := constructListOptions(c, labels)
+	opts, err := constructListOptions(c, labels)
+	if err != nil {
+		return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: err.Error()})
+	}
 
 	objs, err := client.Client.Resource(gvr).List(context.TODO(), opts)
 	if err != nil {
@@ -343,6 +347,8 @@ func (s *EchoServer) ListHandler(c echo.Context) error {
 		memberRoleType := memberRoleQueryExists(c)
 		projects, orgAdmin = getProjectsAndOrgAdminBool(JwtClaims.Claims.RealmAccess.Roles, memberRoleType, projects, orgAdmin)
 		// If member-role query param is true, filtered list is given and if Project Role exists, full list is given
+		// The admin of a folder lists the projects of the folder.
+		orgAdmin = orgAdmin || authn.IsFolderAdmin(JwtClaims)
 		switch {
 		case memberRoleType, orgAdmin:
 			log.Debug().Msgf("Filtering list.. memberRoleType - %t , orgAdmin - %t", memberRoleType, orgAdmin)
@@ -388,6 +394,10 @@ func getCRDInfoAndLabels(nc *NexusContext, jwtClaims authn.JwtData) (string, mod
 	for k, v := range parseLabels(nc, crdInfo.ParentHierarchy, jwtClaims.OrgName) {
 		labels[k] = v
 	}
+	if common.IsTenancyMode() && crdName == projectCRDType && !nc.QueryParams().Has(folderQueryParam) {
+		// The projects of all the folders are listed, unless a folder is selected.
+		delete(labels, folderCRDType)
+	}
 	return crdName, crdInfo, labels
 }
 
@@ -401,9 +411,19 @@ func constructGVR(crdName string) schema.GroupVersionResource {
 	return gvr
 }
 
-func constructListOptions(c echo.Context, labels k8sLabels.Set) metav1.ListOptions {
+func constructListOptions(c echo.Context, labels k8sLabels.Set) (metav1.ListOptions, error) {
+	selector := labels.AsSelector()
+	if c.QueryParams().Has(labelSelectorParam) {
+		// The user selector narrows the hierarchy selector, it can't select objects out of it.
+		userSelector, err := k8sLabels.Parse(c.QueryParams().Get(labelSelectorParam))
+		if err != nil {
+			return metav1.ListOptions{}, fmt.Errorf("invalid labelSelector: %w", err)
+		}
+		requirements, _ := userSelector.Requirements()
+		selector = selector.Add(requirements...)
+	}
 	opts := metav1.ListOptions{
-		LabelSelector: labels.AsSelector().String(),
+		LabelSelector: selector.String(),
 	}
 
 	if c.QueryParams().Has("limit") {
@@ -419,7 +439,7 @@ func constructListOptions(c echo.Context, labels k8sLabels.Set) metav1.ListOptio
 		opts.Continue = c.QueryParams().Get("continue")
 	}
 
-	return opts
+	return opts, nil
 }
 
 func processListResponse(objs *unstructured.UnstructuredList, crdInfo model.NodeInfo,
@@ -514,11 +534,31 @@ func (s *EchoServer) PutHandler(c echo.Context) error {
 	if err != nil {
 		return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: err.Error()})
 	}
+	if err := validateMetadata(crdName, body); err != nil {
+		return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: err.Error()})
+	}
 
 	hashedName, gvr := getHashedNameAndGVR(crdName, crdInfo, name, JwtClaims.OrgName, nc)
 	obj, err := client.Client.Resource(gvr).Get(context.TODO(), hashedName, metav1.GetOptions{})
 	if err != nil {
 		if errors.IsNotFound(err) {
+			defer lockQuota(nc, crdName, crdInfo, JwtClaims.OrgName)()
+			msg, err := exceedsQuota(nc, crdName, crdInfo, gvr, JwtClaims.OrgName)
+			if err != nil {
+				return handleClientError(nc, err)
+			}
+			if msg != "" {
+				log.Error().Msg(msg)
+				return nc.JSON(http.StatusForbidden, DefaultResponse{Message: msg})
+			}
+			msg, err = missingTemplate(context.Background(), s.TenancyNexusClient, crdName, body)
+			if err != nil {
+				return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: err.Error()})
+			}
+			if msg != "" {
+				log.Error().Msg(msg)
+				return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: msg})
+			}
 			return handleCreateObject(nc, gvr, crdInfo, hashedName, body, name, JwtClaims.OrgName)
 		}
 		return handleClientError(nc, err)
@@ -566,6 +606,7 @@ func handleCreateObject(nc *NexusContext, gvr schema.GroupVersionResource, crdIn
 		return handleClientError(nc, err)
 	}
 
+	labels = syncSpecLabels(gvr.GroupResource().String(), labels, nil, body)
 	labels["nexus/is_name_hashed"] = "true"
 	labels["nexus/display_name"] = name
 	labels[crdInfo.Name] = name
@@ -661,10 +702,29 @@ func handleSpecPatch(nc *NexusContext, gvr schema.GroupVersionResource,
 		delete(body, v.FieldNameGvk)
 	}
 
+	if err := validateMetadata(gvr.GroupResource().String(), body); err != nil {
+		return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: err.Error()})
+	}
+	if _, ok := body[templateField]; ok {
+		obj, err := client.Client.Resource(gvr).Get(context.TODO(), hashedName, metav1.GetOptions{})
+		if err != nil {
+			return handleClientError(nc, err)
+		}
+		spec, _ := obj.Object["spec"].(map[string]interface{})
+		if err := keepTemplate(gvr.GroupResource().String(), spec, body); err != nil {
+			return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: err.Error()})
+		}
+	}
+
 	payload := struct {
-		Spec map[string]interface{} `json:"spec"`
+		Metadata map[string]interface{} `json:"metadata,omitempty"`
+		Spec     map[string]interface{} `json:"spec"`
 	}{
-		body,
+		Spec: body,
+	}
+	// The user labels are merged into the labels of the object as they are into its spec.
+	if labels := specLabels(gvr.GroupResource().String(), body); labels != nil {
+		payload.Metadata = map[string]interface{}{"labels": labels}
 	}
 
 	patchBytes, err := json.Marshal(payload)
@@ -700,27 +760,28 @@ func (s *EchoServer) deleteHandler(c echo.Context) error {
 	}
 	log.Debug().Msg("authenticated and authorized successfully")
 	// Check if Projects deleted before Org Delete
-	if authn.MatchesOrgPattern(c.Request().RequestURI) {
-		folder, err := s.TenancyNexusClient.TenancyMultiTenancy().Config().Orgs(JwtClaims.OrgName).
-			GetFolders(context.Background(), "default")
-		if err == nil {
-			projects, err := folder.GetAllProjects(context.Background())
-			if err == nil {
-				if len(projects) != 0 {
-					log.Error().Msgf(
-						"Org delete not permitted until all its projects are deleted.. Status: %d", http.StatusConflict)
-					return nc.JSON(http.StatusConflict,
-						DefaultResponse{Message: "Org delete not permitted until all its projects are deleted"},
-					)
-				}
-			}
-		}
+	if authn.MatchesOrgPattern(c.Request().RequestURI) && orgHasProjects(JwtClaims.OrgName) {
+		log.Error().Msgf(
+			"Org delete not permitted until all its projects are deleted.. Status: %d", http.StatusConflict)
+		return nc.JSON(http.StatusConflict,
+			DefaultResponse{Message: "Org delete not permitted until all its projects are deleted"},
+		)
 	}
 
 	crdName, crdInfo, name, err := getCRDInfoAndName(nc)
 	if err != nil {
 		return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: err.Error()})
 	}
+	if crdName == folderCRDType {
+		msg, err := folderDeleteConflict(name, JwtClaims.OrgName)
+		if err != nil {
+			return handleClientError(nc, err)
+		}
+		if msg != "" {
+			log.Error().Msg(msg)
+			return nc.JSON(http.StatusConflict, DefaultResponse{Message: msg})
+		}
+	}
 
 	hashedName, gvr := getHashedNameAndGVR(crdName, crdInfo, name, JwtClaims.OrgName, nc)
 	err = client.DeleteObject(gvr, crdName, crdInfo, hashedName)
@@ -783,7 +844,11 @@ func parseLabels(c echo.Context, parents []string, orgName string) map[string]st
 				continue
 			}
 			if parent == "folders.folder.edge-orchestrator.intel.com" {
-				labels["folders.folder.edge-orchestrator.intel.com"] = "default"
+				folder, ok := requestFolder(nc, orgName)
+				if !ok {
+					folder = defaultFolder
+				}
+				labels["folders.folder.edge-orchestrator.intel.com"] = folder
 				continue
 			}
 		}
@@ -852,7 +917,11 @@ func updateResource(nc *NexusContext, gvr schema.GroupVersionResource,
 			body[v.FieldNameGvk] = value
 		}
 	}
+	if err := keepTemplate(gvr.GroupResource().String(), spec, body); err != nil {
+		return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: err.Error()})
+	}
 	obj.Object["spec"] = body
+	obj.SetLabels(syncSpecLabels(gvr.GroupResource().String(), obj.GetLabels(), spec, body))
 
 	_, err := client.Client.Resource(gvr).Update(context.TODO(), obj, metav1.UpdateOptions{})
 	if err != nil {
@@ -25,6 +25,7 @@ import (
 	nc "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic/fake"
 	k8sFake "k8s.io/client-go/kubernetes/fake"
@@ -135,6 +136,170 @@ var _ = ginkgo.Describe("EchoServer Handlers", ginkgo.Ordered, func() {
 				}).WithTimeout(10 * time.Second).WithPolling(2 * time.Second).Should(gomega.Equal(http.StatusOK))
 			})
 		})
+
+		ginkgo.When("Org status is requested", ginkgo.Ordered, func() {
+			ginkgo.It("should return the watcher conditions", func() {
+				serverObj, stopCh := setupServer()
+				defer teardownServer(serverObj, stopCh)
+				model.ConstructMapURIToURIInfo(model.Upsert, map[string]model.RestURIInfo{
+					"/v1/orgs/{org.Org}/status": {TypeOfURI: model.StatusURI},
+				})
+
+				orgObj := constructUnstructuredOrg("18a8a4294ab1ac866a53b7b5fe35421875af8be5")
+				orgObj.Object["status"] = map[string]interface{}{
+					"orgStatus": map[string]interface{}{
+						"statusIndicator": "STATUS_INDICATION_IN_PROGRESS",
+						"conditions": []interface{}{
+							map[string]interface{}{
+								"watcher":            "keycloak-tenant-controller",
+								"state":              "STATUS_INDICATION_IDLE",
+								"message":            "roles created",
+								"lastTransitionTime": int64(1700000000),
+								"attempts":           int64(1),
+							},
+						},
+					},
+					"nexus": map[string]interface{}{},
+				}
+				_, err := client.Client.Resource(constructOrgGVR()).
+					Create(context.Background(), orgObj, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				rec := httptest.NewRecorder()
+				c := serverObj.Echo.NewContext(httptest.NewRequest(http.MethodGet, "/", http.NoBody), rec)
+				c.SetParamNames("org.Org")
+				c.SetParamValues("getHandlerOrg1")
+				nc := &echoserver.NexusContext{
+					Context:  c,
+					NexusURI: "/v1/orgs/{org.Org}/status",
+				}
+				err = serverObj.GetHandler(nc)
+				gomega.Expect(err).To(gomega.BeNil())
+				gomega.Expect(rec.Code).To(gomega.Equal(http.StatusOK))
+
+				var status struct {
+					OrgStatus struct {
+						Conditions []struct {
+							Watcher  string `json:"watcher"`
+							State    string `json:"state"`
+							Attempts int    `json:"attempts"`
+						} `json:"conditions"`
+					} `json:"orgStatus"`
+					Nexus interface{} `json:"nexus"`
+				}
+				gomega.Expect(json.Unmarshal(rec.Body.Bytes(), &status)).To(gomega.Succeed())
+				gomega.Expect(status.Nexus).To(gomega.BeNil())
+				gomega.Expect(status.OrgStatus.Conditions).To(gomega.HaveLen(1))
+				gomega.Expect(status.OrgStatus.Conditions[0].Watcher).To(gomega.Equal("keycloak-tenant-controller"))
+				gomega.Expect(status.OrgStatus.Conditions[0].State).To(gomega.Equal("STATUS_INDICATION_IDLE"))
+				gomega.Expect(status.OrgStatus.Conditions[0].Attempts).To(gomega.Equal(1))
+			})
+		})
+
+		ginkgo.When("Org retry is requested", ginkgo.Ordered, func() {
+			ginkgo.It("should only retry an org in error", func() {
+				serverObj, stopCh := setupServer()
+				defer teardownServer(serverObj, stopCh)
+
+				orgObj := constructUnstructuredOrg("18a8a4294ab1ac866a53b7b5fe35421875af8be5")
+				orgObj.Object["status"] = map[string]interface{}{
+					"orgStatus": map[string]interface{}{"statusIndicator": "STATUS_INDICATION_IDLE"},
+				}
+				_, err := client.Client.Resource(constructOrgGVR()).
+					Create(context.Background(), orgObj, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				retry := func() *httptest.ResponseRecorder {
+					rec := httptest.NewRecorder()
+					c := serverObj.Echo.NewContext(httptest.NewRequest(http.MethodPost, "/", http.NoBody), rec)
+					c.SetParamNames("org.Org")
+					c.SetParamValues("getHandlerOrg1")
+					nc := &echoserver.NexusContext{Context: c, NexusURI: "/v1/orgs/{org.Org}"}
+					gomega.Expect(serverObj.RetryHandler(nc)).To(gomega.Succeed())
+					return rec
+				}
+				gomega.Expect(retry().Code).To(gomega.Equal(http.StatusConflict))
+
+				orgObj.Object["status"] = map[string]interface{}{
+					"orgStatus": map[string]interface{}{"statusIndicator": "STATUS_INDICATION_ERROR"},
+				}
+				_, err = client.Client.Resource(constructOrgGVR()).
+					UpdateStatus(context.Background(), orgObj, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(retry().Code).To(gomega.Equal(http.StatusAccepted))
+
+				obj, err := client.Client.Resource(constructOrgGVR()).
+					Get(context.Background(), orgObj.GetName(), metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(obj.GetAnnotations()).
+					To(gomega.HaveKey("tenancy-manager.edge-orchestrator.intel.com/retry-requested"))
+			})
+		})
+
+		ginkgo.When("Org force-delete is not confirmed", func() {
+			ginkgo.It("should reject the request", func() {
+				serverObj, stopCh := setupServer()
+				defer teardownServer(serverObj, stopCh)
+
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"confirm": "other"}`))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := serverObj.Echo.NewContext(req, rec)
+				c.SetParamNames("org.Org")
+				c.SetParamValues("getHandlerOrg1")
+				nc := &echoserver.NexusContext{Context: c, NexusURI: "/v1/orgs/{org.Org}"}
+				gomega.Expect(serverObj.ForceDeleteHandler(nc)).To(gomega.Succeed())
+				gomega.Expect(rec.Code).To(gomega.Equal(http.StatusBadRequest))
+			})
+		})
+
+		ginkgo.When("Org to force-delete is not in error", func() {
+			ginkgo.It("should reject the request", func() {
+				serverObj, stopCh := setupServer()
+				defer teardownServer(serverObj, stopCh)
+
+				orgObj := constructUnstructuredOrg("18a8a4294ab1ac866a53b7b5fe35421875af8be5")
+				orgObj.Object["status"] = map[string]interface{}{
+					"orgStatus": map[string]interface{}{"statusIndicator": "STATUS_INDICATION_IN_PROGRESS"},
+				}
+				_, err := client.Client.Resource(constructOrgGVR()).
+					Create(context.Background(), orgObj, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"confirm": "getHandlerOrg1"}`))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := serverObj.Echo.NewContext(req, rec)
+				c.SetParamNames("org.Org")
+				c.SetParamValues("getHandlerOrg1")
+				nc := &echoserver.NexusContext{Context: c, NexusURI: "/v1/orgs/{org.Org}"}
+				gomega.Expect(serverObj.ForceDeleteHandler(nc)).To(gomega.Succeed())
+				gomega.Expect(rec.Code).To(gomega.Equal(http.StatusConflict))
+
+				obj, err := client.Client.Resource(constructOrgGVR()).
+					Get(context.Background(), orgObj.GetName(), metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(obj.GetAnnotations()).
+					NotTo(gomega.HaveKey("tenancy-manager.edge-orchestrator.intel.com/force-delete"))
+			})
+		})
+
+		ginkgo.When("Project move names no folder", func() {
+			ginkgo.It("should reject the request", func() {
+				serverObj, stopCh := setupServer()
+				defer teardownServer(serverObj, stopCh)
+
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := serverObj.Echo.NewContext(req, rec)
+				c.SetParamNames("project.Project")
+				c.SetParamValues("project1")
+				nc := &echoserver.NexusContext{Context: c, NexusURI: "/v1/projects/{project.Project}"}
+				gomega.Expect(serverObj.MoveHandler(nc)).To(gomega.Succeed())
+				gomega.Expect(rec.Code).To(gomega.Equal(http.StatusBadRequest))
+			})
+		})
 	})
 
 	ginkgo.Context("PutHandler Tests", ginkgo.Ordered, func() {
@@ -164,6 +329,148 @@ var _ = ginkgo.Describe("EchoServer Handlers", ginkgo.Ordered, func() {
 				gomega.Expect(err).ToNot(gomega.HaveOccurred())
 			})
 		})
+
+		ginkgo.When("Org project quota is exhausted", ginkgo.Ordered, func() {
+			ginkgo.It("Create project, should be forbidden", func() {
+				serverObj, stopCh := setupServer()
+				defer teardownServer(serverObj, stopCh)
+
+				orgObj := constructUnstructuredOrg("18a8a4294ab1ac866a53b7b5fe35421875af8be5")
+				orgObj.Object["spec"] = map[string]interface{}{
+					"quota": map[string]interface{}{
+						"maxProjects": int64(1),
+					},
+				}
+				_, err := client.Client.Resource(constructOrgGVR()).
+					Create(context.Background(), orgObj, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				projObj := &unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "project.edge-orchestrator.intel.com/v1",
+						"kind":       "Project",
+						"metadata": map[string]interface{}{
+							"name":            "proj1HashedName",
+							"resourceVersion": "1",
+							"labels": map[string]interface{}{
+								"orgs.org.edge-orchestrator.intel.com": "getHandlerOrg1",
+							},
+						},
+					},
+				}
+				_, err = client.Client.Resource(constructProjectGVR()).
+					Create(context.Background(), projObj, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(`{"description": "desc for project"}`))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := serverObj.Echo.NewContext(req, rec)
+				c.SetParamNames("org.Org", "project.Project")
+				c.SetParamValues("getHandlerOrg1", "proj2")
+				nc := &echoserver.NexusContext{
+					Context:  c,
+					NexusURI: "/v1/projects/{project.Project}",
+				}
+
+				err = serverObj.PutHandler(nc)
+				gomega.Expect(err).To(gomega.BeNil())
+				gomega.Expect(rec.Code).To(gomega.Equal(http.StatusForbidden))
+				var response echoserver.DefaultResponse
+				gomega.Expect(json.Unmarshal(rec.Body.Bytes(), &response)).To(gomega.Succeed())
+				gomega.Expect(response.Message).To(gomega.ContainSubstring("allows at most 1 projects"))
+			})
+		})
+
+		ginkgo.When("Projects are created concurrently", func() {
+			ginkgo.It("should serialize the creates of one org from the quota check", func() {
+				serverObj, stopCh := setupServer()
+				defer teardownServer(serverObj, stopCh)
+
+				crdName := "projects.project.edge-orchestrator.intel.com"
+				crdInfo := model.CrdTypeToNodeInfo[crdName]
+				newContext := func(org, project string) *echoserver.NexusContext {
+					c := serverObj.Echo.NewContext(httptest.NewRequest(http.MethodPut, "/", http.NoBody), httptest.NewRecorder())
+					c.SetParamNames("org.Org", "project.Project")
+					c.SetParamValues(org, project)
+					return &echoserver.NexusContext{Context: c, NexusURI: "/v1/projects/{project.Project}"}
+				}
+
+				unlock := echoserver.LockQuota(newContext("quotaOrg1", "proj1"), crdName, crdInfo, "quotaOrg1")
+				sameOrg := make(chan struct{})
+				go func() {
+					defer ginkgo.GinkgoRecover()
+					echoserver.LockQuota(newContext("quotaOrg1", "proj2"), crdName, crdInfo, "quotaOrg1")()
+					close(sameOrg)
+				}()
+				otherOrg := make(chan struct{})
+				go func() {
+					defer ginkgo.GinkgoRecover()
+					echoserver.LockQuota(newContext("quotaOrg2", "proj1"), crdName, crdInfo, "quotaOrg2")()
+					close(otherOrg)
+				}()
+
+				gomega.Eventually(otherOrg).WithTimeout(5 * time.Second).Should(gomega.BeClosed())
+				gomega.Consistently(sameOrg).WithTimeout(time.Second).ShouldNot(gomega.BeClosed())
+				unlock()
+				gomega.Eventually(sameOrg).WithTimeout(5 * time.Second).Should(gomega.BeClosed())
+			})
+		})
+
+		ginkgo.When("Project labels override a nexus label", func() {
+			ginkgo.It("Create project, should fail", func() {
+				serverObj, stopCh := setupServer()
+				defer teardownServer(serverObj, stopCh)
+
+				rec := httptest.NewRecorder()
+				body := `{"description": "desc for project", "labels": {"nexus/display_name": "proj3"}}`
+				req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := serverObj.Echo.NewContext(req, rec)
+				c.SetParamNames("org.Org", "project.Project")
+				c.SetParamValues("getHandlerOrg1", "proj3")
+				nc := &echoserver.NexusContext{
+					Context:  c,
+					NexusURI: "/v1/projects/{project.Project}",
+				}
+
+				err := serverObj.PutHandler(nc)
+				gomega.Expect(err).To(gomega.BeNil())
+				gomega.Expect(rec.Code).To(gomega.Equal(http.StatusBadRequest))
+				var response echoserver.DefaultResponse
+				gomega.Expect(json.Unmarshal(rec.Body.Bytes(), &response)).To(gomega.Succeed())
+				gomega.Expect(response.Message).To(gomega.ContainSubstring("is reserved"))
+			})
+		})
+
+		ginkgo.When("Project names a missing template", func() {
+			ginkgo.It("Create project, should fail", func() {
+				serverObj, stopCh := setupServer()
+				defer teardownServer(serverObj, stopCh)
+
+				for body, message := range map[string]string{
+					`{"description": "desc for project", "template": "edge"}`: "Project template edge not found.",
+					`{"description": "desc for project", "template": 1}`:      "template must be a string",
+				} {
+					rec := httptest.NewRecorder()
+					req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+					req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+					c := serverObj.Echo.NewContext(req, rec)
+					c.SetParamNames("org.Org", "project.Project")
+					c.SetParamValues("getHandlerOrg1", "proj4")
+					nc := &echoserver.NexusContext{
+						Context:  c,
+						NexusURI: "/v1/projects/{project.Project}",
+					}
+
+					gomega.Expect(serverObj.PutHandler(nc)).To(gomega.Succeed())
+					gomega.Expect(rec.Code).To(gomega.Equal(http.StatusBadRequest))
+					var response echoserver.DefaultResponse
+					gomega.Expect(json.Unmarshal(rec.Body.Bytes(), &response)).To(gomega.Succeed())
+					gomega.Expect(response.Message).To(gomega.Equal(message))
+				}
+			})
+		})
 	})
 
 	ginkgo.Context("ListHandler Tests", ginkgo.Ordered, func() {
@@ -197,5 +504,39 @@ var _ = ginkgo.Describe("EchoServer Handlers", ginkgo.Ordered, func() {
 			gomega.Expect(err).To(gomega.BeNil())
 			gomega.Expect(rec.Code).To(gomega.Equal(http.StatusOK))
 		})
+
+		ginkgo.It("List org with a label selector should pass", func() {
+			serverObj, stopCh := setupServer()
+			defer teardownServer(serverObj, stopCh)
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/v1/orgs?labelSelector=tier%3Dgold", http.NoBody)
+			c := serverObj.Echo.NewContext(req, rec)
+			nc := &echoserver.NexusContext{
+				Context:  c,
+				NexusURI: "/v1/orgs",
+			}
+
+			err := serverObj.ListHandler(nc)
+			gomega.Expect(err).To(gomega.BeNil())
+			gomega.Expect(rec.Code).To(gomega.Equal(http.StatusOK))
+		})
+
+		ginkgo.It("List org with an invalid label selector should fail", func() {
+			serverObj, stopCh := setupServer()
+			defer teardownServer(serverObj, stopCh)
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/v1/orgs?labelSelector=tier%20in%20(gold", http.NoBody)
+			c := serverObj.Echo.NewContext(req, rec)
+			nc := &echoserver.NexusContext{
+				Context:  c,
+				NexusURI: "/v1/orgs",
+			}
+
+			err := serverObj.ListHandler(nc)
+			gomega.Expect(err).To(gomega.BeNil())
+			gomega.Expect(rec.Code).To(gomega.Equal(http.StatusBadRequest))
+		})
 	})
 })
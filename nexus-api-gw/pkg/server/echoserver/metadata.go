@@ -0,0 +1,118 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package echoserver
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	labelsField = "labels"
+	ownersField = "owners"
+	// maxOwners bounds the owners of an org or a project.
+	maxOwners = 32
+)
+
+// metadataCRDTypes are the CRD types whose spec carries user labels and owners.
+var metadataCRDTypes = map[string]bool{
+	orgCRDType:     true,
+	projectCRDType: true,
+}
+
+// reservedLabelPrefixes are the labels set by nexus on every object, that user labels can't override.
+var reservedLabelPrefixes = []string{"nexus/", "edge-orchestrator.intel.com"}
+
+// validateMetadata checks the labels and the owners in the spec body of an object of crdName. In a merge patch,
+// a null label value removes the label.
+func validateMetadata(crdName string, body map[string]interface{}) error {
+	if !metadataCRDTypes[crdName] {
+		return nil
+	}
+	if labels, ok := body[labelsField]; ok && labels != nil {
+		labelMap, ok := labels.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("labels must be a map of strings")
+		}
+		for key, value := range labelMap {
+			if err := validateLabel(key, value); err != nil {
+				return err
+			}
+		}
+	}
+	if owners, ok := body[ownersField]; ok && owners != nil {
+		ownerList, ok := owners.([]interface{})
+		if !ok {
+			return fmt.Errorf("owners must be a list of strings")
+		}
+		if len(ownerList) > maxOwners {
+			return fmt.Errorf("at most %d owners are allowed", maxOwners)
+		}
+		seen := map[string]bool{}
+		for _, owner := range ownerList {
+			name, ok := owner.(string)
+			if !ok || strings.TrimSpace(name) == "" {
+				return fmt.Errorf("owners must be non-empty strings")
+			}
+			if seen[name] {
+				return fmt.Errorf("owner %s is duplicated", name)
+			}
+			seen[name] = true
+		}
+	}
+	return nil
+}
+
+// validateLabel checks a user label is a valid Kubernetes label that does not override a nexus label.
+func validateLabel(key string, value interface{}) error {
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return fmt.Errorf("invalid label key %s: %s", key, strings.Join(errs, "; "))
+	}
+	for _, prefix := range reservedLabelPrefixes {
+		if strings.HasPrefix(key, prefix) || strings.Contains(key, "."+prefix) {
+			return fmt.Errorf("label key %s is reserved", key)
+		}
+	}
+	if value == nil {
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("value of label %s must be a string", key)
+	}
+	if errs := validation.IsValidLabelValue(str); len(errs) > 0 {
+		return fmt.Errorf("invalid value of label %s: %s", key, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// specLabels returns the user labels in the spec of an object of crdName.
+func specLabels(crdName string, spec map[string]interface{}) map[string]interface{} {
+	if !metadataCRDTypes[crdName] {
+		return nil
+	}
+	labels, _ := spec[labelsField].(map[string]interface{})
+	return labels
+}
+
+// syncSpecLabels copies the user labels of spec to the labels of its object, removing those of its former spec,
+// for list calls to select objects by their user labels.
+func syncSpecLabels(crdName string, labels map[string]string, former, spec map[string]interface{}) map[string]string {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for key := range specLabels(crdName, former) {
+		delete(labels, key)
+	}
+	for key, value := range specLabels(crdName, spec) {
+		if str, ok := value.(string); ok {
+			labels[key] = str
+		}
+	}
+	return labels
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package echoserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/client"
+	orgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Operator requests on an Org or Project, carried out by tenancy-manager.
+const (
+	retryRequestedAnnotation = "tenancy-manager.edge-orchestrator.intel.com/retry-requested"
+	forceDeleteAnnotation    = "tenancy-manager.edge-orchestrator.intel.com/force-delete"
+	requestedByAnnotation    = "tenancy-manager.edge-orchestrator.intel.com/requested-by"
+
+	orgCrdName = "orgs.org.edge-orchestrator.intel.com"
+)
+
+// ForceDeleteRequest confirms a force-delete with the name of the Org or Project.
+type ForceDeleteRequest struct {
+	Confirm string `json:"confirm"`
+}
+
+// statusIndicator returns the status indicator of an Org or Project.
+func statusIndicator(obj *unstructured.Unstructured) string {
+	status, _, _ := unstructured.NestedMap(obj.Object, "status")
+	for key, value := range status {
+		if key == "nexus" {
+			continue
+		}
+		if sub, ok := value.(map[string]interface{}); ok {
+			if indicator, ok := sub["statusIndicator"].(string); ok {
+				return indicator
+			}
+		}
+	}
+	return ""
+}
+
+// annotate merges annotations into the metadata of an object.
+func annotate(ctx context.Context, gvr schema.GroupVersionResource, name string, annotations map[string]string) error {
+	patchBytes, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": annotations},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = client.Client.Resource(gvr).Patch(ctx, name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// RetryHandler is used to process POST <org or project>/retry requests. It asks tenancy-manager to re-run the
+// failed create or delete of an Org or Project in ERROR.
+func (s *EchoServer) RetryHandler(c echo.Context) error {
+	nc, ok := c.(*NexusContext)
+	if !ok {
+		return fmt.Errorf("context is not of type *NexusContext")
+	}
+
+	JwtClaims, httpErr := s.Authenticator.AuthenticateAndAuthorize(c, s.TenancyNexusClient)
+	if httpErr != nil {
+		log.Error().Msgf("authenticateAndAuthorize failed with httpErr: %#v", httpErr)
+		return nc.JSON(httpErr.Code, httpErr)
+	}
+	crdName, crdInfo, name, err := getCRDInfoAndName(nc)
+	if err != nil {
+		return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: err.Error()})
+	}
+	hashedName, gvr := getHashedNameAndGVR(crdName, crdInfo, name, JwtClaims.OrgName, nc)
+	obj, err := client.Client.Resource(gvr).Get(context.TODO(), hashedName, metav1.GetOptions{})
+	if err != nil {
+		return handleClientError(nc, err)
+	}
+	if indicator := statusIndicator(obj); indicator != string(orgsv1.StatusIndicationError) {
+		return nc.JSON(http.StatusConflict, DefaultResponse{
+			Message: fmt.Sprintf("%s is %s, only a request in STATUS_INDICATION_ERROR can be retried", name, indicator),
+		})
+	}
+
+	err = annotate(context.TODO(), gvr, hashedName, map[string]string{
+		retryRequestedAnnotation: time.Now().UTC().Format(time.RFC3339),
+		requestedByAnnotation:    JwtClaims.Claims.PreferredUsername,
+	})
+	if err != nil {
+		log.Error().Msgf("Failed to request the retry of %s, err: %s", name, err.Error())
+		return handleClientError(nc, err)
+	}
+	log.Info().Msgf("User %s requested the retry of %s", JwtClaims.Claims.PreferredUsername, name)
+	return nc.JSON(http.StatusAccepted, DefaultResponse{Message: fmt.Sprintf("Retry of %s requested", name)})
+}
+
+// ForceDeleteHandler is used to process POST <org or project>/force-delete requests. It deletes an Org or Project
+// in ERROR and asks tenancy-manager not to wait for its watchers. The request body must confirm the name.
+func (s *EchoServer) ForceDeleteHandler(c echo.Context) error {
+	nc, ok := c.(*NexusContext)
+	if !ok {
+		return fmt.Errorf("context is not of type *NexusContext")
+	}
+
+	JwtClaims, httpErr := s.Authenticator.AuthenticateAndAuthorize(c, s.TenancyNexusClient)
+	if httpErr != nil {
+		log.Error().Msgf("authenticateAndAuthorize failed with httpErr: %#v", httpErr)
+		return nc.JSON(httpErr.Code, httpErr)
+	}
+	crdName, crdInfo, name, err := getCRDInfoAndName(nc)
+	if err != nil {
+		return nc.JSON(http.StatusBadRequest, DefaultResponse{Message: err.Error()})
+	}
+	var req ForceDeleteRequest
+	if err := (&echo.DefaultBinder{}).BindBody(nc, &req); err != nil || req.Confirm != name {
+		return nc.JSON(http.StatusBadRequest, DefaultResponse{
+			Message: fmt.Sprintf(`force-delete must be confirmed with {"confirm": "%s"}`, name),
+		})
+	}
+
+	if crdName == orgCrdName && orgHasProjects(JwtClaims.OrgName) {
+		return nc.JSON(http.StatusConflict,
+			DefaultResponse{Message: "Org delete not permitted until all its projects are deleted"},
+		)
+	}
+
+	hashedName, gvr := getHashedNameAndGVR(crdName, crdInfo, name, JwtClaims.OrgName, nc)
+	obj, err := client.Client.Resource(gvr).Get(context.TODO(), hashedName, metav1.GetOptions{})
+	if err != nil {
+		return handleClientError(nc, err)
+	}
+	if indicator := statusIndicator(obj); indicator != string(orgsv1.StatusIndicationError) {
+		return nc.JSON(http.StatusConflict, DefaultResponse{
+			Message: fmt.Sprintf("%s is %s, only a request in STATUS_INDICATION_ERROR can be force-deleted", name, indicator),
+		})
+	}
+	err = annotate(context.TODO(), gvr, hashedName, map[string]string{
+		forceDeleteAnnotation: name,
+		requestedByAnnotation: JwtClaims.Claims.PreferredUsername,
+	})
+	if err != nil {
+		log.Error().Msgf("Failed to request the force-delete of %s, err: %s", name, err.Error())
+		return handleClientError(nc, err)
+	}
+	if err := client.DeleteObject(gvr, crdName, crdInfo, hashedName); err != nil {
+		log.Error().Msgf("Failed to DeleteObject, err: %s", err.Error())
+		return handleClientError(nc, err)
+	}
+	log.Info().Msgf("User %s force-deleted %s", JwtClaims.Claims.PreferredUsername, name)
+	return nc.JSON(http.StatusAccepted, DefaultResponse{Message: fmt.Sprintf("Force-delete of %s requested", name)})
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package echoserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/client"
+	"github.com/open-edge-platform/orch-utils/nexus-api-gw/pkg/model"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	orgCRDType     = "orgs.org.edge-orchestrator.intel.com"
+	folderCRDType  = "folders.folder.edge-orchestrator.intel.com"
+	projectCRDType = "projects.project.edge-orchestrator.intel.com"
+	networkCRDType = "networks.network.edge-orchestrator.intel.com"
+)
+
+// quotaLimit is the field of the org spec.quota bounding the objects of a CRD type.
+type quotaLimit struct {
+	field string
+	// scope are the parents the objects are counted within.
+	scope []string
+	// unit names the limited objects in the messages.
+	unit string
+}
+
+var quotaLimits = map[string]quotaLimit{
+	folderCRDType:  {field: "maxFolders", scope: []string{orgCRDType}, unit: "folders"},
+	projectCRDType: {field: "maxProjects", scope: []string{orgCRDType}, unit: "projects"},
+	networkCRDType: {
+		field: "maxNetworksPerProject",
+		scope: []string{orgCRDType, folderCRDType, projectCRDType},
+		unit:  "networks per project",
+	},
+}
+
+/*
+quotaLocks serializes the creates counted against the same quota, from the check of the quota to the create,
+so that concurrent creates do not all pass the check. nexus-api-gw runs as a single replica.
+*/
+var quotaLocks = newKeyedMutex()
+
+// keyedMutex hands out one mutex per key, so that the creates within one quota scope do not block the others.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	sync.Mutex
+	refs int
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: map[string]*keyLock{}}
+}
+
+// lock blocks until key is free and returns the function that releases it.
+func (k *keyedMutex) lock(key string) func() {
+	k.mu.Lock()
+	l, ok := k.locks[key]
+	if !ok {
+		l = &keyLock{}
+		k.locks[key] = l
+	}
+	l.refs++
+	k.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		k.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(k.locks, key)
+		}
+		k.mu.Unlock()
+	}
+}
+
+// lockQuota locks the quota scope of an object of crdName to create, and returns the function that releases it.
+// Objects without quota are not locked.
+func lockQuota(nc *NexusContext, crdName string, crdInfo model.NodeInfo, orgName string) func() {
+	limit, ok := quotaLimits[crdName]
+	if !ok {
+		return func() {}
+	}
+	labels := parseLabels(nc, crdInfo.ParentHierarchy, orgName)
+	key := []string{crdName}
+	for _, parent := range limit.scope {
+		key = append(key, labels[parent])
+	}
+	return quotaLocks.lock(strings.Join(key, "/"))
+}
+
+// exceedsQuota returns a message if creating an object of crdName, listed through gvr, would exceed
+// the quota of its org. Objects are not limited when their org is not found.
+func exceedsQuota(nc *NexusContext, crdName string, crdInfo model.NodeInfo, gvr schema.GroupVersionResource,
+	orgName string,
+) (string, error) {
+	limit, ok := quotaLimits[crdName]
+	if !ok {
+		return "", nil
+	}
+	labels := parseLabels(nc, crdInfo.ParentHierarchy, orgName)
+	org := labels[orgCRDType]
+	orgHashedName, orgGVR := getHashedNameAndGVR(orgCRDType, model.CrdTypeToNodeInfo[orgCRDType], org, orgName, nc)
+	orgObj, err := client.Client.Resource(orgGVR).Get(context.TODO(), orgHashedName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	maxObjects, found, err := unstructured.NestedInt64(orgObj.Object, "spec", "quota", limit.field)
+	if err != nil {
+		// A malformed quota does not limit the org.
+		log.Warn().Msgf("Ignoring the malformed spec.quota.%s of org %s: %s", limit.field, org, err.Error())
+		return "", nil
+	}
+	if !found || maxObjects <= 0 {
+		return "", nil
+	}
+
+	selector := k8sLabels.Set{}
+	for _, parent := range limit.scope {
+		selector[parent] = labels[parent]
+	}
+	objs, err := client.Client.Resource(gvr).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	if int64(len(objs.Items)) < maxObjects {
+		return "", nil
+	}
+	return fmt.Sprintf("Quota exceeded: org %s allows at most %d %s.", org, maxObjects, limit.unit), nil
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2025 Intel Corporation
+// SPDX-FileCopyrightText: 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package echoserver
+
+import (
+	"context"
+	"fmt"
+
+	nexusClient "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+)
+
+// templateField is the field of the project spec naming the ProjectTemplate expanded into the project on create.
+const templateField = "template"
+
+// specTemplate returns the template named in the spec of an object of crdName, empty if none.
+func specTemplate(crdName string, spec map[string]interface{}) (string, error) {
+	if crdName != projectCRDType || spec[templateField] == nil {
+		return "", nil
+	}
+	template, ok := spec[templateField].(string)
+	if !ok {
+		return "", fmt.Errorf("template must be a string")
+	}
+	return template, nil
+}
+
+// missingTemplate returns a message if the spec body of a new object of crdName names a ProjectTemplate
+// that does not exist.
+func missingTemplate(ctx context.Context, tenancyClient *nexusClient.Clientset, crdName string,
+	body map[string]interface{},
+) (string, error) {
+	template, err := specTemplate(crdName, body)
+	if err != nil || template == "" {
+		return "", err
+	}
+	_, err = tenancyClient.TenancyMultiTenancy().Config().GetProjectTemplates(ctx, template)
+	if nexusClient.IsNotFound(err) || nexusClient.IsChildNotFound(err) {
+		return fmt.Sprintf("Project template %s not found.", template), nil
+	}
+	return "", err
+}
+
+// keepTemplate checks the spec body of an update of an object of crdName, whose spec is former, does not change
+// its template: templates are expanded once, on create. A body without template keeps the former one.
+func keepTemplate(crdName string, former, body map[string]interface{}) error {
+	formerTemplate, err := specTemplate(crdName, former)
+	if err != nil {
+		return err
+	}
+	if _, ok := body[templateField]; !ok {
+		if formerTemplate != "" {
+			body[templateField] = formerTemplate
+		}
+		return nil
+	}
+	template, err := specTemplate(crdName, body)
+	if err != nil {
+		return err
+	}
+	if template != formerTemplate {
+		return fmt.Errorf("the template of a project can't be changed once it is created")
+	}
+	return nil
+}
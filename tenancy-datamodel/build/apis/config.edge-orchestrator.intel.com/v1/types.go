@@ -70,10 +70,11 @@ func (c *Config) DisplayName() string {
 
 // +k8s:openapi-gen=true
 type ConfigSpec struct {
-	OrgsGvk            map[string]Child `json:"orgsGvk,omitempty" yaml:"orgsGvk,omitempty" nexus:"children"`
-	OrgWatchersGvk     map[string]Child `json:"orgWatchersGvk,omitempty" yaml:"orgWatchersGvk,omitempty" nexus:"children"`
-	APIMappingsGvk     map[string]Child `json:"aPIMappingsGvk,omitempty" yaml:"aPIMappingsGvk,omitempty" nexus:"children"`
-	ProjectWatchersGvk map[string]Child `json:"projectWatchersGvk,omitempty" yaml:"projectWatchersGvk,omitempty" nexus:"children"`
+	OrgsGvk             map[string]Child `json:"orgsGvk,omitempty" yaml:"orgsGvk,omitempty" nexus:"children"`
+	OrgWatchersGvk      map[string]Child `json:"orgWatchersGvk,omitempty" yaml:"orgWatchersGvk,omitempty" nexus:"children"`
+	APIMappingsGvk      map[string]Child `json:"aPIMappingsGvk,omitempty" yaml:"aPIMappingsGvk,omitempty" nexus:"children"`
+	ProjectWatchersGvk  map[string]Child `json:"projectWatchersGvk,omitempty" yaml:"projectWatchersGvk,omitempty" nexus:"children"`
+	ProjectTemplatesGvk map[string]Child `json:"projectTemplatesGvk,omitempty" yaml:"projectTemplatesGvk,omitempty" nexus:"children"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -153,6 +153,13 @@ func (in *ConfigSpec) DeepCopyInto(out *ConfigSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ProjectTemplatesGvk != nil {
+		in, out := &in.ProjectTemplatesGvk, &out.ProjectTemplatesGvk
+		*out = make(map[string]Child, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -70,6 +70,7 @@ func (c *Folder) DisplayName() string {
 
 // +k8s:openapi-gen=true
 type FolderSpec struct {
+	Description string           `json:"description,omitempty" yaml:"description,omitempty"`
 	ProjectsGvk map[string]Child `json:"projectsGvk,omitempty" yaml:"projectsGvk,omitempty" nexus:"children"`
 }
 
@@ -85,9 +85,16 @@ type NetworkList struct {
 // +k8s:openapi-gen=true
 type NetworkStatus struct {
 	CurrentState string `json:"currentState" yaml:"currentState"`
+	Message      string `json:"message,omitempty" yaml:"message,omitempty"`
 }
 
 //nolint:revive // Per requirement.
 type NetworkType string
 
 const AppplicationMesh NetworkType = "application-mesh"
+
+const (
+	NetworkStatePending = "Pending"
+	NetworkStateReady   = "Ready"
+	NetworkStateError   = "Error"
+)
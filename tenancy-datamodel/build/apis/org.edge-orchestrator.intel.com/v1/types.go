@@ -71,8 +71,12 @@ func (c *Org) DisplayName() string {
 
 // +k8s:openapi-gen=true
 type OrgSpec struct {
-	Description string           `json:"description" yaml:"description"`
-	FoldersGvk  map[string]Child `json:"foldersGvk,omitempty" yaml:"foldersGvk,omitempty" nexus:"children"`
+	Description string            `json:"description" yaml:"description"`
+	Quota       Quota             `json:"quota,omitempty" yaml:"quota,omitempty"`
+	Suspended   bool              `json:"suspended,omitempty" yaml:"suspended,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Owners      []string          `json:"owners,omitempty" yaml:"owners,omitempty"`
+	FoldersGvk  map[string]Child  `json:"foldersGvk,omitempty" yaml:"foldersGvk,omitempty" nexus:"children"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -88,6 +92,31 @@ type OrgStatus struct {
 	Message         string               `json:"message" yaml:"message"`
 	TimeStamp       uint64               `json:"timeStamp" yaml:"timeStamp"`
 	UID             string               `json:"uID" yaml:"uID"`
+	Conditions      []WatcherCondition   `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	Usage           QuotaUsage           `json:"usage,omitempty" yaml:"usage,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type Quota struct {
+	MaxProjects           int32 `json:"maxProjects" yaml:"maxProjects"`
+	MaxFolders            int32 `json:"maxFolders" yaml:"maxFolders"`
+	MaxNetworksPerProject int32 `json:"maxNetworksPerProject" yaml:"maxNetworksPerProject"`
+}
+
+// +k8s:openapi-gen=true
+type QuotaUsage struct {
+	Projects int32 `json:"projects" yaml:"projects"`
+	Folders  int32 `json:"folders" yaml:"folders"`
+	Networks int32 `json:"networks" yaml:"networks"`
+}
+
+// +k8s:openapi-gen=true
+type WatcherCondition struct {
+	Watcher            string               `json:"watcher" yaml:"watcher"`
+	State              TenancyRequestStatus `json:"state" yaml:"state"`
+	Message            string               `json:"message" yaml:"message"`
+	LastTransitionTime uint64               `json:"lastTransitionTime" yaml:"lastTransitionTime"`
+	Attempts           int32                `json:"attempts" yaml:"attempts"`
 }
 
 type TenancyRequestStatus string
@@ -83,7 +83,7 @@ func (in *Org) DeepCopyInto(out *Org) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -141,7 +141,7 @@ func (in *OrgList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrgNexusStatus) DeepCopyInto(out *OrgNexusStatus) {
 	*out = *in
-	out.OrgStatus = in.OrgStatus
+	in.OrgStatus.DeepCopyInto(&out.OrgStatus)
 	out.Nexus = in.Nexus
 	return
 }
@@ -159,6 +159,19 @@ func (in *OrgNexusStatus) DeepCopy() *OrgNexusStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrgSpec) DeepCopyInto(out *OrgSpec) {
 	*out = *in
+	out.Quota = in.Quota
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Owners != nil {
+		in, out := &in.Owners, &out.Owners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.FoldersGvk != nil {
 		in, out := &in.FoldersGvk, &out.FoldersGvk
 		*out = make(map[string]Child, len(*in))
@@ -182,6 +195,12 @@ func (in *OrgSpec) DeepCopy() *OrgSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrgStatus) DeepCopyInto(out *OrgStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]WatcherCondition, len(*in))
+		copy(*out, *in)
+	}
+	out.Usage = in.Usage
 	return
 }
 
@@ -195,6 +214,38 @@ func (in *OrgStatus) DeepCopy() *OrgStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Quota) DeepCopyInto(out *Quota) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Quota.
+func (in *Quota) DeepCopy() *Quota {
+	if in == nil {
+		return nil
+	}
+	out := new(Quota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaUsage) DeepCopyInto(out *QuotaUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaUsage.
+func (in *QuotaUsage) DeepCopy() *QuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncerStatus) DeepCopyInto(out *SyncerStatus) {
 	*out = *in
@@ -210,3 +261,19 @@ func (in *SyncerStatus) DeepCopy() *SyncerStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatcherCondition) DeepCopyInto(out *WatcherCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatcherCondition.
+func (in *WatcherCondition) DeepCopy() *WatcherCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(WatcherCondition)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -48,8 +48,8 @@ type NexusStatus struct {
 type OrgWatcher struct {
 	metav1.TypeMeta   `json:",inline" yaml:",inline"`
 	metav1.ObjectMeta `json:"metadata" yaml:"metadata"`
-
-	Status OrgWatcherNexusStatus `json:"status,omitempty" yaml:"status,omitempty"`
+	Spec              OrgWatcherSpec        `json:"spec,omitempty" yaml:"spec,omitempty"`
+	Status            OrgWatcherNexusStatus `json:"status,omitempty" yaml:"status,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -68,9 +68,28 @@ func (c *OrgWatcher) DisplayName() string {
 	return ""
 }
 
+// +k8s:openapi-gen=true
+type OrgWatcherSpec struct {
+	Phase               int32         `json:"phase" yaml:"phase"`
+	DependsOn           []string      `json:"dependsOn,omitempty" yaml:"dependsOn,omitempty"`
+	CreateTimeoutInSecs int32         `json:"createTimeoutInSecs" yaml:"createTimeoutInSecs"`
+	DeleteTimeoutInSecs int32         `json:"deleteTimeoutInSecs" yaml:"deleteTimeoutInSecs"`
+	Required            *bool         `json:"required,omitempty" yaml:"required,omitempty"`
+	FailurePolicy       FailurePolicy `json:"failurePolicy" yaml:"failurePolicy"`
+	Retries             int32         `json:"retries" yaml:"retries"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type OrgWatcherList struct {
 	metav1.TypeMeta `json:",inline" yaml:",inline"`
 	metav1.ListMeta `json:"metadata" yaml:"metadata"`
 	Items           []OrgWatcher `json:"items" yaml:"items"`
 }
+
+type FailurePolicy string
+
+const (
+	FailurePolicyFail   FailurePolicy = "Fail"
+	FailurePolicyIgnore FailurePolicy = "Ignore"
+	FailurePolicyRetry  FailurePolicy = "Retry"
+)
@@ -82,6 +82,7 @@ func (in *OrgWatcher) DeepCopyInto(out *OrgWatcher) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	out.Status = in.Status
 	return
 }
@@ -154,6 +155,32 @@ func (in *OrgWatcherNexusStatus) DeepCopy() *OrgWatcherNexusStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgWatcherSpec) DeepCopyInto(out *OrgWatcherSpec) {
+	*out = *in
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgWatcherSpec.
+func (in *OrgWatcherSpec) DeepCopy() *OrgWatcherSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgWatcherSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncerStatus) DeepCopyInto(out *SyncerStatus) {
 	*out = *in
@@ -71,8 +71,12 @@ func (c *Project) DisplayName() string {
 
 // +k8s:openapi-gen=true
 type ProjectSpec struct {
-	Description string           `json:"description" yaml:"description"`
-	NetworksGvk map[string]Child `json:"networksGvk,omitempty" yaml:"networksGvk,omitempty" nexus:"children"`
+	Description string            `json:"description" yaml:"description"`
+	Archived    bool              `json:"archived,omitempty" yaml:"archived,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Owners      []string          `json:"owners,omitempty" yaml:"owners,omitempty"`
+	Template    string            `json:"template,omitempty" yaml:"template,omitempty"`
+	NetworksGvk map[string]Child  `json:"networksGvk,omitempty" yaml:"networksGvk,omitempty" nexus:"children"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -88,6 +92,16 @@ type ProjectStatus struct {
 	Message         string               `json:"message" yaml:"message"`
 	TimeStamp       uint64               `json:"timeStamp" yaml:"timeStamp"`
 	UID             string               `json:"uID" yaml:"uID"`
+	Conditions      []WatcherCondition   `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type WatcherCondition struct {
+	Watcher            string               `json:"watcher" yaml:"watcher"`
+	State              TenancyRequestStatus `json:"state" yaml:"state"`
+	Message            string               `json:"message" yaml:"message"`
+	LastTransitionTime uint64               `json:"lastTransitionTime" yaml:"lastTransitionTime"`
+	Attempts           int32                `json:"attempts" yaml:"attempts"`
 }
 
 type TenancyRequestStatus string
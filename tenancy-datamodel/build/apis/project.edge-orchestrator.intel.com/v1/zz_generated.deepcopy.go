@@ -83,7 +83,7 @@ func (in *Project) DeepCopyInto(out *Project) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -141,7 +141,7 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectNexusStatus) DeepCopyInto(out *ProjectNexusStatus) {
 	*out = *in
-	out.ProjectStatus = in.ProjectStatus
+	in.ProjectStatus.DeepCopyInto(&out.ProjectStatus)
 	out.Nexus = in.Nexus
 	return
 }
@@ -159,6 +159,18 @@ func (in *ProjectNexusStatus) DeepCopy() *ProjectNexusStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Owners != nil {
+		in, out := &in.Owners, &out.Owners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.NetworksGvk != nil {
 		in, out := &in.NetworksGvk, &out.NetworksGvk
 		*out = make(map[string]Child, len(*in))
@@ -182,6 +194,11 @@ func (in *ProjectSpec) DeepCopy() *ProjectSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]WatcherCondition, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -210,3 +227,19 @@ func (in *SyncerStatus) DeepCopy() *SyncerStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatcherCondition) DeepCopyInto(out *WatcherCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatcherCondition.
+func (in *WatcherCondition) DeepCopy() *WatcherCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(WatcherCondition)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -82,6 +82,7 @@ func (in *ProjectWatcher) DeepCopyInto(out *ProjectWatcher) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	out.Status = in.Status
 	return
 }
@@ -154,6 +155,32 @@ func (in *ProjectWatcherNexusStatus) DeepCopy() *ProjectWatcherNexusStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectWatcherSpec) DeepCopyInto(out *ProjectWatcherSpec) {
+	*out = *in
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectWatcherSpec.
+func (in *ProjectWatcherSpec) DeepCopy() *ProjectWatcherSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectWatcherSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncerStatus) DeepCopyInto(out *SyncerStatus) {
 	*out = *in
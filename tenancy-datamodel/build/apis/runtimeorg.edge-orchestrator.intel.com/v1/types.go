@@ -70,9 +70,12 @@ func (c *RuntimeOrg) DisplayName() string {
 
 // +k8s:openapi-gen=true
 type RuntimeOrgSpec struct {
-	Deleted           bool             `json:"deleted" yaml:"deleted"`
-	FoldersGvk        map[string]Child `json:"foldersGvk,omitempty" yaml:"foldersGvk,omitempty" nexus:"children"`
-	ActiveWatchersGvk map[string]Child `json:"activeWatchersGvk,omitempty" yaml:"activeWatchersGvk,omitempty" nexus:"children"`
+	Deleted           bool              `json:"deleted" yaml:"deleted"`
+	Suspended         bool              `json:"suspended,omitempty" yaml:"suspended,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Owners            []string          `json:"owners,omitempty" yaml:"owners,omitempty"`
+	FoldersGvk        map[string]Child  `json:"foldersGvk,omitempty" yaml:"foldersGvk,omitempty" nexus:"children"`
+	ActiveWatchersGvk map[string]Child  `json:"activeWatchersGvk,omitempty" yaml:"activeWatchersGvk,omitempty" nexus:"children"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
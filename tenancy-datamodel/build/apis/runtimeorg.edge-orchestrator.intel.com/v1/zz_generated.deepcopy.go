@@ -158,6 +158,18 @@ func (in *RuntimeOrgNexusStatus) DeepCopy() *RuntimeOrgNexusStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuntimeOrgSpec) DeepCopyInto(out *RuntimeOrgSpec) {
 	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Owners != nil {
+		in, out := &in.Owners, &out.Owners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.FoldersGvk != nil {
 		in, out := &in.FoldersGvk, &out.FoldersGvk
 		*out = make(map[string]Child, len(*in))
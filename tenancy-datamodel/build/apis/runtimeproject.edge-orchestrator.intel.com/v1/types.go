@@ -70,8 +70,12 @@ func (c *RuntimeProject) DisplayName() string {
 
 // +k8s:openapi-gen=true
 type RuntimeProjectSpec struct {
-	Deleted           bool             `json:"deleted" yaml:"deleted"`
-	ActiveWatchersGvk map[string]Child `json:"activeWatchersGvk,omitempty" yaml:"activeWatchersGvk,omitempty" nexus:"children"`
+	Deleted           bool              `json:"deleted" yaml:"deleted"`
+	Archived          bool              `json:"archived,omitempty" yaml:"archived,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Owners            []string          `json:"owners,omitempty" yaml:"owners,omitempty"`
+	Folder            string            `json:"folder,omitempty" yaml:"folder,omitempty"`
+	ActiveWatchersGvk map[string]Child  `json:"activeWatchersGvk,omitempty" yaml:"activeWatchersGvk,omitempty" nexus:"children"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
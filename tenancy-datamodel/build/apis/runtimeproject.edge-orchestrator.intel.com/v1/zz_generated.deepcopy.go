@@ -158,6 +158,18 @@ func (in *RuntimeProjectNexusStatus) DeepCopy() *RuntimeProjectNexusStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuntimeProjectSpec) DeepCopyInto(out *RuntimeProjectSpec) {
 	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Owners != nil {
+		in, out := &in.Owners, &out.Owners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.ActiveWatchersGvk != nil {
 		in, out := &in.ActiveWatchersGvk, &out.ActiveWatchersGvk
 		*out = make(map[string]Child, len(*in))
@@ -33,6 +33,7 @@ import (
 	orgwatcheredgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/orgwatcher.edge-orchestrator.intel.com/v1"
 	projectedgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/project.edge-orchestrator.intel.com/v1"
 	projectactivewatcheredgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/projectactivewatcher.edge-orchestrator.intel.com/v1"
+	projecttemplateedgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/projecttemplate.edge-orchestrator.intel.com/v1"
 	projectwatcheredgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/projectwatcher.edge-orchestrator.intel.com/v1"
 	runtimeedgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/runtime.edge-orchestrator.intel.com/v1"
 	runtimefolderedgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/runtimefolder.edge-orchestrator.intel.com/v1"
@@ -56,6 +57,7 @@ type Interface interface {
 	OrgwatcherEdgeV1() orgwatcheredgev1.OrgwatcherEdgeV1Interface
 	ProjectEdgeV1() projectedgev1.ProjectEdgeV1Interface
 	ProjectactivewatcherEdgeV1() projectactivewatcheredgev1.ProjectactivewatcherEdgeV1Interface
+	ProjecttemplateEdgeV1() projecttemplateedgev1.ProjecttemplateEdgeV1Interface
 	ProjectwatcherEdgeV1() projectwatcheredgev1.ProjectwatcherEdgeV1Interface
 	RuntimeEdgeV1() runtimeedgev1.RuntimeEdgeV1Interface
 	RuntimefolderEdgeV1() runtimefolderedgev1.RuntimefolderEdgeV1Interface
@@ -76,6 +78,7 @@ type Clientset struct {
 	orgwatcherEdgeV1           *orgwatcheredgev1.OrgwatcherEdgeV1Client
 	projectEdgeV1              *projectedgev1.ProjectEdgeV1Client
 	projectactivewatcherEdgeV1 *projectactivewatcheredgev1.ProjectactivewatcherEdgeV1Client
+	projecttemplateEdgeV1      *projecttemplateedgev1.ProjecttemplateEdgeV1Client
 	projectwatcherEdgeV1       *projectwatcheredgev1.ProjectwatcherEdgeV1Client
 	runtimeEdgeV1              *runtimeedgev1.RuntimeEdgeV1Client
 	runtimefolderEdgeV1        *runtimefolderedgev1.RuntimefolderEdgeV1Client
@@ -129,6 +132,11 @@ func (c *Clientset) ProjectactivewatcherEdgeV1() projectactivewatcheredgev1.Proj
 	return c.projectactivewatcherEdgeV1
 }
 
+// ProjecttemplateEdgeV1 retrieves the ProjecttemplateEdgeV1Client
+func (c *Clientset) ProjecttemplateEdgeV1() projecttemplateedgev1.ProjecttemplateEdgeV1Interface {
+	return c.projecttemplateEdgeV1
+}
+
 // ProjectwatcherEdgeV1 retrieves the ProjectwatcherEdgeV1Client
 func (c *Clientset) ProjectwatcherEdgeV1() projectwatcheredgev1.ProjectwatcherEdgeV1Interface {
 	return c.projectwatcherEdgeV1
@@ -239,6 +247,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.projecttemplateEdgeV1, err = projecttemplateedgev1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.projectwatcherEdgeV1, err = projectwatcheredgev1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -293,6 +305,7 @@ func New(c rest.Interface) *Clientset {
 	cs.orgwatcherEdgeV1 = orgwatcheredgev1.New(c)
 	cs.projectEdgeV1 = projectedgev1.New(c)
 	cs.projectactivewatcherEdgeV1 = projectactivewatcheredgev1.New(c)
+	cs.projecttemplateEdgeV1 = projecttemplateedgev1.New(c)
 	cs.projectwatcherEdgeV1 = projectwatcheredgev1.New(c)
 	cs.runtimeEdgeV1 = runtimeedgev1.New(c)
 	cs.runtimefolderEdgeV1 = runtimefolderedgev1.New(c)
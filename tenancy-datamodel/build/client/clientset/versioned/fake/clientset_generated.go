@@ -41,6 +41,8 @@ import (
 	fakeprojectedgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/project.edge-orchestrator.intel.com/v1/fake"
 	projectactivewatcheredgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/projectactivewatcher.edge-orchestrator.intel.com/v1"
 	fakeprojectactivewatcheredgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/projectactivewatcher.edge-orchestrator.intel.com/v1/fake"
+	projecttemplateedgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/projecttemplate.edge-orchestrator.intel.com/v1"
+	fakeprojecttemplateedgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/projecttemplate.edge-orchestrator.intel.com/v1/fake"
 	projectwatcheredgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/projectwatcher.edge-orchestrator.intel.com/v1"
 	fakeprojectwatcheredgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/projectwatcher.edge-orchestrator.intel.com/v1/fake"
 	runtimeedgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/clientset/versioned/typed/runtime.edge-orchestrator.intel.com/v1"
@@ -156,6 +158,11 @@ func (c *Clientset) ProjectactivewatcherEdgeV1() projectactivewatcheredgev1.Proj
 	return &fakeprojectactivewatcheredgev1.FakeProjectactivewatcherEdgeV1{Fake: &c.Fake}
 }
 
+// ProjecttemplateEdgeV1 retrieves the ProjecttemplateEdgeV1Client
+func (c *Clientset) ProjecttemplateEdgeV1() projecttemplateedgev1.ProjecttemplateEdgeV1Interface {
+	return &fakeprojecttemplateedgev1.FakeProjecttemplateEdgeV1{Fake: &c.Fake}
+}
+
 // ProjectwatcherEdgeV1 retrieves the ProjectwatcherEdgeV1Client
 func (c *Clientset) ProjectwatcherEdgeV1() projectwatcheredgev1.ProjectwatcherEdgeV1Interface {
 	return &fakeprojectwatcheredgev1.FakeProjectwatcherEdgeV1{Fake: &c.Fake}
@@ -31,6 +31,7 @@ import (
 	orgwatcheredgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgwatcher.edge-orchestrator.intel.com/v1"
 	projectedgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
 	projectactivewatcheredgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectactivewatcher.edge-orchestrator.intel.com/v1"
+	projecttemplateedgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projecttemplate.edge-orchestrator.intel.com/v1"
 	projectwatcheredgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectwatcher.edge-orchestrator.intel.com/v1"
 	runtimeedgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtime.edge-orchestrator.intel.com/v1"
 	runtimefolderedgev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimefolder.edge-orchestrator.intel.com/v1"
@@ -58,6 +59,7 @@ var localSchemeBuilder = runtime.SchemeBuilder{
 	orgwatcheredgev1.AddToScheme,
 	projectedgev1.AddToScheme,
 	projectactivewatcheredgev1.AddToScheme,
+	projecttemplateedgev1.AddToScheme,
 	projectwatcheredgev1.AddToScheme,
 	runtimeedgev1.AddToScheme,
 	runtimefolderedgev1.AddToScheme,
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	projecttemplateedgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projecttemplate.edge-orchestrator.intel.com/v1"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeProjectTemplates implements ProjectTemplateInterface
+type FakeProjectTemplates struct {
+	Fake *FakeProjecttemplateEdgeV1
+}
+
+var projecttemplatesResource = schema.GroupVersionResource{Group: "projecttemplate.edge-orchestrator.intel.com", Version: "v1", Resource: "projecttemplates"}
+
+var projecttemplatesKind = schema.GroupVersionKind{Group: "projecttemplate.edge-orchestrator.intel.com", Version: "v1", Kind: "ProjectTemplate"}
+
+// Get takes name of the projectTemplate, and returns the corresponding projectTemplate object, and an error if there is any.
+func (c *FakeProjectTemplates) Get(ctx context.Context, name string, options v1.GetOptions) (result *projecttemplateedgeorchestratorintelcomv1.ProjectTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(projecttemplatesResource, name), &projecttemplateedgeorchestratorintelcomv1.ProjectTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*projecttemplateedgeorchestratorintelcomv1.ProjectTemplate), err
+}
+
+// List takes label and field selectors, and returns the list of ProjectTemplates that match those selectors.
+func (c *FakeProjectTemplates) List(ctx context.Context, opts v1.ListOptions) (result *projecttemplateedgeorchestratorintelcomv1.ProjectTemplateList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(projecttemplatesResource, projecttemplatesKind, opts), &projecttemplateedgeorchestratorintelcomv1.ProjectTemplateList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &projecttemplateedgeorchestratorintelcomv1.ProjectTemplateList{ListMeta: obj.(*projecttemplateedgeorchestratorintelcomv1.ProjectTemplateList).ListMeta}
+	for _, item := range obj.(*projecttemplateedgeorchestratorintelcomv1.ProjectTemplateList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested projectTemplates.
+func (c *FakeProjectTemplates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(projecttemplatesResource, opts))
+}
+
+// Create takes the representation of a projectTemplate and creates it.  Returns the server's representation of the projectTemplate, and an error, if there is any.
+func (c *FakeProjectTemplates) Create(ctx context.Context, projectTemplate *projecttemplateedgeorchestratorintelcomv1.ProjectTemplate, opts v1.CreateOptions) (result *projecttemplateedgeorchestratorintelcomv1.ProjectTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(projecttemplatesResource, projectTemplate), &projecttemplateedgeorchestratorintelcomv1.ProjectTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*projecttemplateedgeorchestratorintelcomv1.ProjectTemplate), err
+}
+
+// Update takes the representation of a projectTemplate and updates it. Returns the server's representation of the projectTemplate, and an error, if there is any.
+func (c *FakeProjectTemplates) Update(ctx context.Context, projectTemplate *projecttemplateedgeorchestratorintelcomv1.ProjectTemplate, opts v1.UpdateOptions) (result *projecttemplateedgeorchestratorintelcomv1.ProjectTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(projecttemplatesResource, projectTemplate), &projecttemplateedgeorchestratorintelcomv1.ProjectTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*projecttemplateedgeorchestratorintelcomv1.ProjectTemplate), err
+}
+
+// Delete takes name of the projectTemplate and deletes it. Returns an error if one occurs.
+func (c *FakeProjectTemplates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(projecttemplatesResource, name, opts), &projecttemplateedgeorchestratorintelcomv1.ProjectTemplate{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeProjectTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(projecttemplatesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &projecttemplateedgeorchestratorintelcomv1.ProjectTemplateList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched projectTemplate.
+func (c *FakeProjectTemplates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *projecttemplateedgeorchestratorintelcomv1.ProjectTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(projecttemplatesResource, name, pt, data, subresources...), &projecttemplateedgeorchestratorintelcomv1.ProjectTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*projecttemplateedgeorchestratorintelcomv1.ProjectTemplate), err
+}
@@ -33,6 +33,7 @@ import (
 	orgwatcheredgeorchestratorintelcom "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/orgwatcher.edge-orchestrator.intel.com"
 	projectedgeorchestratorintelcom "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/project.edge-orchestrator.intel.com"
 	projectactivewatcheredgeorchestratorintelcom "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/projectactivewatcher.edge-orchestrator.intel.com"
+	projecttemplateedgeorchestratorintelcom "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/projecttemplate.edge-orchestrator.intel.com"
 	projectwatcheredgeorchestratorintelcom "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/projectwatcher.edge-orchestrator.intel.com"
 	runtimeedgeorchestratorintelcom "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/runtime.edge-orchestrator.intel.com"
 	runtimefolderedgeorchestratorintelcom "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/runtimefolder.edge-orchestrator.intel.com"
@@ -269,6 +270,7 @@ type SharedInformerFactory interface {
 	OrgwatcherEdge() orgwatcheredgeorchestratorintelcom.Interface
 	ProjectEdge() projectedgeorchestratorintelcom.Interface
 	ProjectactivewatcherEdge() projectactivewatcheredgeorchestratorintelcom.Interface
+	ProjecttemplateEdge() projecttemplateedgeorchestratorintelcom.Interface
 	ProjectwatcherEdge() projectwatcheredgeorchestratorintelcom.Interface
 	RuntimeEdge() runtimeedgeorchestratorintelcom.Interface
 	RuntimefolderEdge() runtimefolderedgeorchestratorintelcom.Interface
@@ -313,6 +315,10 @@ func (f *sharedInformerFactory) ProjectactivewatcherEdge() projectactivewatchere
 	return projectactivewatcheredgeorchestratorintelcom.New(f, f.namespace, f.tweakListOptions)
 }
 
+func (f *sharedInformerFactory) ProjecttemplateEdge() projecttemplateedgeorchestratorintelcom.Interface {
+	return projecttemplateedgeorchestratorintelcom.New(f, f.namespace, f.tweakListOptions)
+}
+
 func (f *sharedInformerFactory) ProjectwatcherEdge() projectwatcheredgeorchestratorintelcom.Interface {
 	return projectwatcheredgeorchestratorintelcom.New(f, f.namespace, f.tweakListOptions)
 }
@@ -32,6 +32,7 @@ import (
 	orgwatcheredgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgwatcher.edge-orchestrator.intel.com/v1"
 	projectedgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
 	projectactivewatcheredgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectactivewatcher.edge-orchestrator.intel.com/v1"
+	projecttemplateedgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projecttemplate.edge-orchestrator.intel.com/v1"
 	projectwatcheredgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectwatcher.edge-orchestrator.intel.com/v1"
 	runtimeedgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtime.edge-orchestrator.intel.com/v1"
 	runtimefolderedgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimefolder.edge-orchestrator.intel.com/v1"
@@ -105,6 +106,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case projectactivewatcheredgeorchestratorintelcomv1.SchemeGroupVersion.WithResource("projectactivewatchers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.ProjectactivewatcherEdge().V1().ProjectActiveWatchers().Informer()}, nil
 
+		// Group=projecttemplate.edge-orchestrator.intel.com, Version=v1
+	case projecttemplateedgeorchestratorintelcomv1.SchemeGroupVersion.WithResource("projecttemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.ProjecttemplateEdge().V1().ProjectTemplates().Informer()}, nil
+
 		// Group=projectwatcher.edge-orchestrator.intel.com, Version=v1
 	case projectwatcheredgeorchestratorintelcomv1.SchemeGroupVersion.WithResource("projectwatchers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.ProjectwatcherEdge().V1().ProjectWatchers().Informer()}, nil
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projecttemplate.edge-orchestrator.intel.com/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ProjectTemplateLister helps list ProjectTemplates.
+// All objects returned here must be treated as read-only.
+type ProjectTemplateLister interface {
+	// List lists all ProjectTemplates in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.ProjectTemplate, err error)
+	// Get retrieves the ProjectTemplate from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.ProjectTemplate, error)
+	ProjectTemplateListerExpansion
+}
+
+// projectTemplateLister implements the ProjectTemplateLister interface.
+type projectTemplateLister struct {
+	indexer cache.Indexer
+}
+
+// NewProjectTemplateLister returns a new ProjectTemplateLister.
+func NewProjectTemplateLister(indexer cache.Indexer) ProjectTemplateLister {
+	return &projectTemplateLister{indexer: indexer}
+}
+
+// List lists all ProjectTemplates in the indexer.
+func (s *projectTemplateLister) List(selector labels.Selector) (ret []*v1.ProjectTemplate, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.ProjectTemplate))
+	})
+	return ret, err
+}
+
+// Get retrieves the ProjectTemplate from the index for a given name.
+func (s *projectTemplateLister) Get(name string) (*v1.ProjectTemplate, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("projecttemplate"), name)
+	}
+	return obj.(*v1.ProjectTemplate), nil
+}
@@ -35,6 +35,7 @@ func GetCRDParentsMap() map[string][]string {
 		"orgwatchers.orgwatcher.edge-orchestrator.intel.com":                     {"multitenancies.tenancy.edge-orchestrator.intel.com", "configs.config.edge-orchestrator.intel.com"},
 		"projectactivewatchers.projectactivewatcher.edge-orchestrator.intel.com": {"multitenancies.tenancy.edge-orchestrator.intel.com", "runtimes.runtime.edge-orchestrator.intel.com", "runtimeorgs.runtimeorg.edge-orchestrator.intel.com", "runtimefolders.runtimefolder.edge-orchestrator.intel.com", "runtimeprojects.runtimeproject.edge-orchestrator.intel.com"},
 		"projects.project.edge-orchestrator.intel.com":                           {"multitenancies.tenancy.edge-orchestrator.intel.com", "configs.config.edge-orchestrator.intel.com", "orgs.org.edge-orchestrator.intel.com", "folders.folder.edge-orchestrator.intel.com"},
+		"projecttemplates.projecttemplate.edge-orchestrator.intel.com":           {"multitenancies.tenancy.edge-orchestrator.intel.com", "configs.config.edge-orchestrator.intel.com"},
 		"projectwatchers.projectwatcher.edge-orchestrator.intel.com":             {"multitenancies.tenancy.edge-orchestrator.intel.com", "configs.config.edge-orchestrator.intel.com"},
 		"runtimefolders.runtimefolder.edge-orchestrator.intel.com":               {"multitenancies.tenancy.edge-orchestrator.intel.com", "runtimes.runtime.edge-orchestrator.intel.com", "runtimeorgs.runtimeorg.edge-orchestrator.intel.com"},
 		"runtimeorgs.runtimeorg.edge-orchestrator.intel.com":                     {"multitenancies.tenancy.edge-orchestrator.intel.com", "runtimes.runtime.edge-orchestrator.intel.com"},
@@ -115,6 +116,13 @@ func GetObjectByCRDName(dmClient *datamodel.Clientset, crdName, name string) int
 		}
 		return obj
 	}
+	if crdName == "projecttemplates.projecttemplate.edge-orchestrator.intel.com" {
+		obj, err := dmClient.ProjecttemplateEdgeV1().ProjectTemplates().Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return nil
+		}
+		return obj
+	}
 	if crdName == "projectwatchers.projectwatcher.edge-orchestrator.intel.com" {
 		obj, err := dmClient.ProjectwatcherEdgeV1().ProjectWatchers().Get(context.TODO(), name, metav1.GetOptions{})
 		if err != nil {
@@ -53,6 +53,7 @@ import (
 	baseorgwatcheredgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgwatcher.edge-orchestrator.intel.com/v1"
 	baseprojectedgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
 	baseprojectactivewatcheredgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectactivewatcher.edge-orchestrator.intel.com/v1"
+	baseprojecttemplateedgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projecttemplate.edge-orchestrator.intel.com/v1"
 	baseprojectwatcheredgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectwatcher.edge-orchestrator.intel.com/v1"
 	baseruntimeedgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtime.edge-orchestrator.intel.com/v1"
 	baseruntimefolderedgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimefolder.edge-orchestrator.intel.com/v1"
@@ -69,6 +70,7 @@ import (
 	informerorgwatcheredgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/orgwatcher.edge-orchestrator.intel.com/v1"
 	informerprojectedgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/project.edge-orchestrator.intel.com/v1"
 	informerprojectactivewatcheredgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/projectactivewatcher.edge-orchestrator.intel.com/v1"
+	informerprojecttemplateedgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/projecttemplate.edge-orchestrator.intel.com/v1"
 	informerprojectwatcheredgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/projectwatcher.edge-orchestrator.intel.com/v1"
 	informerruntimeedgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/runtime.edge-orchestrator.intel.com/v1"
 	informerruntimefolderedgeorchestratorintelcomv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/client/informers/externalversions/runtimefolder.edge-orchestrator.intel.com/v1"
@@ -101,6 +103,7 @@ type Clientset struct {
 	networkEdgeV1              *NetworkEdgeV1
 	orgwatcherEdgeV1           *OrgwatcherEdgeV1
 	projectwatcherEdgeV1       *ProjectwatcherEdgeV1
+	projecttemplateEdgeV1      *ProjecttemplateEdgeV1
 	runtimeEdgeV1              *RuntimeEdgeV1
 	runtimeorgEdgeV1           *RuntimeorgEdgeV1
 	runtimefolderEdgeV1        *RuntimefolderEdgeV1
@@ -243,6 +246,19 @@ func (c *Clientset) SubscribeAll() {
 
 	}
 
+	key = "projecttemplates.projecttemplate.edge-orchestrator.intel.com"
+	if _, ok := subscriptionMap.Load(key); !ok {
+		informer := informerprojecttemplateedgeorchestratorintelcomv1.NewProjectTemplateInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		subscribe(key, informer)
+
+		chainer := projecttemplateProjecttemplateEdgeV1Chainer{
+			client: c,
+		}
+		chainer.RegisterAddCallback(chainer.addCallback)
+		chainer.RegisterDeleteCallback(chainer.deleteCallback)
+
+	}
+
 	key = "runtimes.runtime.edge-orchestrator.intel.com"
 	if _, ok := subscriptionMap.Load(key); !ok {
 		informer := informerruntimeedgeorchestratorintelcomv1.NewRuntimeInformer(c.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
@@ -371,6 +387,7 @@ func NewForConfig(config *rest.Config) (*Clientset, error) {
 	client.networkEdgeV1 = newNetworkEdgeV1(client)
 	client.orgwatcherEdgeV1 = newOrgwatcherEdgeV1(client)
 	client.projectwatcherEdgeV1 = newProjectwatcherEdgeV1(client)
+	client.projecttemplateEdgeV1 = newProjecttemplateEdgeV1(client)
 	client.runtimeEdgeV1 = newRuntimeEdgeV1(client)
 	client.runtimeorgEdgeV1 = newRuntimeorgEdgeV1(client)
 	client.runtimefolderEdgeV1 = newRuntimefolderEdgeV1(client)
@@ -396,6 +413,7 @@ func NewFakeClient() *Clientset {
 	client.networkEdgeV1 = newNetworkEdgeV1(client)
 	client.orgwatcherEdgeV1 = newOrgwatcherEdgeV1(client)
 	client.projectwatcherEdgeV1 = newProjectwatcherEdgeV1(client)
+	client.projecttemplateEdgeV1 = newProjecttemplateEdgeV1(client)
 	client.runtimeEdgeV1 = newRuntimeEdgeV1(client)
 	client.runtimeorgEdgeV1 = newRuntimeorgEdgeV1(client)
 	client.runtimefolderEdgeV1 = newRuntimefolderEdgeV1(client)
@@ -445,6 +463,9 @@ func (c *Clientset) Orgwatcher() *OrgwatcherEdgeV1 {
 func (c *Clientset) Projectwatcher() *ProjectwatcherEdgeV1 {
 	return c.projectwatcherEdgeV1
 }
+func (c *Clientset) Projecttemplate() *ProjecttemplateEdgeV1 {
+	return c.projecttemplateEdgeV1
+}
 func (c *Clientset) Runtime() *RuntimeEdgeV1 {
 	return c.runtimeEdgeV1
 }
@@ -554,6 +575,16 @@ func newProjectwatcherEdgeV1(client *Clientset) *ProjectwatcherEdgeV1 {
 	}
 }
 
+type ProjecttemplateEdgeV1 struct {
+	client *Clientset
+}
+
+func newProjecttemplateEdgeV1(client *Clientset) *ProjecttemplateEdgeV1 {
+	return &ProjecttemplateEdgeV1{
+		client: client,
+	}
+}
+
 type RuntimeEdgeV1 struct {
 	client *Clientset
 }
@@ -1748,6 +1779,13 @@ func (group *ConfigEdgeV1) DeleteConfigByName(ctx context.Context, hashedName st
 		}
 		RemoveChild("configs.config.edge-orchestrator.intel.com", hashedName, "projectwatchers.projectwatcher.edge-orchestrator.intel.com", child)
 	}
+	for _, child := range GetChildren("configs.config.edge-orchestrator.intel.com", hashedName, "projecttemplates.projecttemplate.edge-orchestrator.intel.com") {
+		err := group.client.Projecttemplate().DeleteProjectTemplateByName(ctx, child)
+		if err != nil && errors.IsNotFound(err) == false {
+			return err
+		}
+		RemoveChild("configs.config.edge-orchestrator.intel.com", hashedName, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", child)
+	}
 
 	retryCount = 0
 	for {
@@ -2583,6 +2621,137 @@ func (obj *ConfigConfig) DeleteProjectWatchers(ctx context.Context, displayName
 	return
 }
 
+type ConfigConfigProjectTemplates struct {
+	client           *Clientset
+	ProjectTemplates []baseconfigedgeorchestratorintelcomv1.Child
+}
+
+func (n *ConfigConfigProjectTemplates) Next(ctx context.Context) (*ProjecttemplateProjectTemplate, error) {
+	for index, child := range n.ProjectTemplates {
+		logger.Debugf("[ConfigConfigProjectTemplates Next] Get next ProjectTemplates with name %s", child.Name)
+		obj, err := n.client.Projecttemplate().GetProjectTemplateByName(ctx, child.Name)
+		if err == nil {
+			if index == len(n.ProjectTemplates)-1 {
+				n.ProjectTemplates = nil
+			} else {
+				n.ProjectTemplates = n.ProjectTemplates[index+1:]
+			}
+			return obj, nil
+		} else if errors.IsNotFound(err) {
+			continue
+		} else {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// GetAllProjectTemplatesIter returns an iterator for all children of given type
+func (obj *ConfigConfig) GetAllProjectTemplatesIter(ctx context.Context) (
+	result ConfigConfigProjectTemplates) {
+	result.client = obj.client
+	for _, v := range GetChildren("configs.config.edge-orchestrator.intel.com", obj.Name, "projecttemplates.projecttemplate.edge-orchestrator.intel.com") {
+		result.ProjectTemplates = append(result.ProjectTemplates, baseconfigedgeorchestratorintelcomv1.Child{
+			Group: "projecttemplate.edge-orchestrator.intel.com",
+			Kind:  "ProjectTemplate",
+			Name:  v,
+		})
+	}
+	return
+}
+
+// GetAllProjectTemplates returns all children of a given type
+func (obj *ConfigConfig) GetAllProjectTemplates(ctx context.Context) (
+	result []*ProjecttemplateProjectTemplate, err error) {
+	for _, v := range GetChildren("configs.config.edge-orchestrator.intel.com", obj.Name, "projecttemplates.projecttemplate.edge-orchestrator.intel.com") {
+		logger.Debugf("[ConfigConfig GetAllProjectTemplates] Get next ProjectTemplates with name %s", v)
+		l, err := obj.client.Projecttemplate().GetProjectTemplateByName(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, l)
+	}
+	return
+}
+
+// GetProjectTemplates returns child which has given displayName
+func (obj *ConfigConfig) GetProjectTemplates(ctx context.Context,
+	displayName string) (result *ProjecttemplateProjectTemplate, err error) {
+
+	parentLabels := make(map[string]string)
+	for k, v := range obj.Labels {
+		parentLabels[k] = v
+	}
+	parentLabels["configs.config.edge-orchestrator.intel.com"] = obj.DisplayName()
+	childHashName := helper.GetHashedName("projecttemplates.projecttemplate.edge-orchestrator.intel.com", parentLabels, displayName)
+	logger.Debugf("[GetProjectTemplates] in ConfigConfig with name %s, displayName %s, parentLabels %#v", childHashName, displayName, parentLabels)
+	if IsChildExists("configs.config.edge-orchestrator.intel.com", obj.Name, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", childHashName) == false {
+		logger.Debugf("[GetProjectTemplates] ChildNotFound ProjectTemplates with name %s, displayName %s ", childHashName, displayName)
+		return nil, NewChildNotFound(obj.DisplayName(), "Config.Config", "ProjectTemplates", displayName)
+	}
+
+	logger.Debugf("[GetProjectTemplates] invoke GetProjectTemplateByName name %s, displayName %s", childHashName, displayName)
+	result, err = obj.client.Projecttemplate().GetProjectTemplateByName(ctx, childHashName)
+	return
+}
+
+// AddProjectTemplates calculates hashed name of the child to create based on objToCreate.Name
+// and parents names and creates it. objToCreate.Name is changed to the hashed name. Original name is preserved in
+// nexus/display_name label and can be obtained using DisplayName() method.
+func (obj *ConfigConfig) AddProjectTemplates(ctx context.Context,
+	objToCreate *baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate) (result *ProjecttemplateProjectTemplate, err error) {
+	logger.Debugf("[AddProjectTemplates] Received objToAdd: %s", objToCreate.GetName())
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for _, v := range helper.GetCRDParentsMap()["configs.config.edge-orchestrator.intel.com"] {
+		objToCreate.Labels[v] = obj.Labels[v]
+	}
+	objToCreate.Labels["configs.config.edge-orchestrator.intel.com"] = obj.DisplayName()
+	if objToCreate.Labels[common.IsNameHashedLabel] != "true" {
+		objToCreate.Labels[common.DisplayNameLabel] = objToCreate.GetName()
+		objToCreate.Labels[common.IsNameHashedLabel] = "true"
+		hashedName := helper.GetHashedName(objToCreate.CRDName(), objToCreate.Labels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	result, err = obj.client.Projecttemplate().CreateProjectTemplateByName(ctx, objToCreate)
+	logger.Debugf("[AddProjectTemplates] ProjectTemplate created successfully: %s", objToCreate.GetName())
+	updatedObj, getErr := obj.client.Config().GetConfigByName(ctx, obj.GetName())
+	if getErr == nil {
+		obj.Config = updatedObj.Config
+	}
+	logger.Debugf("[AddProjectTemplates] Executed Successfully: %s", objToCreate.GetName())
+	return
+}
+
+// DeleteProjectTemplates calculates hashed name of the child to delete based on displayName
+// and parents names and deletes it.
+
+func (obj *ConfigConfig) DeleteProjectTemplates(ctx context.Context, displayName string) (err error) {
+	logger.Debugf("[ DeleteProjectTemplates] Received for ProjectTemplate object: %s to delete", displayName)
+
+	parentLabels := make(map[string]string)
+	for k, v := range obj.Labels {
+		parentLabels[k] = v
+	}
+	parentLabels["configs.config.edge-orchestrator.intel.com"] = obj.DisplayName()
+	childHashName := helper.GetHashedName("projecttemplates.projecttemplate.edge-orchestrator.intel.com", parentLabels, displayName)
+	if IsChildExists("configs.config.edge-orchestrator.intel.com", obj.Name, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", childHashName) == false {
+		return NewChildNotFound(obj.DisplayName(), "Config.Config", "ProjectTemplates", displayName)
+	}
+
+	err = obj.client.Projecttemplate().DeleteProjectTemplateByName(ctx, childHashName)
+	if err != nil {
+		return err
+	}
+	logger.Debugf("[ DeleteProjectTemplates] ProjectTemplate object: %s deleted successfully", displayName)
+	updatedObj, err := obj.client.Config().GetConfigByName(ctx, obj.GetName())
+	if err == nil {
+		obj.Config = updatedObj.Config
+	}
+	return
+}
+
 type configConfigEdgeV1Chainer struct {
 	client       *Clientset
 	name         string
@@ -3117,6 +3286,54 @@ func (c *configConfigEdgeV1Chainer) DeleteProjectWatchers(ctx context.Context, n
 	return c.client.Projectwatcher().DeleteProjectWatcherByName(ctx, hashedName)
 }
 
+func (c *configConfigEdgeV1Chainer) ProjectTemplates(name string) *projecttemplateProjecttemplateEdgeV1Chainer {
+	parentLabels := c.parentLabels
+	parentLabels["projecttemplates.projecttemplate.edge-orchestrator.intel.com"] = name
+	return &projecttemplateProjecttemplateEdgeV1Chainer{
+		client:       c.client,
+		name:         name,
+		parentLabels: parentLabels,
+	}
+}
+
+// GetProjectTemplates calculates hashed name of the object based on displayName and it's parents and returns the object
+func (c *configConfigEdgeV1Chainer) GetProjectTemplates(ctx context.Context, displayName string) (result *ProjecttemplateProjectTemplate, err error) {
+	hashedName := helper.GetHashedName("projecttemplates.projecttemplate.edge-orchestrator.intel.com", c.parentLabels, displayName)
+	logger.Debugf("[GetProjectTemplates] using chainer for name %s, displayName %s, labels %#v", hashedName, displayName, c.parentLabels)
+	return c.client.Projecttemplate().GetProjectTemplateByName(ctx, hashedName)
+}
+
+// AddProjectTemplates calculates hashed name of the child to create based on objToCreate.Name
+// and parents names and creates it. objToCreate.Name is changed to the hashed name. Original name is preserved in
+// nexus/display_name label and can be obtained using DisplayName() method.
+func (c *configConfigEdgeV1Chainer) AddProjectTemplates(ctx context.Context,
+	objToCreate *baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate) (result *ProjecttemplateProjectTemplate, err error) {
+	if objToCreate.Labels == nil {
+		objToCreate.Labels = map[string]string{}
+	}
+	for k, v := range c.parentLabels {
+		objToCreate.Labels[k] = v
+	}
+	if objToCreate.Labels[common.IsNameHashedLabel] != "true" {
+		objToCreate.Labels[common.DisplayNameLabel] = objToCreate.GetName()
+		objToCreate.Labels[common.IsNameHashedLabel] = "true"
+		hashedName := helper.GetHashedName("projecttemplates.projecttemplate.edge-orchestrator.intel.com", c.parentLabels, objToCreate.GetName())
+		objToCreate.Name = hashedName
+	}
+	return c.client.Projecttemplate().CreateProjectTemplateByName(ctx, objToCreate)
+}
+
+// DeleteProjectTemplates calculates hashed name of the child to delete based on displayName
+// and parents names and deletes it.
+func (c *configConfigEdgeV1Chainer) DeleteProjectTemplates(ctx context.Context, name string) (err error) {
+	if c.parentLabels == nil {
+		c.parentLabels = map[string]string{}
+	}
+	c.parentLabels[common.IsNameHashedLabel] = "true"
+	hashedName := helper.GetHashedName("projecttemplates.projecttemplate.edge-orchestrator.intel.com", c.parentLabels, name)
+	return c.client.Projecttemplate().DeleteProjectTemplateByName(ctx, hashedName)
+}
+
 func (group *ApimappingconfigEdgeV1) GetAPIMappingConfigChildrenMap() map[string]baseapimappingconfigedgeorchestratorintelcomv1.Child {
 	return map[string]baseapimappingconfigedgeorchestratorintelcomv1.Child{}
 }
@@ -6634,6 +6851,90 @@ func (group *ProjectEdgeV1) UpdateProjectByName(ctx context.Context,
 		patch = append(patch, patchOpDescription)
 	}
 
+	rt = reflect.TypeOf(objToUpdate.Spec.Archived)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.Archived).IsNil() {
+			patchValueArchived := objToUpdate.Spec.Archived
+			patchOpArchived := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/archived",
+				Value: patchValueArchived,
+			}
+			patch = append(patch, patchOpArchived)
+		}
+	} else {
+		patchValueArchived := objToUpdate.Spec.Archived
+		patchOpArchived := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/archived",
+			Value: patchValueArchived,
+		}
+		patch = append(patch, patchOpArchived)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.Labels)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.Labels).IsNil() {
+			patchValueLabels := objToUpdate.Spec.Labels
+			patchOpLabels := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/labels",
+				Value: patchValueLabels,
+			}
+			patch = append(patch, patchOpLabels)
+		}
+	} else {
+		patchValueLabels := objToUpdate.Spec.Labels
+		patchOpLabels := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/labels",
+			Value: patchValueLabels,
+		}
+		patch = append(patch, patchOpLabels)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.Owners)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.Owners).IsNil() {
+			patchValueOwners := objToUpdate.Spec.Owners
+			patchOpOwners := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/owners",
+				Value: patchValueOwners,
+			}
+			patch = append(patch, patchOpOwners)
+		}
+	} else {
+		patchValueOwners := objToUpdate.Spec.Owners
+		patchOpOwners := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/owners",
+			Value: patchValueOwners,
+		}
+		patch = append(patch, patchOpOwners)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.Template)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.Template).IsNil() {
+			patchValueTemplate := objToUpdate.Spec.Template
+			patchOpTemplate := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/template",
+				Value: patchValueTemplate,
+			}
+			patch = append(patch, patchOpTemplate)
+		}
+	} else {
+		patchValueTemplate := objToUpdate.Spec.Template
+		patchOpTemplate := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/template",
+			Value: patchValueTemplate,
+		}
+		patch = append(patch, patchOpTemplate)
+	}
+
 	marshaled, err := patch.Marshal()
 	if err != nil {
 		return nil, err
@@ -8692,6 +8993,155 @@ func (group *OrgwatcherEdgeV1) UpdateOrgWatcherByName(ctx context.Context,
 		})
 	}
 
+	var rt reflect.Type
+
+	rt = reflect.TypeOf(objToUpdate.Spec.Phase)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.Phase).IsNil() {
+			patchValuePhase := objToUpdate.Spec.Phase
+			patchOpPhase := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/phase",
+				Value: patchValuePhase,
+			}
+			patch = append(patch, patchOpPhase)
+		}
+	} else {
+		patchValuePhase := objToUpdate.Spec.Phase
+		patchOpPhase := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/phase",
+			Value: patchValuePhase,
+		}
+		patch = append(patch, patchOpPhase)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.DependsOn)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.DependsOn).IsNil() {
+			patchValueDependsOn := objToUpdate.Spec.DependsOn
+			patchOpDependsOn := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/dependsOn",
+				Value: patchValueDependsOn,
+			}
+			patch = append(patch, patchOpDependsOn)
+		}
+	} else {
+		patchValueDependsOn := objToUpdate.Spec.DependsOn
+		patchOpDependsOn := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/dependsOn",
+			Value: patchValueDependsOn,
+		}
+		patch = append(patch, patchOpDependsOn)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.CreateTimeoutInSecs)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.CreateTimeoutInSecs).IsNil() {
+			patchValueCreateTimeoutInSecs := objToUpdate.Spec.CreateTimeoutInSecs
+			patchOpCreateTimeoutInSecs := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/createTimeoutInSecs",
+				Value: patchValueCreateTimeoutInSecs,
+			}
+			patch = append(patch, patchOpCreateTimeoutInSecs)
+		}
+	} else {
+		patchValueCreateTimeoutInSecs := objToUpdate.Spec.CreateTimeoutInSecs
+		patchOpCreateTimeoutInSecs := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/createTimeoutInSecs",
+			Value: patchValueCreateTimeoutInSecs,
+		}
+		patch = append(patch, patchOpCreateTimeoutInSecs)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.DeleteTimeoutInSecs)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.DeleteTimeoutInSecs).IsNil() {
+			patchValueDeleteTimeoutInSecs := objToUpdate.Spec.DeleteTimeoutInSecs
+			patchOpDeleteTimeoutInSecs := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/deleteTimeoutInSecs",
+				Value: patchValueDeleteTimeoutInSecs,
+			}
+			patch = append(patch, patchOpDeleteTimeoutInSecs)
+		}
+	} else {
+		patchValueDeleteTimeoutInSecs := objToUpdate.Spec.DeleteTimeoutInSecs
+		patchOpDeleteTimeoutInSecs := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/deleteTimeoutInSecs",
+			Value: patchValueDeleteTimeoutInSecs,
+		}
+		patch = append(patch, patchOpDeleteTimeoutInSecs)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.Required)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map || rt.Kind() == reflect.Ptr {
+		if !reflect.ValueOf(objToUpdate.Spec.Required).IsNil() {
+			patchValueRequired := objToUpdate.Spec.Required
+			patchOpRequired := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/required",
+				Value: patchValueRequired,
+			}
+			patch = append(patch, patchOpRequired)
+		}
+	} else {
+		patchValueRequired := objToUpdate.Spec.Required
+		patchOpRequired := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/required",
+			Value: patchValueRequired,
+		}
+		patch = append(patch, patchOpRequired)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.FailurePolicy)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.FailurePolicy).IsNil() {
+			patchValueFailurePolicy := objToUpdate.Spec.FailurePolicy
+			patchOpFailurePolicy := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/failurePolicy",
+				Value: patchValueFailurePolicy,
+			}
+			patch = append(patch, patchOpFailurePolicy)
+		}
+	} else {
+		patchValueFailurePolicy := objToUpdate.Spec.FailurePolicy
+		patchOpFailurePolicy := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/failurePolicy",
+			Value: patchValueFailurePolicy,
+		}
+		patch = append(patch, patchOpFailurePolicy)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.Retries)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.Retries).IsNil() {
+			patchValueRetries := objToUpdate.Spec.Retries
+			patchOpRetries := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/retries",
+				Value: patchValueRetries,
+			}
+			patch = append(patch, patchOpRetries)
+		}
+	} else {
+		patchValueRetries := objToUpdate.Spec.Retries
+		patchOpRetries := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/retries",
+			Value: patchValueRetries,
+		}
+		patch = append(patch, patchOpRetries)
+	}
+
 	marshaled, err := patch.Marshal()
 	if err != nil {
 		return nil, err
@@ -9497,19 +9947,168 @@ func (group *ProjectwatcherEdgeV1) UpdateProjectWatcherByName(ctx context.Contex
 		})
 	}
 
-	marshaled, err := patch.Marshal()
-	if err != nil {
-		return nil, err
+	var rt reflect.Type
+
+	rt = reflect.TypeOf(objToUpdate.Spec.Phase)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.Phase).IsNil() {
+			patchValuePhase := objToUpdate.Spec.Phase
+			patchOpPhase := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/phase",
+				Value: patchValuePhase,
+			}
+			patch = append(patch, patchOpPhase)
+		}
+	} else {
+		patchValuePhase := objToUpdate.Spec.Phase
+		patchOpPhase := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/phase",
+			Value: patchValuePhase,
+		}
+		patch = append(patch, patchOpPhase)
 	}
 
-	var (
-		result *baseprojectwatcheredgeorchestratorintelcomv1.ProjectWatcher
-	)
-	newCtx := context.TODO()
-	retryCount := 0
-	for {
-		result, err = group.client.baseClient.
-			ProjectwatcherEdgeV1().
+	rt = reflect.TypeOf(objToUpdate.Spec.DependsOn)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.DependsOn).IsNil() {
+			patchValueDependsOn := objToUpdate.Spec.DependsOn
+			patchOpDependsOn := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/dependsOn",
+				Value: patchValueDependsOn,
+			}
+			patch = append(patch, patchOpDependsOn)
+		}
+	} else {
+		patchValueDependsOn := objToUpdate.Spec.DependsOn
+		patchOpDependsOn := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/dependsOn",
+			Value: patchValueDependsOn,
+		}
+		patch = append(patch, patchOpDependsOn)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.CreateTimeoutInSecs)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.CreateTimeoutInSecs).IsNil() {
+			patchValueCreateTimeoutInSecs := objToUpdate.Spec.CreateTimeoutInSecs
+			patchOpCreateTimeoutInSecs := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/createTimeoutInSecs",
+				Value: patchValueCreateTimeoutInSecs,
+			}
+			patch = append(patch, patchOpCreateTimeoutInSecs)
+		}
+	} else {
+		patchValueCreateTimeoutInSecs := objToUpdate.Spec.CreateTimeoutInSecs
+		patchOpCreateTimeoutInSecs := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/createTimeoutInSecs",
+			Value: patchValueCreateTimeoutInSecs,
+		}
+		patch = append(patch, patchOpCreateTimeoutInSecs)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.DeleteTimeoutInSecs)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.DeleteTimeoutInSecs).IsNil() {
+			patchValueDeleteTimeoutInSecs := objToUpdate.Spec.DeleteTimeoutInSecs
+			patchOpDeleteTimeoutInSecs := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/deleteTimeoutInSecs",
+				Value: patchValueDeleteTimeoutInSecs,
+			}
+			patch = append(patch, patchOpDeleteTimeoutInSecs)
+		}
+	} else {
+		patchValueDeleteTimeoutInSecs := objToUpdate.Spec.DeleteTimeoutInSecs
+		patchOpDeleteTimeoutInSecs := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/deleteTimeoutInSecs",
+			Value: patchValueDeleteTimeoutInSecs,
+		}
+		patch = append(patch, patchOpDeleteTimeoutInSecs)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.Required)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map || rt.Kind() == reflect.Ptr {
+		if !reflect.ValueOf(objToUpdate.Spec.Required).IsNil() {
+			patchValueRequired := objToUpdate.Spec.Required
+			patchOpRequired := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/required",
+				Value: patchValueRequired,
+			}
+			patch = append(patch, patchOpRequired)
+		}
+	} else {
+		patchValueRequired := objToUpdate.Spec.Required
+		patchOpRequired := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/required",
+			Value: patchValueRequired,
+		}
+		patch = append(patch, patchOpRequired)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.FailurePolicy)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.FailurePolicy).IsNil() {
+			patchValueFailurePolicy := objToUpdate.Spec.FailurePolicy
+			patchOpFailurePolicy := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/failurePolicy",
+				Value: patchValueFailurePolicy,
+			}
+			patch = append(patch, patchOpFailurePolicy)
+		}
+	} else {
+		patchValueFailurePolicy := objToUpdate.Spec.FailurePolicy
+		patchOpFailurePolicy := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/failurePolicy",
+			Value: patchValueFailurePolicy,
+		}
+		patch = append(patch, patchOpFailurePolicy)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.Retries)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.Retries).IsNil() {
+			patchValueRetries := objToUpdate.Spec.Retries
+			patchOpRetries := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/retries",
+				Value: patchValueRetries,
+			}
+			patch = append(patch, patchOpRetries)
+		}
+	} else {
+		patchValueRetries := objToUpdate.Spec.Retries
+		patchOpRetries := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/retries",
+			Value: patchValueRetries,
+		}
+		patch = append(patch, patchOpRetries)
+	}
+
+	marshaled, err := patch.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result *baseprojectwatcheredgeorchestratorintelcomv1.ProjectWatcher
+	)
+	newCtx := context.TODO()
+	retryCount := 0
+	for {
+		result, err = group.client.baseClient.
+			ProjectwatcherEdgeV1().
 			ProjectWatchers().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
 		if err != nil {
 			logger.Errorf("[UpdateProjectWatcherByName] Failed to patch ProjectWatcher %s with error: %+v", objToUpdate.GetName(), err)
@@ -9963,6 +10562,897 @@ func (c *projectwatcherProjectwatcherEdgeV1Chainer) RegisterDeleteCallback(cbfn
 	return registrationId, err
 }
 
+func (group *ProjecttemplateEdgeV1) GetProjectTemplateChildrenMap() map[string]baseprojecttemplateedgeorchestratorintelcomv1.Child {
+	return map[string]baseprojecttemplateedgeorchestratorintelcomv1.Child{}
+}
+
+func (group *ProjecttemplateEdgeV1) GetProjectTemplateChild(grp, kind, name string) baseprojecttemplateedgeorchestratorintelcomv1.Child {
+	return baseprojecttemplateedgeorchestratorintelcomv1.Child{
+		Group: grp,
+		Kind:  kind,
+		Name:  name,
+	}
+}
+
+// GetProjectTemplateByName returns object stored in the database under the hashedName which is a hash of display
+// name and parents names. Use it when you know hashed name of object.
+func (group *ProjecttemplateEdgeV1) GetProjectTemplateByName(ctx context.Context, hashedName string) (*ProjecttemplateProjectTemplate, error) {
+	key := "projecttemplates.projecttemplate.edge-orchestrator.intel.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		// Check if the object is in write cache.
+		resWrCache, inWrCache := s.(subscription).WriteCacheObjects.Load(hashedName)
+		item, exists, _ := s.(subscription).informer.GetStore().GetByKey(hashedName)
+		if exists {
+			logger.Debugf("[GetProjectTemplateByName] Object: %s exists in cache", hashedName)
+			resultCache, _ := item.(*baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate)
+			subsCacheVersion, subsCacheVersionErr := strconv.Atoi(resultCache.ResourceVersion)
+			if subsCacheVersionErr != nil {
+				logger.Fatalf("[GetProjectTemplateByName] Getting version of Object: %s failed with error %v", hashedName, subsCacheVersionErr)
+			}
+
+			writeCacheVersion := 0
+			var writeCacheVersionErr error
+			if inWrCache {
+				writeCacheVersion, writeCacheVersionErr = strconv.Atoi(resWrCache.(*baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate).ResourceVersion)
+				if writeCacheVersionErr != nil {
+					logger.Fatalf("[GetProjectTemplateByName] Getting version of Object: %s in write cache failed with error %v", hashedName, writeCacheVersionErr)
+				}
+			}
+
+			if !inWrCache || subsCacheVersion >= writeCacheVersion {
+				if inWrCache {
+					s.(subscription).WriteCacheObjects.Delete(hashedName)
+				}
+				return &ProjecttemplateProjectTemplate{
+					client:          group.client,
+					ProjectTemplate: resultCache,
+				}, nil
+			}
+		}
+		if inWrCache {
+			return &ProjecttemplateProjectTemplate{
+				client:          group.client,
+				ProjectTemplate: resWrCache.(*baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate),
+			}, nil
+		}
+	}
+
+	retryCount := 0
+	for {
+		result, err := group.client.baseClient.
+			ProjecttemplateEdgeV1().
+			ProjectTemplates().Get(ctx, hashedName, metav1.GetOptions{})
+		if err == nil {
+			return &ProjecttemplateProjectTemplate{
+				client:          group.client,
+				ProjectTemplate: result,
+			}, nil
+		} else if errors.IsNotFound(err) {
+			logger.Debugf("[GetProjectTemplateByName]: object %v not found", hashedName)
+			return nil, err
+		} else {
+			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				logger.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
+				if retryCount == maxRetryCount {
+					logger.Errorf("Max retry exceed on Get ProjectTemplates: %s", hashedName)
+					return nil, err
+				}
+				retryCount += 1
+				time.Sleep(sleepTime * time.Second)
+			} else if customerrors.Is(err, context.Canceled) {
+				logger.Errorf("[GetProjectTemplateByName]: %+v", err)
+				return nil, context.Canceled
+			} else {
+				logger.Errorf("[GetProjectTemplateByName]: %+v", err)
+				return nil, err
+			}
+		}
+	}
+}
+
+// ForceReadProjectTemplateByName read object directly from the database under the hashedName which is a hash of display
+// name and parents names. Use it when you know hashed name of object.
+func (group *ProjecttemplateEdgeV1) ForceReadProjectTemplateByName(ctx context.Context, hashedName string) (*ProjecttemplateProjectTemplate, error) {
+	logger.Debugf("[ForceReadProjectTemplateByName] Received object :%s to read from DB", hashedName)
+	retryCount := 0
+	for {
+		result, err := group.client.baseClient.
+			ProjecttemplateEdgeV1().
+			ProjectTemplates().Get(ctx, hashedName, metav1.GetOptions{})
+		if err != nil {
+			logger.Errorf("[ForceReadProjectTemplateByName] Failed to Get ProjectTemplates: %+v", err)
+			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				logger.Errorf("[Retry Count: %d ] %+v", retryCount, err)
+				if retryCount == maxRetryCount {
+					logger.Errorf("Max Retry exceed on Get ProjectTemplates: %s", hashedName)
+					return nil, err
+				}
+				retryCount += 1
+				time.Sleep(sleepTime * time.Second)
+			} else if customerrors.Is(err, context.Canceled) {
+				logger.Errorf("[ForceReadProjectTemplateByName]: %+v", err)
+				return nil, context.Canceled
+			} else {
+				logger.Errorf("[ForceReadProjectTemplateByName]: %+v", err)
+				return nil, err
+			}
+		} else {
+			logger.Debugf("[ForceReadProjectTemplateByName] Executed Successfully :%s", hashedName)
+			return &ProjecttemplateProjectTemplate{
+				client:          group.client,
+				ProjectTemplate: result,
+			}, nil
+		}
+	}
+}
+
+// DeleteProjectTemplateByName deletes object stored in the database under the hashedName which is a hash of
+// display name and parents names. Use it when you know hashed name of object.
+func (group *ProjecttemplateEdgeV1) DeleteProjectTemplateByName(ctx context.Context, hashedName string) (err error) {
+	logger.Debugf("[DeleteProjectTemplateByName] Received objectToDelete: %s", hashedName)
+	var (
+		retryCount int
+		result     *baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate
+	)
+
+	retryCount = 0
+	for {
+		result, err = group.client.baseClient.
+			ProjecttemplateEdgeV1().
+			ProjectTemplates().Get(ctx, hashedName, metav1.GetOptions{})
+		if err != nil {
+			logger.Errorf("[DeleteProjectTemplateByName] Failed to get ProjectTemplates: %+v", err)
+			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				logger.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
+				if retryCount == maxRetryCount {
+					logger.Errorf("Max retry exceed on get ProjectTemplates: %s", hashedName)
+					return err
+				}
+				retryCount += 1
+				time.Sleep(sleepTime * time.Second)
+			} else if customerrors.Is(err, context.Canceled) {
+				logger.Errorf("[DeleteProjectTemplateByName] context canceled: %s", hashedName)
+				return context.Canceled
+			} else if errors.IsNotFound(err) {
+				logger.Errorf("[DeleteProjectTemplateByName] Object: %s not found", hashedName)
+				break
+			} else {
+				logger.Errorf("[DeleteProjectTemplateByName] Object: %s unexpected error: %+v", hashedName, err)
+				return err
+			}
+		} else {
+			break
+		}
+	}
+
+	if result == nil {
+		return err
+	}
+
+	retryCount = 0
+	for {
+		err = group.client.baseClient.
+			ProjecttemplateEdgeV1().
+			ProjectTemplates().Delete(ctx, hashedName, metav1.DeleteOptions{})
+		if err != nil {
+			logger.Errorf("[DeleteProjectTemplateByName] failed to delete ProjectTemplates: %+v", err)
+			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				logger.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, hashedName, err)
+				if retryCount == maxRetryCount {
+					logger.Errorf("Max retry exceed on delete ProjectTemplates: %s", hashedName)
+					return err
+				}
+				retryCount += 1
+				time.Sleep(sleepTime * time.Second)
+			} else if customerrors.Is(err, context.Canceled) {
+				logger.Errorf("[DeleteProjectTemplateByName]: context canceled: %s", hashedName)
+				return context.Canceled
+			} else if errors.IsNotFound(err) {
+				logger.Errorf("[DeleteProjectTemplateByName] Object: %s not found", hashedName)
+				break
+			} else {
+				logger.Errorf("[DeleteProjectTemplateByName] Object: %s unexpected error: %+v", hashedName, err)
+				return err
+			}
+		} else {
+			if s, ok := subscriptionMap.Load("projecttemplates.projecttemplate.edge-orchestrator.intel.com"); ok {
+				s.(subscription).WriteCacheObjects.Delete(hashedName)
+			}
+			break
+		}
+	}
+	// Get Parent Node and check if gvk present before patch
+
+	logger.Debugf("[DeleteProjectTemplateByName] Get parent details for object: %s", hashedName)
+	// var patch Patch
+	parents := result.GetLabels()
+	if parents == nil {
+		parents = make(map[string]string)
+	}
+	parentName, ok := parents["configs.config.edge-orchestrator.intel.com"]
+	if !ok {
+		parentName = helper.DefaultKey
+	}
+	if result.GetLabels() != nil {
+		if parents[common.IsNameHashedLabel] == "true" {
+			parentName = helper.GetHashedName("configs.config.edge-orchestrator.intel.com", parents, parentName)
+		}
+	} else {
+		parentName = helper.GetHashedName("configs.config.edge-orchestrator.intel.com", parents, parentName)
+	}
+	RemoveChild("configs.config.edge-orchestrator.intel.com", parentName, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", hashedName)
+
+	return nil
+}
+
+// CreateProjectTemplateByName creates object in the database without hashing the name.
+// Use it directly ONLY when objToCreate.Name is hashed name of the object.
+func (group *ProjecttemplateEdgeV1) CreateProjectTemplateByName(ctx context.Context,
+	objToCreate *baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate) (*ProjecttemplateProjectTemplate, error) {
+	logger.Debugf("[CreateProjectTemplateByName] Received objToCreate: %s", objToCreate.GetName())
+	if objToCreate.GetLabels() == nil {
+		objToCreate.Labels = make(map[string]string)
+	}
+	if _, ok := objToCreate.Labels[common.DisplayNameLabel]; !ok {
+		objToCreate.Labels[common.DisplayNameLabel] = objToCreate.GetName()
+	}
+
+	var (
+		retryCount int
+		result     *baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate
+		err        error
+	)
+	retryCount = 0
+	for {
+		result, err = group.client.baseClient.
+			ProjecttemplateEdgeV1().
+			ProjectTemplates().Create(ctx, objToCreate, metav1.CreateOptions{})
+		if err != nil {
+			logger.Errorf("[CreateProjectTemplateByName] Failed to create ProjectTemplate: %s, error: %+v", objToCreate.GetName(), err)
+			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				logger.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToCreate.GetName(), err)
+				if retryCount == maxRetryCount {
+					logger.Errorf("Max retry exceed on create ProjectTemplate: %s", objToCreate.GetName())
+					return nil, err
+				}
+				retryCount += 1
+				time.Sleep(sleepTime * time.Second)
+			} else if customerrors.Is(err, context.Canceled) {
+				logger.Errorf("[CreateProjectTemplateByName] context canceled while creating ProjectTemplate: %s", objToCreate.GetName())
+				return nil, context.Canceled
+			} else if errors.IsAlreadyExists(err) {
+				logger.Debugf("[CreateProjectTemplateByName] ProjectTemplate: %s already exists, error: %+v", objToCreate.GetName(), err)
+				result, err = group.client.baseClient.ProjecttemplateEdgeV1().ProjectTemplates().Get(ctx, objToCreate.GetName(), metav1.GetOptions{})
+				if err != nil {
+					logger.Fatalf("[CreateProjectTemplateByName] Unable to Get ProjectTemplate %s after it was flagged as already exists, error: %+v", objToCreate.GetName(), err)
+				}
+				break
+			} else {
+				logger.Errorf("[CreateProjectTemplateByName] found unexpected error while creating ProjectTemplate: %s, error: %+v", objToCreate.GetName(), err)
+				return nil, err
+			}
+		} else {
+			logger.Debugf("[CreateProjectTemplateByName] ProjectTemplate: %s created successfully", objToCreate.GetName())
+			if s, ok := subscriptionMap.Load("projecttemplates.projecttemplate.edge-orchestrator.intel.com"); ok {
+				logger.Debugf("[CreateProjectTemplateByName] ProjectTemplate: %s stored in wr-cache", objToCreate.GetName())
+				s.(subscription).WriteCacheObjects.Store(objToCreate.GetName(), result)
+			}
+			break
+		}
+	}
+
+	parentName, ok := objToCreate.GetLabels()["configs.config.edge-orchestrator.intel.com"]
+	if !ok {
+		parentName = helper.DefaultKey
+	}
+	parentHashedName := helper.GetHashedName("configs.config.edge-orchestrator.intel.com", objToCreate.GetLabels(), parentName)
+
+	AddChild("configs.config.edge-orchestrator.intel.com", parentHashedName, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", objToCreate.Name)
+
+	logger.Debugf("[CreateProjectTemplateByName] Executed Successfully: %s", objToCreate.GetName())
+	return &ProjecttemplateProjectTemplate{
+		client:          group.client,
+		ProjectTemplate: result,
+	}, nil
+}
+
+// UpdateProjectTemplateByName updates object stored in the database under the hashedName which is a hash of
+// display name and parents names.
+func (group *ProjecttemplateEdgeV1) UpdateProjectTemplateByName(ctx context.Context,
+	objToUpdate *baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate) (*ProjecttemplateProjectTemplate, error) {
+	logger.Debugf("[UpdateProjectTemplateByName] Received objToUpdate: %s", objToUpdate.GetName())
+
+	var patch Patch
+
+	if objToUpdate.Annotations != nil || objToUpdate.Labels != nil {
+		current, err := group.client.Projecttemplate().GetProjectTemplateByName(ctx, objToUpdate.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if objToUpdate.Annotations != nil {
+			if current.Annotations[ownershipAnnotation] != "" {
+				objToUpdate.Annotations[ownershipAnnotation] = current.Annotations[ownershipAnnotation]
+			}
+			patch = append(patch, PatchOp{
+				Op:    "replace",
+				Path:  "/metadata/annotations",
+				Value: objToUpdate.Annotations,
+			})
+		}
+
+		if objToUpdate.Labels != nil {
+			parentsList := helper.GetCRDParentsMap()["projecttemplates.projecttemplate.edge-orchestrator.intel.com"]
+			for _, k := range parentsList {
+				objToUpdate.Labels[k] = current.Labels[k]
+			}
+			objToUpdate.Labels[common.IsNameHashedLabel] = current.Labels[common.IsNameHashedLabel]
+			objToUpdate.Labels[common.DisplayNameLabel] = current.Labels[common.DisplayNameLabel]
+			patch = append(patch, PatchOp{
+				Op:    "replace",
+				Path:  "/metadata/labels",
+				Value: objToUpdate.Labels,
+			})
+		}
+		patch = append(patch, PatchOp{
+			Op:    "replace",
+			Path:  "/metadata/finalizers",
+			Value: objToUpdate.Finalizers,
+		})
+	}
+
+	var rt reflect.Type
+
+	rt = reflect.TypeOf(objToUpdate.Spec.Description)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.Description).IsNil() {
+			patchValueDescription := objToUpdate.Spec.Description
+			patchOpDescription := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/description",
+				Value: patchValueDescription,
+			}
+			patch = append(patch, patchOpDescription)
+		}
+	} else {
+		patchValueDescription := objToUpdate.Spec.Description
+		patchOpDescription := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/description",
+			Value: patchValueDescription,
+		}
+		patch = append(patch, patchOpDescription)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.Networks)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.Networks).IsNil() {
+			patchValueNetworks := objToUpdate.Spec.Networks
+			patchOpNetworks := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/networks",
+				Value: patchValueNetworks,
+			}
+			patch = append(patch, patchOpNetworks)
+		}
+	} else {
+		patchValueNetworks := objToUpdate.Spec.Networks
+		patchOpNetworks := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/networks",
+			Value: patchValueNetworks,
+		}
+		patch = append(patch, patchOpNetworks)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.Labels)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.Labels).IsNil() {
+			patchValueLabels := objToUpdate.Spec.Labels
+			patchOpLabels := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/labels",
+				Value: patchValueLabels,
+			}
+			patch = append(patch, patchOpLabels)
+		}
+	} else {
+		patchValueLabels := objToUpdate.Spec.Labels
+		patchOpLabels := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/labels",
+			Value: patchValueLabels,
+		}
+		patch = append(patch, patchOpLabels)
+	}
+
+	rt = reflect.TypeOf(objToUpdate.Spec.WatcherParameters)
+	if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+		if !reflect.ValueOf(objToUpdate.Spec.WatcherParameters).IsNil() {
+			patchValueWatcherParameters := objToUpdate.Spec.WatcherParameters
+			patchOpWatcherParameters := PatchOp{
+				Op:    "replace",
+				Path:  "/spec/watcherParameters",
+				Value: patchValueWatcherParameters,
+			}
+			patch = append(patch, patchOpWatcherParameters)
+		}
+	} else {
+		patchValueWatcherParameters := objToUpdate.Spec.WatcherParameters
+		patchOpWatcherParameters := PatchOp{
+			Op:    "replace",
+			Path:  "/spec/watcherParameters",
+			Value: patchValueWatcherParameters,
+		}
+		patch = append(patch, patchOpWatcherParameters)
+	}
+
+	marshaled, err := patch.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result *baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate
+	)
+	newCtx := context.TODO()
+	retryCount := 0
+	for {
+		result, err = group.client.baseClient.
+			ProjecttemplateEdgeV1().
+			ProjectTemplates().Patch(newCtx, objToUpdate.GetName(), types.JSONPatchType, marshaled, metav1.PatchOptions{}, "")
+		if err != nil {
+			logger.Errorf("[UpdateProjectTemplateByName] Failed to patch ProjectTemplate %s with error: %+v", objToUpdate.GetName(), err)
+			if errors.IsTimeout(err) || customerrors.Is(err, context.DeadlineExceeded) {
+				logger.Debugf("[Retry count: (%d) obj: %s ] %+v", retryCount, objToUpdate.GetName(), err)
+				if retryCount == maxRetryCount {
+					logger.Errorf("Max retry exceed on patching: %s", objToUpdate.GetName())
+					logger.Debugf("Trigger ProjectTemplate Delete: %s", objToUpdate.GetName())
+					delErr := group.DeleteProjectTemplateByName(newCtx, objToUpdate.GetName())
+					if delErr != nil {
+						logger.Debugf("Error occur while deleting ProjectTemplate: %s", objToUpdate.GetName())
+						return nil, delErr
+					}
+					logger.Debugf("ProjectTemplate deleted: %s", objToUpdate.GetName())
+					return nil, err
+				}
+				retryCount += 1
+				time.Sleep(sleepTime * time.Second)
+			} else if customerrors.Is(err, context.Canceled) {
+				logger.Errorf("[UpdateProjectTemplateByName]: context canceled: %s", objToUpdate.GetName())
+				return nil, context.Canceled
+			} else {
+				logger.Errorf("[UpdateProjectTemplateByName] Object: %s unexpected error: %+v", objToUpdate.GetName(), err)
+				logger.Debugf("Trigger ProjectTemplate Delete: %s", objToUpdate.GetName())
+				delErr := group.DeleteProjectTemplateByName(newCtx, objToUpdate.GetName())
+				if delErr != nil {
+					logger.Debugf("Error occur while deleting ProjectTemplate: %+v", objToUpdate.GetName())
+					return nil, delErr
+				}
+				logger.Debugf("ProjectTemplate Deleted: %s", objToUpdate.GetName())
+				return nil, err
+			}
+		} else {
+			logger.Debugf("[UpdateProjectTemplateByName] Patch ProjectTemplate Success :%s", objToUpdate.GetName())
+			if s, ok := subscriptionMap.Load("projecttemplates.projecttemplate.edge-orchestrator.intel.com"); ok {
+				logger.Debugf("[UpdateProjectTemplateByName] %s stored in wr-cache", objToUpdate.GetName())
+				s.(subscription).WriteCacheObjects.Store(objToUpdate.GetName(), result)
+			}
+			break
+		}
+	}
+	logger.Debugf("[UpdateProjectTemplateByName] Executed Successfully %s", objToUpdate.GetName())
+	return &ProjecttemplateProjectTemplate{
+		client:          group.client,
+		ProjectTemplate: result,
+	}, nil
+}
+
+// ListProjectTemplates returns slice of all existing objects of this type. Selectors can be provided in opts parameter.
+func (group *ProjecttemplateEdgeV1) ListProjectTemplates(ctx context.Context,
+	opts metav1.ListOptions) (result []*ProjecttemplateProjectTemplate, err error) {
+	key := "projecttemplates.projecttemplate.edge-orchestrator.intel.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		items := s.(subscription).informer.GetStore().List()
+		result = make([]*ProjecttemplateProjectTemplate, len(items))
+		for k, v := range items {
+			item, _ := v.(*baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate)
+			result[k] = &ProjecttemplateProjectTemplate{
+				client:          group.client,
+				ProjectTemplate: item,
+			}
+		}
+	} else {
+		list, err := group.client.baseClient.ProjecttemplateEdgeV1().
+			ProjectTemplates().List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = make([]*ProjecttemplateProjectTemplate, len(list.Items))
+		for k, v := range list.Items {
+			item := v
+			result[k] = &ProjecttemplateProjectTemplate{
+				client:          group.client,
+				ProjectTemplate: &item,
+			}
+		}
+	}
+	return
+}
+
+type ProjecttemplateProjectTemplate struct {
+	client *Clientset
+	*baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate
+}
+
+// Delete removes obj and all it's children from the database.
+func (obj *ProjecttemplateProjectTemplate) Delete(ctx context.Context) error {
+	err := obj.client.Projecttemplate().DeleteProjectTemplateByName(ctx, obj.GetName())
+	if err != nil {
+		return err
+	}
+	obj.ProjectTemplate = nil
+	return nil
+}
+
+// Update updates spec of object in database. Children and Link can not be updated using this function.
+func (obj *ProjecttemplateProjectTemplate) Update(ctx context.Context) error {
+	result, err := obj.client.Projecttemplate().UpdateProjectTemplateByName(ctx, obj.ProjectTemplate)
+	if err != nil {
+		return err
+	}
+	obj.ProjectTemplate = result.ProjectTemplate
+	return nil
+}
+
+func (obj *ProjecttemplateProjectTemplate) GetParent(ctx context.Context) (result *ConfigConfig, err error) {
+	hashedName := helper.GetHashedName("configs.config.edge-orchestrator.intel.com", obj.Labels, obj.Labels["configs.config.edge-orchestrator.intel.com"])
+	logger.Debugf("[GetParent] Get parent of ProjecttemplateProjectTemplate name %s [labels %#v] of parent type configs.config.edge-orchestrator.intel.com and name %s", obj.Name, obj.Labels, hashedName)
+	return obj.client.Config().GetConfigByName(ctx, hashedName)
+}
+
+type projecttemplateProjecttemplateEdgeV1Chainer struct {
+	client       *Clientset
+	name         string
+	parentLabels map[string]string
+}
+
+func (c *projecttemplateProjecttemplateEdgeV1Chainer) Subscribe() {
+	key := "projecttemplates.projecttemplate.edge-orchestrator.intel.com"
+	if _, ok := subscriptionMap.Load(key); !ok {
+		informer := informerprojecttemplateedgeorchestratorintelcomv1.NewProjectTemplateInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		subscribe(key, informer)
+
+		c.RegisterAddCallback(c.addCallback)
+		c.RegisterDeleteCallback(c.deleteCallback)
+
+	}
+}
+
+func (c *projecttemplateProjecttemplateEdgeV1Chainer) Unsubscribe() {
+	key := "projecttemplates.projecttemplate.edge-orchestrator.intel.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		close(s.(subscription).stop)
+		subscriptionMap.Delete(key)
+	}
+}
+
+func (c *projecttemplateProjecttemplateEdgeV1Chainer) IsSubscribed() bool {
+	key := "projecttemplates.projecttemplate.edge-orchestrator.intel.com"
+	_, ok := subscriptionMap.Load(key)
+	return ok
+}
+
+func (c *projecttemplateProjecttemplateEdgeV1Chainer) addCallback(obj *ProjecttemplateProjectTemplate) {
+	parentDisplayName := helper.DefaultKey
+	if value, ok := obj.Labels["configs.config.edge-orchestrator.intel.com"]; ok {
+		parentDisplayName = value
+	}
+	parentHashName := helper.GetHashedName("configs.config.edge-orchestrator.intel.com", obj.Labels, parentDisplayName)
+	logger.Debugf("[addCallback] received for projecttemplates.projecttemplate.edge-orchestrator.intel.com name %s displayName %s parent configs.config.edge-orchestrator.intel.com name %s parentDisplayName %s", obj.Name, obj.DisplayName(), parentHashName, parentDisplayName)
+
+	AddChild("configs.config.edge-orchestrator.intel.com", parentHashName, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", obj.Name)
+}
+
+func (c *projecttemplateProjecttemplateEdgeV1Chainer) deleteCallback(obj *ProjecttemplateProjectTemplate) {
+	parentDisplayName := helper.DefaultKey
+	if value, ok := obj.Labels["configs.config.edge-orchestrator.intel.com"]; ok {
+		parentDisplayName = value
+	}
+	parentHashName := helper.GetHashedName("configs.config.edge-orchestrator.intel.com", obj.Labels, parentDisplayName)
+	logger.Debugf("[deleteCallback] received for projecttemplates.projecttemplate.edge-orchestrator.intel.com name %s displayName %s parent configs.config.edge-orchestrator.intel.com name %s parentDisplayName %s", obj.Name, obj.DisplayName(), parentHashName, parentDisplayName)
+
+	RemoveChild("configs.config.edge-orchestrator.intel.com", parentHashName, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", obj.Name)
+}
+
+func (c *projecttemplateProjecttemplateEdgeV1Chainer) RegisterEventHandler(addCB func(obj *ProjecttemplateProjectTemplate), updateCB func(oldObj, newObj *ProjecttemplateProjectTemplate), deleteCB func(obj *ProjecttemplateProjectTemplate)) (cache.ResourceEventHandlerRegistration, error) {
+	fmt.Println("RegisterEventHandler for ProjecttemplateProjectTemplate")
+	var (
+		registrationId cache.ResourceEventHandlerRegistration
+		err            error
+		informer       cache.SharedIndexInformer
+	)
+	key := "projecttemplates.projecttemplate.edge-orchestrator.intel.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		fmt.Println("Informer exists for ProjecttemplateProjectTemplate")
+		sub := s.(subscription)
+		informer = sub.informer
+	} else {
+		fmt.Println("Informer doesn't exists for ProjecttemplateProjectTemplate, so creating a new one")
+		informer = informerprojecttemplateedgeorchestratorintelcomv1.NewProjectTemplateInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		subscribe(key, informer)
+
+		c.RegisterAddCallback(c.addCallback)
+		c.RegisterDeleteCallback(c.deleteCallback)
+
+	}
+	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			nc := &ProjecttemplateProjectTemplate{
+				client:          c.client,
+				ProjectTemplate: obj.(*baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate),
+			}
+			logger.Debugf("[RegisterEventHandler AddFunc] Got Add event for projecttemplates.projecttemplate.edge-orchestrator.intel.com name %s", nc.ProjectTemplate.Name)
+
+			var parent *ConfigConfig
+			for i := 0; i < 600; i++ {
+				// Check if parent exists
+				p, err := nc.GetParent(context.TODO())
+				if err != nil || p == nil {
+					time.Sleep(500 * time.Millisecond)
+					continue
+				}
+				parent = p
+				break
+			}
+			if parent == nil {
+				hashedName := helper.GetHashedName("configs.config.edge-orchestrator.intel.com", nc.Labels, nc.Labels["configs.config.edge-orchestrator.intel.com"])
+				parent, err = c.client.Config().ForceReadConfigByName(context.TODO(), hashedName)
+				if err != nil {
+					if errors.IsNotFound(err) {
+						return
+					}
+					panic("error occurred while fetching parent " + err.Error())
+				}
+				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+			}
+			if !IsChildExists("configs.config.edge-orchestrator.intel.com", parent.Name, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", nc.Name) {
+				AddChild("configs.config.edge-orchestrator.intel.com", parent.Name, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", nc.Name)
+			}
+
+			addCB(nc)
+		},
+
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldData := &ProjecttemplateProjectTemplate{
+				client:          c.client,
+				ProjectTemplate: oldObj.(*baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate),
+			}
+			newData := &ProjecttemplateProjectTemplate{
+				client:          c.client,
+				ProjectTemplate: newObj.(*baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate),
+			}
+			logger.Debugf("[RegisterEventHandler UpdateFunc] Got Update event for projecttemplates.projecttemplate.edge-orchestrator.intel.com name %s old version %s new version %s", oldData.ProjectTemplate.Name, oldData.ResourceVersion, newData.ResourceVersion)
+			updateCB(oldData, newData)
+		},
+
+		DeleteFunc: func(obj interface{}) {
+			nc := &ProjecttemplateProjectTemplate{
+				client:          c.client,
+				ProjectTemplate: obj.(*baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate),
+			}
+			logger.Debugf("[RegisterEventHandler DeleteFunc] Got Delete event for projecttemplates.projecttemplate.edge-orchestrator.intel.com name %s", nc.ProjectTemplate.Name)
+
+			var parent *ConfigConfig
+			for i := 0; i < 600; i++ {
+				// Check if parent exists
+				p, err := nc.GetParent(context.TODO())
+				if errors.IsNotFound(err) {
+					break
+				} else if err != nil || p == nil {
+					time.Sleep(500 * time.Millisecond)
+					continue
+				}
+				parent = p
+				break
+			}
+			if parent == nil {
+				hashedName := helper.GetHashedName("configs.config.edge-orchestrator.intel.com", nc.Labels, nc.Labels["configs.config.edge-orchestrator.intel.com"])
+				parent, err = c.client.Config().ForceReadConfigByName(context.TODO(), hashedName)
+				if err != nil {
+					if errors.IsNotFound(err) {
+						return
+					}
+					panic("error occurred while fetching parent " + err.Error())
+				}
+				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+			}
+
+			if IsChildExists("configs.config.edge-orchestrator.intel.com", parent.Name, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", nc.Name) {
+				RemoveChild("configs.config.edge-orchestrator.intel.com", parent.Name, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", nc.Name)
+			}
+
+			deleteCB(nc)
+		},
+	})
+	return registrationId, err
+}
+
+func (c *projecttemplateProjecttemplateEdgeV1Chainer) RegisterAddCallback(cbfn func(obj *ProjecttemplateProjectTemplate)) (cache.ResourceEventHandlerRegistration, error) {
+	logger.Debugf("[RegisterAddCallback] Received for ProjecttemplateProjectTemplate")
+	var (
+		registrationId cache.ResourceEventHandlerRegistration
+		err            error
+		informer       cache.SharedIndexInformer
+	)
+
+	key := "projecttemplates.projecttemplate.edge-orchestrator.intel.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		fmt.Println("Informer exists for ProjecttemplateProjectTemplate")
+		sub := s.(subscription)
+		informer = sub.informer
+	} else {
+		fmt.Println("Informer doesn't exists for ProjecttemplateProjectTemplate, so creating a new one")
+		informer = informerprojecttemplateedgeorchestratorintelcomv1.NewProjectTemplateInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		subscribe(key, informer)
+
+		c.RegisterAddCallback(c.addCallback)
+		c.RegisterDeleteCallback(c.deleteCallback)
+
+	}
+
+	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			nc := &ProjecttemplateProjectTemplate{
+				client:          c.client,
+				ProjectTemplate: obj.(*baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate),
+			}
+			logger.Debugf("[RegisterAddCallback] Got Add event for projecttemplates.projecttemplate.edge-orchestrator.intel.com name %s", nc.ProjectTemplate.Name)
+
+			var parent *ConfigConfig
+			for i := 0; i < 600; i++ {
+				// Check if parent exists
+				p, err := nc.GetParent(context.TODO())
+				if err != nil || p == nil {
+					time.Sleep(500 * time.Millisecond)
+					continue
+				}
+				parent = p
+				break
+			}
+			if parent == nil {
+				hashedName := helper.GetHashedName("configs.config.edge-orchestrator.intel.com", nc.Labels, nc.Labels["configs.config.edge-orchestrator.intel.com"])
+				parent, err = c.client.Config().ForceReadConfigByName(context.TODO(), hashedName)
+				if err != nil {
+					if errors.IsNotFound(err) {
+						return
+					}
+
+					panic("error occurred while fetching parent " + err.Error())
+				}
+				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+			}
+
+			if !IsChildExists("configs.config.edge-orchestrator.intel.com", parent.Name, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", nc.Name) {
+				AddChild("configs.config.edge-orchestrator.intel.com", parent.Name, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", nc.Name)
+			}
+
+			cbfn(nc)
+		},
+	})
+
+	return registrationId, err
+}
+
+func (c *projecttemplateProjecttemplateEdgeV1Chainer) RegisterUpdateCallback(cbfn func(oldObj, newObj *ProjecttemplateProjectTemplate)) (cache.ResourceEventHandlerRegistration, error) {
+	logger.Debugf("[RegisterUpdateCallback] Received for ProjecttemplateProjectTemplate")
+	var (
+		registrationId cache.ResourceEventHandlerRegistration
+		err            error
+		informer       cache.SharedIndexInformer
+	)
+
+	key := "projecttemplates.projecttemplate.edge-orchestrator.intel.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		fmt.Println("Informer exists for ProjecttemplateProjectTemplate")
+		sub := s.(subscription)
+		informer = sub.informer
+	} else {
+		fmt.Println("Informer doesn't exists for ProjecttemplateProjectTemplate, so creating a new one")
+		informer = informerprojecttemplateedgeorchestratorintelcomv1.NewProjectTemplateInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		subscribe(key, informer)
+
+		c.RegisterAddCallback(c.addCallback)
+		c.RegisterDeleteCallback(c.deleteCallback)
+
+	}
+
+	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldData := &ProjecttemplateProjectTemplate{
+				client:          c.client,
+				ProjectTemplate: oldObj.(*baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate),
+			}
+			newData := &ProjecttemplateProjectTemplate{
+				client:          c.client,
+				ProjectTemplate: newObj.(*baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate),
+			}
+			logger.Debugf("[RegisterUpdateCallback] Got Update event for projecttemplates.projecttemplate.edge-orchestrator.intel.com name %s old version %s new version %s", oldData.ProjectTemplate.Name, oldData.ResourceVersion, newData.ResourceVersion)
+			cbfn(oldData, newData)
+		},
+	})
+
+	return registrationId, err
+}
+
+func (c *projecttemplateProjecttemplateEdgeV1Chainer) RegisterDeleteCallback(cbfn func(obj *ProjecttemplateProjectTemplate)) (cache.ResourceEventHandlerRegistration, error) {
+	logger.Debugf("[RegisterDeleteCallback] Received for ProjecttemplateProjectTemplate")
+	var (
+		registrationId cache.ResourceEventHandlerRegistration
+		err            error
+		informer       cache.SharedIndexInformer
+	)
+
+	key := "projecttemplates.projecttemplate.edge-orchestrator.intel.com"
+	if s, ok := subscriptionMap.Load(key); ok {
+		fmt.Println("Informer exists for ProjecttemplateProjectTemplate")
+		sub := s.(subscription)
+		informer = sub.informer
+	} else {
+		fmt.Println("Informer doesn't exists for ProjecttemplateProjectTemplate, so creating a new one")
+		informer = informerprojecttemplateedgeorchestratorintelcomv1.NewProjectTemplateInformer(c.client.baseClient, informerResyncPeriod*time.Second, cache.Indexers{})
+		subscribe(key, informer)
+
+		c.RegisterAddCallback(c.addCallback)
+		c.RegisterDeleteCallback(c.deleteCallback)
+
+	}
+
+	registrationId, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: func(obj interface{}) {
+			nc := &ProjecttemplateProjectTemplate{
+				client:          c.client,
+				ProjectTemplate: obj.(*baseprojecttemplateedgeorchestratorintelcomv1.ProjectTemplate),
+			}
+			logger.Debugf("[RegisterDeleteCallback] Got Delete event for projecttemplates.projecttemplate.edge-orchestrator.intel.com name %s", nc.ProjectTemplate.Name)
+
+			var parent *ConfigConfig
+			for i := 0; i < 600; i++ {
+				// Check if parent exists
+				p, err := nc.GetParent(context.TODO())
+				if errors.IsNotFound(err) {
+					break
+				} else if err != nil || p == nil {
+					time.Sleep(500 * time.Millisecond)
+					continue
+				}
+				parent = p
+				break
+			}
+
+			if parent == nil {
+				hashedName := helper.GetHashedName("configs.config.edge-orchestrator.intel.com", nc.Labels, nc.Labels["configs.config.edge-orchestrator.intel.com"])
+				parent, err = c.client.Config().ForceReadConfigByName(context.TODO(), hashedName)
+				if err != nil {
+					if errors.IsNotFound(err) {
+						return
+					}
+
+					panic("error occurred while fetching parent " + err.Error())
+				}
+				panic(fmt.Sprintf("parent found (event loop is stalled) " + nc.DisplayName()))
+			}
+			if IsChildExists("configs.config.edge-orchestrator.intel.com", parent.Name, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", nc.Name) {
+				RemoveChild("configs.config.edge-orchestrator.intel.com", parent.Name, "projecttemplates.projecttemplate.edge-orchestrator.intel.com", nc.Name)
+			}
+
+			cbfn(nc)
+		},
+	})
+
+	return registrationId, err
+}
+
 func (group *RuntimeEdgeV1) GetRuntimeChildrenMap() map[string]baseruntimeedgeorchestratorintelcomv1.Child {
 	return map[string]baseruntimeedgeorchestratorintelcomv1.Child{}
 }
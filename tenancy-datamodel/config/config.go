@@ -22,6 +22,7 @@ import (
 	"github.com/open-edge-platform/orch-utils/tenancy-datamodel/config/apimappingconfig"
 	"github.com/open-edge-platform/orch-utils/tenancy-datamodel/config/org"
 	"github.com/open-edge-platform/orch-utils/tenancy-datamodel/config/orgwatcher"
+	"github.com/open-edge-platform/orch-utils/tenancy-datamodel/config/projecttemplate"
 	"github.com/open-edge-platform/orch-utils/tenancy-datamodel/config/projectwatcher"
 	"github.com/open-edge-platform/orch-utils/tenancy-datamodel/nexus/base/nexus"
 )
@@ -41,4 +42,7 @@ type Config struct {
 
 	// Components to be notified of project create/delete.
 	ProjectWatchers projectwatcher.ProjectWatcher `nexus:"children"`
+
+	// Defaults applied to the projects created from them.
+	ProjectTemplates projecttemplate.ProjectTemplate `nexus:"children"`
 }
@@ -23,9 +23,27 @@ import (
 	"github.com/open-edge-platform/orch-utils/tenancy-datamodel/nexus/base/nexus"
 )
 
+// REST API to CRUD folder.
+var FolderRestAPISpec = nexus.RestAPISpec{
+	Uris: []nexus.RestURIs{
+		{
+			Uri:     "/v1/folders/{folder.Folder}",
+			Methods: nexus.DefaultHTTPMethodsResponses,
+		},
+		{
+			Uri:     "/v1/folders",
+			Methods: nexus.HTTPListResponse,
+		},
+	},
+}
+
+// nexus-rest-api-gen:FolderRestAPISpec
 type Folder struct {
 	nexus.Node
 
+	// Description of folder.
+	Description string `json:"description,omitempty"`
+
 	// Projects associated with this Folder.
 	Projects project.Project `nexus:"children"`
 }
@@ -36,8 +36,7 @@ var NetworkRestAPISpec = nexus.RestAPISpec{
 	},
 }
 
-// TO-DO: Revisit nexus-deferred-delete after the implementation of the controller is further along and
-//        we are able to implement delete logic.
+// Networks are reconciled by tenancy-manager. They have nothing to tear down, so their delete is not deferred.
 
 // nexus-rest-api-gen:NetworkRestAPISpec
 // nexus-deferred-delete: false
@@ -56,9 +55,19 @@ type NetworkType string
 
 //nolint:revive // Per requirement.
 type NetworkStatus struct {
+	// CurrentState is Pending, Ready or Error.
 	CurrentState string
+	// Message explains a Pending or Error state.
+	Message string `json:"message,omitempty"`
 }
 
 const (
 	AppplicationMesh NetworkType = "application-mesh"
 )
+
+// States of a Network.
+const (
+	NetworkStatePending = "Pending"
+	NetworkStateReady   = "Ready"
+	NetworkStateError   = "Error"
+)
@@ -54,6 +54,18 @@ type Project struct {
 	// Description of project.
 	Description string
 
+	// Archived makes the project read-only without deleting it.
+	Archived bool `json:"archived,omitempty"`
+
+	// Labels are arbitrary key/value metadata of the project, e.g. a cost center, a region or a tier.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Owners of the project, e.g. their email addresses.
+	Owners []string `json:"owners,omitempty"`
+
+	// Template names the ProjectTemplate expanded once the project is created. It cannot be changed afterwards.
+	Template string `json:"template,omitempty"`
+
 	// Networks associated with this org.
 	Networks network.Network `nexus:"children"`
 
@@ -74,4 +86,25 @@ type ProjectStatus struct {
 
 	// Unigue ID assigned to this project.
 	UID string
+
+	// Conditions holds the progress of each ProjectWatcher on the last request.
+	Conditions []WatcherCondition `json:"conditions,omitempty"`
+}
+
+// WatcherCondition is the state of one ProjectWatcher on the last request, mirrored from its ProjectActiveWatcher.
+type WatcherCondition struct {
+	// Name of the ProjectWatcher.
+	Watcher string
+
+	// State reported by the watcher (e.g., error, in progress, idle).
+	State TenancyRequestStatus
+
+	// Message reported by the watcher.
+	Message string
+
+	// Timestamp of when State last changed.
+	LastTransitionTime uint64
+
+	// Number of times the watcher was asked to process the last request.
+	Attempts int32
 }
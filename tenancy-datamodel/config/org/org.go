@@ -54,6 +54,18 @@ type Org struct {
 	// Description of org.
 	Description string
 
+	// Quota limits the resources that can be created under this org.
+	Quota Quota `json:"quota,omitempty"`
+
+	// Suspended holds the org and its projects without deleting them, e.g. for a billing hold or a security incident.
+	Suspended bool `json:"suspended,omitempty"`
+
+	// Labels are arbitrary key/value metadata of the org, e.g. a cost center, a region or a tier.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Owners of the org, e.g. their email addresses.
+	Owners []string `json:"owners,omitempty"`
+
 	// Folders associated with this org.
 	Folders folder.Folder `nexus:"children"`
 
@@ -74,4 +86,52 @@ type OrgStatus struct {
 
 	// Unigue ID assigned to this org.
 	UID string
+
+	// Conditions holds the progress of each OrgWatcher on the last request.
+	Conditions []WatcherCondition `json:"conditions,omitempty"`
+
+	// Usage is the consumption of this org's Quota, maintained by tenancy-manager.
+	Usage QuotaUsage `json:"usage,omitempty"`
+}
+
+// Quota bounds the resources of an org. A zero limit means unlimited.
+type Quota struct {
+	// Maximum number of projects in the org.
+	MaxProjects int32
+
+	// Maximum number of folders in the org.
+	MaxFolders int32
+
+	// Maximum number of networks in each project of the org.
+	MaxNetworksPerProject int32
+}
+
+// QuotaUsage is the number of resources currently created under an org.
+type QuotaUsage struct {
+	// Number of projects in the org.
+	Projects int32
+
+	// Number of folders in the org.
+	Folders int32
+
+	// Number of networks across all projects of the org.
+	Networks int32
+}
+
+// WatcherCondition is the state of one OrgWatcher on the last request, mirrored from its OrgActiveWatcher.
+type WatcherCondition struct {
+	// Name of the OrgWatcher.
+	Watcher string
+
+	// State reported by the watcher (e.g., error, in progress, idle).
+	State TenancyRequestStatus
+
+	// Message reported by the watcher.
+	Message string
+
+	// Timestamp of when State last changed.
+	LastTransitionTime uint64
+
+	// Number of times the watcher was asked to process the last request.
+	Attempts int32
 }
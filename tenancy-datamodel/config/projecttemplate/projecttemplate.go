@@ -0,0 +1,55 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package projecttemplate
+
+import (
+	"github.com/open-edge-platform/orch-utils/tenancy-datamodel/nexus/base/nexus"
+)
+
+// Defaults applied to a Project that names the template on create. A template is expanded once, when the
+// Project is created; later changes to the template do not modify existing Projects.
+type ProjectTemplate struct {
+	nexus.Node
+
+	// Description of the template.
+	Description string `json:"description,omitempty"`
+
+	// Networks created under the Project, unless the Project already has a network of the same name.
+	Networks []NetworkTemplate `json:"networks,omitempty"`
+
+	// Labels added to the Project. The labels set on the Project itself take precedence.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// WatcherParameters are handed to the ProjectWatchers along with the Project.
+	WatcherParameters []WatcherParameters `json:"watcherParameters,omitempty"`
+}
+
+// NetworkTemplate is a Network created under the Project.
+type NetworkTemplate struct {
+	Name        string
+	Type        string
+	Description string `json:"description,omitempty"`
+}
+
+// WatcherParameters are the parameters of one ProjectWatcher.
+type WatcherParameters struct {
+	// Name of the ProjectWatcher.
+	Watcher string
+
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
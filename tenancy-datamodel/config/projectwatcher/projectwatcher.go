@@ -25,4 +25,38 @@ import (
 // Watchers registered to be notified on Project create and delete.
 type ProjectWatcher struct {
 	nexus.Node
+
+	// Phase orders the watchers of a Project. A watcher is signalled ready on create once every watcher of a
+	// lower phase is IDLE, and on delete once every watcher of a higher phase has removed its active watcher.
+	Phase int32
+
+	// DependsOn lists the watchers that must be IDLE before this watcher is signalled ready on create,
+	// and that are released on delete only after this watcher has removed its active watcher.
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// CreateTimeoutInSecs and DeleteTimeoutInSecs bound how long the watcher may take to acknowledge
+	// a Project create or delete, counted from the start of the operation. Zero uses the tenancy-manager defaults.
+	CreateTimeoutInSecs int32
+	DeleteTimeoutInSecs int32
+
+	// Required watchers turn the Project to ERROR when they fail; the failures of other watchers are only
+	// reported as warnings. Watchers are required unless set to false.
+	Required *bool `json:"required,omitempty"`
+
+	// FailurePolicy applies when the watcher reports an error or does not acknowledge in time.
+	FailurePolicy FailurePolicy
+
+	// Retries is the number of times the watcher is notified again under the Retry failure policy.
+	Retries int32
 }
+
+type FailurePolicy string
+
+const (
+	// FailurePolicyFail fails the Project if the watcher is required. It is the default.
+	FailurePolicyFail FailurePolicy = "Fail"
+	// FailurePolicyIgnore reports the failure as a warning.
+	FailurePolicyIgnore FailurePolicy = "Ignore"
+	// FailurePolicyRetry notifies the watcher again, up to Retries times, before failing.
+	FailurePolicyRetry FailurePolicy = "Retry"
+)
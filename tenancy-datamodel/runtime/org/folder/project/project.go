@@ -29,6 +29,19 @@ type RuntimeProject struct {
 	// Indicates that project has been deleted by the User.
 	Deleted bool
 
+	// Indicates that project has been archived by the User.
+	Archived bool `json:"archived,omitempty"`
+
+	// Labels of the project, copied from the config project.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Owners of the project, copied from the config project.
+	Owners []string `json:"owners,omitempty"`
+
+	// Folder of the config project. A moved project keeps its runtime project, and so its UID, in the runtime folder
+	// it was created in.
+	Folder string `json:"folder,omitempty"`
+
 	// Watchers actively watching this project for create, delete.
 	ActiveWatchers projectactivewatcher.ProjectActiveWatcher `nexus:"children"`
 }
@@ -30,6 +30,15 @@ type RuntimeOrg struct {
 	// Indicates that org has been deleted by the User.
 	Deleted bool
 
+	// Indicates that org has been suspended by the User.
+	Suspended bool `json:"suspended,omitempty"`
+
+	// Labels of the org, copied from the config org.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Owners of the org, copied from the config org.
+	Owners []string `json:"owners,omitempty"`
+
 	// Projects associated with this org.
 	Folders runtimefolder.RuntimeFolder `nexus:"children"`
 
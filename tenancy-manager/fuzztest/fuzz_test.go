@@ -121,6 +121,7 @@ func FuzzTenancyOrgProjectCreate(f *testing.F) {
 
 	tenancy.Testing = true
 	tenancyReconciler = tenancy.NewReconciler(nexusClient, nil)
+	go tenancyReconciler.Start(context.Background())
 
 	tenancyClient, err := nexusClient.AddTenancyMultiTenancy(context.Background(), &tenancyv1.MultiTenancy{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,197 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package backup_test
+
+import (
+	"context"
+	"maps"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	folderv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/folder.edge-orchestrator.intel.com/v1"
+	networkv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/network.edge-orchestrator.intel.com/v1"
+	orgv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	orgwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgwatcher.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/backup"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/watcher/watchertest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = ginkgo.Describe("Backup", ginkgo.Ordered, func() {
+	ctx := context.Background()
+	var (
+		client *nexus_client.Clientset
+		bundle *backup.Bundle
+	)
+
+	ginkgo.BeforeAll(func() {
+		harness, err := watchertest.NewHarness(ctx)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		client = harness.Client
+		config := client.TenancyMultiTenancy().Config()
+
+		_, err = config.AddOrgWatchers(ctx, &orgwatcherv1.OrgWatcher{
+			ObjectMeta: metav1.ObjectMeta{Name: "keycloak-tenant-controller"},
+			Spec:       orgwatcherv1.OrgWatcherSpec{Phase: 1, CreateTimeoutInSecs: 60},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		org, err := config.AddOrgs(ctx, &orgv1.Org{
+			ObjectMeta: metav1.ObjectMeta{Name: "coke"},
+			Spec:       orgv1.OrgSpec{Description: "Coke", Labels: map[string]string{"env": "prod"}},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		folder, err := org.AddFolders(ctx, &folderv1.Folder{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		project, err := folder.AddProjects(ctx, &projectv1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       projectv1.ProjectSpec{Description: "Foo", Owners: []string{"alice"}},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = project.AddNetworks(ctx, &networkv1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: "mesh"},
+			Spec:       networkv1.NetworkSpec{Type: networkv1.AppplicationMesh},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("should export the config tree by display name", func() {
+		var err error
+		bundle, err = backup.Export(ctx, client)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(bundle.Version).To(gomega.Equal(backup.Version))
+		gomega.Expect(bundle.OrgWatchers).To(gomega.ConsistOf(backup.OrgWatcher{
+			Name: "keycloak-tenant-controller", Spec: orgwatcherv1.OrgWatcherSpec{Phase: 1, CreateTimeoutInSecs: 60},
+		}))
+		gomega.Expect(bundle.Orgs).To(gomega.HaveLen(1))
+		org := bundle.Orgs[0]
+		gomega.Expect(org.Name).To(gomega.Equal("coke"))
+		gomega.Expect(org.Spec.FoldersGvk).To(gomega.BeEmpty())
+		gomega.Expect(org.Folders).To(gomega.HaveLen(1))
+		gomega.Expect(org.Folders[0].Projects).To(gomega.HaveLen(1))
+		project := org.Folders[0].Projects[0]
+		gomega.Expect(project.Name).To(gomega.Equal("foo"))
+		gomega.Expect(project.Spec.Owners).To(gomega.Equal([]string{"alice"}))
+		gomega.Expect(project.Networks).To(gomega.ConsistOf(backup.Network{
+			Name: "mesh", Spec: networkv1.NetworkSpec{Type: networkv1.AppplicationMesh},
+		}))
+	})
+
+	ginkgo.It("should encode and decode a bundle", func() {
+		for _, format := range []string{backup.FormatYAML, backup.FormatJSON} {
+			data, err := backup.Marshal(bundle, format)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			decoded, err := backup.Unmarshal(data)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(decoded.Orgs).To(gomega.Equal(bundle.Orgs))
+		}
+
+		_, err := backup.Unmarshal([]byte("version: tenancy.edge-orchestrator.intel.com/v0\n"))
+		gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("unsupported bundle version")))
+		_, err = backup.Unmarshal([]byte("version: tenancy.edge-orchestrator.intel.com/v1\nunknown: 1\n"))
+		gomega.Expect(err).To(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("should leave the objects it finds unchanged", func() {
+		report, err := backup.Import(ctx, client, bundle, backup.Options{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(report.Created).To(gomega.BeEmpty())
+		gomega.Expect(report.Conflicts).To(gomega.BeEmpty())
+		gomega.Expect(report.Unchanged).To(gomega.ConsistOf("orgwatcher/keycloak-tenant-controller", "org/coke",
+			"folder/coke/default", "project/coke/default/foo", "network/coke/default/foo/mesh"))
+	})
+
+	ginkgo.It("should report the objects that differ as conflicts, and the UIDs that changed", func() {
+		changed := *bundle
+		changed.Orgs = []backup.Org{bundle.Orgs[0]}
+		changed.Orgs[0].UID = "exported-uid"
+		changed.Orgs[0].Spec.Description = "Pepsi"
+
+		report, err := backup.Import(ctx, client, &changed, backup.Options{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(report.Conflicts).To(gomega.ConsistOf(gomega.HaveField("Object", "org/coke")))
+		gomega.Expect(report.UIDs).To(gomega.ConsistOf(backup.UIDChange{Object: "org/coke", Exported: "exported-uid"}))
+		gomega.Expect(report.Unchanged).To(gomega.ContainElement("project/coke/default/foo"))
+
+		org, err := client.TenancyMultiTenancy().Config().GetOrgs(ctx, "coke")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(org.Spec.Description).To(gomega.Equal("Coke"))
+	})
+
+	ginkgo.It("should create the objects it misses, or only report them in a dry run", func() {
+		added := *bundle
+		added.OrgWatchers = nil
+		added.Orgs = []backup.Org{bundle.Orgs[0]}
+		added.Orgs[0].Name = "pepsi"
+		created := []string{"org/pepsi", "folder/pepsi/default", "project/pepsi/default/foo",
+			"network/pepsi/default/foo/mesh"}
+
+		report, err := backup.Import(ctx, client, &added, backup.Options{DryRun: true})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(report.Created).To(gomega.Equal(created))
+		_, err = client.TenancyMultiTenancy().Config().GetOrgs(ctx, "pepsi")
+		gomega.Expect(nexus_client.IsChildNotFound(err) || nexus_client.IsNotFound(err)).To(gomega.BeTrue())
+
+		report, err = backup.Import(ctx, client, &added, backup.Options{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(report.Created).To(gomega.Equal(created))
+		gomega.Expect(report.Conflicts).To(gomega.BeEmpty())
+		network, err := client.TenancyMultiTenancy().Config().Orgs("pepsi").Folders("default").Projects("foo").
+			GetNetworks(ctx, "mesh")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(network.Spec.Type).To(gomega.Equal(networkv1.AppplicationMesh))
+		org, err := client.TenancyMultiTenancy().Config().GetOrgs(ctx, "pepsi")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(org.Labels).To(gomega.HaveKeyWithValue("env", "prod"))
+	})
+
+	ginkgo.It("should import a project as expanded from its template", func() {
+		expansion := map[string]string{
+			tenancy.TemplateAnnotation:                      "edge",
+			tenancy.ParametersAnnotationPrefix + "app-orch": `{"registry":"internal"}`,
+		}
+		annotations := maps.Clone(expansion)
+		annotations["example.com/owner"] = "alice"
+		folder := client.TenancyMultiTenancy().Config().Orgs("coke").Folders("default")
+		_, err := folder.AddProjects(ctx, &projectv1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Annotations: annotations},
+			Spec:       projectv1.ProjectSpec{Template: "edge", Labels: map[string]string{"tier": "gold"}},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		exported, err := backup.Export(ctx, client)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		org := exported.Orgs[0]
+		gomega.Expect(org.Name).To(gomega.Equal("coke"))
+		project := org.Folders[0].Projects[0]
+		gomega.Expect(project.Name).To(gomega.Equal("bar"))
+		gomega.Expect(project.Annotations).To(gomega.Equal(expansion))
+
+		// The Tenancy Manager does not expand the template of an imported project again.
+		org.Name = "fanta"
+		org.Folders[0].Projects = []backup.Project{project}
+		exported.Orgs = []backup.Org{org}
+		_, err = backup.Import(ctx, client, exported, backup.Options{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		imported, err := client.TenancyMultiTenancy().Config().Orgs("fanta").Folders("default").GetProjects(ctx, "bar")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(imported.GetAnnotations()).To(gomega.Equal(expansion))
+	})
+})
@@ -0,0 +1,141 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+/*
+Package backup exports the config tree of the tenancy data model, its watcher registrations and its API mappings
+into a versioned Bundle, and imports a Bundle back, into the same or another cluster. Only the config tree is
+exported: the Tenancy Manager recreates the runtime tree, and the watchers their resources, from it.
+*/
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apimappingconfigv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/apimappingconfig.edge-orchestrator.intel.com/v1"
+	folderv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/folder.edge-orchestrator.intel.com/v1"
+	networkv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/network.edge-orchestrator.intel.com/v1"
+	orgv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	orgwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgwatcher.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
+	projecttemplatev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projecttemplate.edge-orchestrator.intel.com/v1"
+	projectwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectwatcher.edge-orchestrator.intel.com/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Version is the version of the Bundles written by Export. Import reads the Bundles of this version only.
+const Version = "tenancy.edge-orchestrator.intel.com/v1"
+
+// Formats of an encoded Bundle.
+const (
+	FormatYAML = "yaml"
+	FormatJSON = "json"
+)
+
+// Bundle is the config tree of the tenancy data model, by display name.
+type Bundle struct {
+	Version    string      `json:"version"`
+	ExportedAt metav1.Time `json:"exportedAt"`
+
+	APIMappings      []APIMapping      `json:"apiMappings,omitempty"`
+	OrgWatchers      []OrgWatcher      `json:"orgWatchers,omitempty"`
+	ProjectWatchers  []ProjectWatcher  `json:"projectWatchers,omitempty"`
+	ProjectTemplates []ProjectTemplate `json:"projectTemplates,omitempty"`
+	Orgs             []Org             `json:"orgs,omitempty"`
+}
+
+// APIMapping is an APIMappingConfig.
+type APIMapping struct {
+	Name string                                  `json:"name"`
+	Spec apimappingconfigv1.APIMappingConfigSpec `json:"spec"`
+}
+
+// OrgWatcher is the registration of an org watcher.
+type OrgWatcher struct {
+	Name string                      `json:"name"`
+	Spec orgwatcherv1.OrgWatcherSpec `json:"spec"`
+}
+
+// ProjectWatcher is the registration of a project watcher.
+type ProjectWatcher struct {
+	Name string                              `json:"name"`
+	Spec projectwatcherv1.ProjectWatcherSpec `json:"spec"`
+}
+
+// ProjectTemplate is a ProjectTemplate.
+type ProjectTemplate struct {
+	Name string                                `json:"name"`
+	Spec projecttemplatev1.ProjectTemplateSpec `json:"spec"`
+}
+
+// Org is a config Org with its Folders. UID is the UID of its runtime Org when it was exported.
+type Org struct {
+	Name    string        `json:"name"`
+	UID     string        `json:"uid,omitempty"`
+	Spec    orgv1.OrgSpec `json:"spec"`
+	Folders []Folder      `json:"folders,omitempty"`
+}
+
+// Folder is a config Folder with its Projects.
+type Folder struct {
+	Name     string              `json:"name"`
+	Spec     folderv1.FolderSpec `json:"spec"`
+	Projects []Project           `json:"projects,omitempty"`
+}
+
+/*
+Project is a config Project with its Networks. UID is the UID of its runtime Project when it was exported.
+Annotations record the expansion of its ProjectTemplate, whose Networks and labels are already in the Project.
+*/
+type Project struct {
+	Name        string                `json:"name"`
+	UID         string                `json:"uid,omitempty"`
+	Annotations map[string]string     `json:"annotations,omitempty"`
+	Spec        projectv1.ProjectSpec `json:"spec"`
+	Networks    []Network             `json:"networks,omitempty"`
+}
+
+// Network is a config Network.
+type Network struct {
+	Name string                `json:"name"`
+	Spec networkv1.NetworkSpec `json:"spec"`
+}
+
+// Marshal encodes bundle in format.
+func Marshal(bundle *Bundle, format string) ([]byte, error) {
+	switch format {
+	case FormatYAML:
+		return yaml.Marshal(bundle)
+	case FormatJSON:
+		return json.MarshalIndent(bundle, "", "  ")
+	}
+	return nil, fmt.Errorf("unknown bundle format %q, expected %s or %s", format, FormatYAML, FormatJSON)
+}
+
+// Unmarshal decodes a Bundle encoded in YAML or JSON, of Version.
+func Unmarshal(data []byte) (*Bundle, error) {
+	bundle := &Bundle{}
+	if err := yaml.UnmarshalStrict(data, bundle); err != nil {
+		return nil, fmt.Errorf("unable to decode bundle: %w", err)
+	}
+	if bundle.Version != Version {
+		return nil, fmt.Errorf("unsupported bundle version %q, expected %s", bundle.Version, Version)
+	}
+	return bundle, nil
+}
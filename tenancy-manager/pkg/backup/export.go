@@ -0,0 +1,166 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package backup
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+/*
+Export reads the config tree of client, its watcher registrations and its API mappings into a Bundle, sorted by
+name. Orgs and Projects being deleted are left out. The children of a node are exported with it, and not in its
+spec, as their references are specific to the cluster.
+*/
+func Export(ctx context.Context, client *nexus_client.Clientset) (*Bundle, error) {
+	config, err := client.TenancyMultiTenancy().GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the config root: %w", err)
+	}
+	bundle := &Bundle{Version: Version, ExportedAt: metav1.NewTime(time.Now().UTC())}
+
+	mappings, err := config.GetAllAPIMappings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list APIMappingConfigs: %w", err)
+	}
+	for _, mapping := range mappings {
+		bundle.APIMappings = append(bundle.APIMappings, APIMapping{Name: mapping.DisplayName(), Spec: mapping.Spec})
+	}
+	orgWatchers, err := config.GetAllOrgWatchers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list OrgWatchers: %w", err)
+	}
+	for _, watcher := range orgWatchers {
+		bundle.OrgWatchers = append(bundle.OrgWatchers, OrgWatcher{Name: watcher.DisplayName(), Spec: watcher.Spec})
+	}
+	projectWatchers, err := config.GetAllProjectWatchers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list ProjectWatchers: %w", err)
+	}
+	for _, watcher := range projectWatchers {
+		bundle.ProjectWatchers = append(bundle.ProjectWatchers,
+			ProjectWatcher{Name: watcher.DisplayName(), Spec: watcher.Spec})
+	}
+	templates, err := config.GetAllProjectTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list ProjectTemplates: %w", err)
+	}
+	for _, template := range templates {
+		bundle.ProjectTemplates = append(bundle.ProjectTemplates,
+			ProjectTemplate{Name: template.DisplayName(), Spec: template.Spec})
+	}
+
+	orgs, err := config.GetAllOrgs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list Orgs: %w", err)
+	}
+	for _, org := range orgs {
+		if !org.DeletionTimestamp.IsZero() {
+			continue
+		}
+		exported, err := exportOrg(ctx, org)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Orgs = append(bundle.Orgs, exported)
+	}
+
+	slices.SortFunc(bundle.APIMappings, func(a, b APIMapping) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(bundle.OrgWatchers, func(a, b OrgWatcher) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(bundle.ProjectWatchers, func(a, b ProjectWatcher) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(bundle.ProjectTemplates, func(a, b ProjectTemplate) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(bundle.Orgs, func(a, b Org) int { return cmp.Compare(a.Name, b.Name) })
+	return bundle, nil
+}
+
+func exportOrg(ctx context.Context, org *nexus_client.OrgOrg) (Org, error) {
+	exported := Org{Name: org.DisplayName(), UID: org.Status.OrgStatus.UID, Spec: org.Spec}
+	exported.Spec.FoldersGvk = nil
+	folders, err := org.GetAllFolders(ctx)
+	if err != nil {
+		return Org{}, fmt.Errorf("unable to list the Folders of org %s: %w", org.DisplayName(), err)
+	}
+	for _, folder := range folders {
+		exportedFolder := Folder{Name: folder.DisplayName(), Spec: folder.Spec}
+		exportedFolder.Spec.ProjectsGvk = nil
+		projects, err := folder.GetAllProjects(ctx)
+		if err != nil {
+			return Org{}, fmt.Errorf("unable to list the Projects of folder %s/%s: %w",
+				org.DisplayName(), folder.DisplayName(), err)
+		}
+		for _, project := range projects {
+			if !project.DeletionTimestamp.IsZero() {
+				continue
+			}
+			exportedProject, err := exportProject(ctx, project)
+			if err != nil {
+				return Org{}, err
+			}
+			exportedFolder.Projects = append(exportedFolder.Projects, exportedProject)
+		}
+		slices.SortFunc(exportedFolder.Projects, func(a, b Project) int { return cmp.Compare(a.Name, b.Name) })
+		exported.Folders = append(exported.Folders, exportedFolder)
+	}
+	slices.SortFunc(exported.Folders, func(a, b Folder) int { return cmp.Compare(a.Name, b.Name) })
+	return exported, nil
+}
+
+// templateAnnotations returns the annotations of a config Project recording the expansion of its ProjectTemplate.
+func templateAnnotations(annotations map[string]string) map[string]string {
+	var expansion map[string]string
+	for key, value := range annotations {
+		if key == tenancy.TemplateAnnotation || strings.HasPrefix(key, tenancy.ParametersAnnotationPrefix) {
+			if expansion == nil {
+				expansion = map[string]string{}
+			}
+			expansion[key] = value
+		}
+	}
+	return expansion
+}
+
+func exportProject(ctx context.Context, project *nexus_client.ProjectProject) (Project, error) {
+	exported := Project{
+		Name:        project.DisplayName(),
+		UID:         project.Status.ProjectStatus.UID,
+		Annotations: templateAnnotations(project.GetAnnotations()),
+		Spec:        project.Spec,
+	}
+	exported.Spec.NetworksGvk = nil
+	networks, err := project.GetAllNetworks(ctx)
+	if err != nil {
+		return Project{}, fmt.Errorf("unable to list the Networks of project %s: %w", project.DisplayName(), err)
+	}
+	for _, network := range networks {
+		if !network.DeletionTimestamp.IsZero() {
+			continue
+		}
+		exported.Networks = append(exported.Networks, Network{Name: network.DisplayName(), Spec: network.Spec})
+	}
+	slices.SortFunc(exported.Networks, func(a, b Network) int { return cmp.Compare(a.Name, b.Name) })
+	return exported, nil
+}
@@ -0,0 +1,470 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	apimappingconfigv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/apimappingconfig.edge-orchestrator.intel.com/v1"
+	folderv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/folder.edge-orchestrator.intel.com/v1"
+	networkv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/network.edge-orchestrator.intel.com/v1"
+	orgv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	orgwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgwatcher.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
+	projecttemplatev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projecttemplate.edge-orchestrator.intel.com/v1"
+	projectwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectwatcher.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// pollInterval is the interval at which Import checks whether an Org or a Project it created is IDLE.
+const pollInterval = time.Second
+
+// errDeleting is the lookup error of an Org or a Project being deleted.
+var errDeleting = errors.New("it is being deleted")
+
+// Options tune Import.
+type Options struct {
+	// Wait is how long Import waits for each Org, and each Project, it created to be IDLE. The Projects of an Org
+	// that is not IDLE in time are not imported. Zero does not wait.
+	Wait time.Duration
+	// DryRun reports what Import would do, without writing anything.
+	DryRun bool
+}
+
+/*
+Report is the outcome of Import. Objects are named after their kind and the display names of their path:
+"org/<org>", "folder/<org>/<folder>", "project/<org>/<folder>/<project>",
+"network/<org>/<folder>/<project>/<network>", "apimapping/<name>", "orgwatcher/<name>", "projectwatcher/<name>"
+and "projecttemplate/<name>".
+*/
+type Report struct {
+	Created   []string    `json:"created,omitempty"`
+	Unchanged []string    `json:"unchanged,omitempty"`
+	Conflicts []Conflict  `json:"conflicts,omitempty"`
+	UIDs      []UIDChange `json:"uids,omitempty"`
+}
+
+// Conflict is an object of the Bundle that was not imported as it is.
+type Conflict struct {
+	Object string `json:"object"`
+	Reason string `json:"reason"`
+}
+
+// UIDChange is an Org or a Project whose UID is not the exported one. Imported is empty until the Tenancy Manager
+// creates its runtime counterpart.
+type UIDChange struct {
+	Object   string `json:"object"`
+	Exported string `json:"exported"`
+	Imported string `json:"imported"`
+}
+
+type importer struct {
+	ctx    context.Context
+	config *nexus_client.ConfigConfig
+	opts   Options
+	report *Report
+}
+
+/*
+Import recreates the objects of bundle that client lacks, in dependency order: the API mappings and the watcher
+registrations first, so that the watchers take part in the creation of the tenants, then the project templates,
+then each Org with its Folders and, once the Org is IDLE, its Projects with their Networks. A Project exported
+once its template was expanded is imported as expanded, so that the Networks of the template are not added again.
+Existing objects are left as they are, and so keep their UID; those whose spec differs from the bundle are reported
+as conflicts. UIDs are not preserved: those of new Orgs and Projects are assigned by the cluster, and reported when
+they differ from the bundle. An API error aborts the import, with the report so far.
+*/
+func Import(ctx context.Context, client *nexus_client.Clientset, bundle *Bundle, opts Options) (*Report, error) {
+	config, err := client.TenancyMultiTenancy().GetConfig(ctx)
+	if err != nil {
+		return &Report{}, fmt.Errorf("unable to get the config root: %w", err)
+	}
+	i := &importer{ctx: ctx, config: config, opts: opts, report: &Report{}}
+
+	for _, mapping := range bundle.APIMappings {
+		if _, err := i.importAPIMapping(mapping); err != nil {
+			return i.report, err
+		}
+	}
+	for _, watcher := range bundle.OrgWatchers {
+		if _, err := i.importOrgWatcher(watcher); err != nil {
+			return i.report, err
+		}
+	}
+	for _, watcher := range bundle.ProjectWatchers {
+		if _, err := i.importProjectWatcher(watcher); err != nil {
+			return i.report, err
+		}
+	}
+	for _, template := range bundle.ProjectTemplates {
+		if _, err := i.importProjectTemplate(template); err != nil {
+			return i.report, err
+		}
+	}
+	for _, org := range bundle.Orgs {
+		if err := i.importOrg(org); err != nil {
+			return i.report, err
+		}
+	}
+	return i.report, nil
+}
+
+/*
+ensure creates object with add unless get finds it. get returns the spec of the existing object, without its
+children, to compare with want. ensure reports whether the children of object can be imported: it exists,
+or it is created, and it is not being deleted.
+*/
+func (i *importer) ensure(object string, want any, get func() (any, error), add func() error) (bool, error) {
+	got, err := get()
+	if isNotFound(err) {
+		if i.opts.DryRun {
+			i.report.Created = append(i.report.Created, object)
+			return true, nil
+		}
+		err = add()
+		if err == nil {
+			i.report.Created = append(i.report.Created, object)
+			return true, nil
+		}
+		if !nexus_client.IsAlreadyExists(err) {
+			return false, fmt.Errorf("unable to create %s: %w", object, err)
+		}
+		// Added meanwhile, e.g. the default Folder of an Org by the Tenancy Manager.
+		got, err = get()
+	}
+	switch {
+	case errors.Is(err, errDeleting):
+		i.conflict(object, err.Error())
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("unable to get %s: %w", object, err)
+	case !equality.Semantic.DeepEqual(got, want):
+		i.conflict(object, "its spec differs from the bundle, it is left as it is")
+	default:
+		i.report.Unchanged = append(i.report.Unchanged, object)
+	}
+	return true, nil
+}
+
+func (i *importer) conflict(object, reason string) {
+	i.report.Conflicts = append(i.report.Conflicts, Conflict{Object: object, Reason: reason})
+}
+
+func isNotFound(err error) bool {
+	return nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err)
+}
+
+func (i *importer) importAPIMapping(mapping APIMapping) (bool, error) {
+	return i.ensure("apimapping/"+mapping.Name, mapping.Spec, func() (any, error) {
+		got, err := i.config.GetAPIMappings(i.ctx, mapping.Name)
+		if err != nil {
+			return nil, err
+		}
+		return got.Spec, nil
+	}, func() error {
+		_, err := i.config.AddAPIMappings(i.ctx, &apimappingconfigv1.APIMappingConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: mapping.Name},
+			Spec:       mapping.Spec,
+		})
+		return err
+	})
+}
+
+func (i *importer) importOrgWatcher(watcher OrgWatcher) (bool, error) {
+	return i.ensure("orgwatcher/"+watcher.Name, watcher.Spec, func() (any, error) {
+		got, err := i.config.GetOrgWatchers(i.ctx, watcher.Name)
+		if err != nil {
+			return nil, err
+		}
+		return got.Spec, nil
+	}, func() error {
+		_, err := i.config.AddOrgWatchers(i.ctx, &orgwatcherv1.OrgWatcher{
+			ObjectMeta: metav1.ObjectMeta{Name: watcher.Name},
+			Spec:       watcher.Spec,
+		})
+		return err
+	})
+}
+
+func (i *importer) importProjectWatcher(watcher ProjectWatcher) (bool, error) {
+	return i.ensure("projectwatcher/"+watcher.Name, watcher.Spec, func() (any, error) {
+		got, err := i.config.GetProjectWatchers(i.ctx, watcher.Name)
+		if err != nil {
+			return nil, err
+		}
+		return got.Spec, nil
+	}, func() error {
+		_, err := i.config.AddProjectWatchers(i.ctx, &projectwatcherv1.ProjectWatcher{
+			ObjectMeta: metav1.ObjectMeta{Name: watcher.Name},
+			Spec:       watcher.Spec,
+		})
+		return err
+	})
+}
+
+func (i *importer) importProjectTemplate(template ProjectTemplate) (bool, error) {
+	return i.ensure("projecttemplate/"+template.Name, template.Spec, func() (any, error) {
+		got, err := i.config.GetProjectTemplates(i.ctx, template.Name)
+		if err != nil {
+			return nil, err
+		}
+		return got.Spec, nil
+	}, func() error {
+		_, err := i.config.AddProjectTemplates(i.ctx, &projecttemplatev1.ProjectTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: template.Name},
+			Spec:       template.Spec,
+		})
+		return err
+	})
+}
+
+func (i *importer) importOrg(org Org) error {
+	object := "org/" + org.Name
+	var imported *nexus_client.OrgOrg
+	ok, err := i.ensure(object, org.Spec, func() (any, error) {
+		got, err := i.config.GetOrgs(i.ctx, org.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !got.DeletionTimestamp.IsZero() {
+			return nil, errDeleting
+		}
+		imported = got
+		spec := got.Spec
+		spec.FoldersGvk = nil
+		return spec, nil
+	}, func() error {
+		added, err := i.config.AddOrgs(i.ctx, &orgv1.Org{
+			ObjectMeta: metav1.ObjectMeta{Name: org.Name, Labels: maps.Clone(org.Spec.Labels)},
+			Spec:       org.Spec,
+		})
+		imported = added
+		return err
+	})
+	if err != nil || !ok {
+		return err
+	}
+
+	for _, folder := range org.Folders {
+		if err := i.importFolder(imported, org, folder); err != nil {
+			return err
+		}
+	}
+	if imported == nil {
+		// A dry run of a new Org.
+		for _, folder := range org.Folders {
+			for _, project := range folder.Projects {
+				i.importNewProject(org, folder, project)
+			}
+		}
+		return nil
+	}
+
+	idle, err := i.waitIdle(object, org.UID, func() (orgv1.TenancyRequestStatus, string, error) {
+		got, err := i.config.GetOrgs(i.ctx, org.Name)
+		if err != nil {
+			return "", "", err
+		}
+		return got.Status.OrgStatus.StatusIndicator, got.Status.OrgStatus.UID, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !idle {
+		for _, folder := range org.Folders {
+			for _, project := range folder.Projects {
+				i.conflict(projectObject(org, folder, project), fmt.Sprintf("org %s is not IDLE", org.Name))
+			}
+		}
+		return nil
+	}
+	for _, folder := range org.Folders {
+		for _, project := range folder.Projects {
+			if err := i.importProject(imported, org, folder, project); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// importFolder imports a Folder of org, without its Projects. imported is nil in a dry run of a new Org.
+func (i *importer) importFolder(imported *nexus_client.OrgOrg, org Org, folder Folder) error {
+	object := "folder/" + org.Name + "/" + folder.Name
+	if imported == nil {
+		i.report.Created = append(i.report.Created, object)
+		return nil
+	}
+	_, err := i.ensure(object, folder.Spec, func() (any, error) {
+		got, err := imported.GetFolders(i.ctx, folder.Name)
+		if err != nil {
+			return nil, err
+		}
+		spec := got.Spec
+		spec.ProjectsGvk = nil
+		return spec, nil
+	}, func() error {
+		_, err := imported.AddFolders(i.ctx, &folderv1.Folder{
+			ObjectMeta: metav1.ObjectMeta{Name: folder.Name},
+			Spec:       folder.Spec,
+		})
+		return err
+	})
+	return err
+}
+
+// importProject imports a Project of org with its Networks, once org is IDLE.
+func (i *importer) importProject(imported *nexus_client.OrgOrg, org Org, folder Folder, project Project) error {
+	object := projectObject(org, folder, project)
+	parent, err := imported.GetFolders(i.ctx, folder.Name)
+	if isNotFound(err) && i.opts.DryRun {
+		i.importNewProject(org, folder, project)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get folder/%s/%s: %w", org.Name, folder.Name, err)
+	}
+
+	var importedProject *nexus_client.ProjectProject
+	ok, err := i.ensure(object, project.Spec, func() (any, error) {
+		got, err := parent.GetProjects(i.ctx, project.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !got.DeletionTimestamp.IsZero() {
+			return nil, errDeleting
+		}
+		importedProject = got
+		spec := got.Spec
+		spec.NetworksGvk = nil
+		return spec, nil
+	}, func() error {
+		added, err := parent.AddProjects(i.ctx, &projectv1.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        project.Name,
+				Labels:      maps.Clone(project.Spec.Labels),
+				Annotations: maps.Clone(project.Annotations),
+			},
+			Spec: project.Spec,
+		})
+		importedProject = added
+		return err
+	})
+	if err != nil || !ok {
+		return err
+	}
+	if importedProject == nil {
+		// A dry run of a new Project.
+		for _, network := range project.Networks {
+			i.report.Created = append(i.report.Created, networkObject(object, network))
+		}
+		return nil
+	}
+
+	for _, network := range project.Networks {
+		if err := i.importNetwork(importedProject, object, network); err != nil {
+			return err
+		}
+	}
+	_, err = i.waitIdle(object, project.UID, func() (orgv1.TenancyRequestStatus, string, error) {
+		got, err := parent.GetProjects(i.ctx, project.Name)
+		if err != nil {
+			return "", "", err
+		}
+		return orgv1.TenancyRequestStatus(got.Status.ProjectStatus.StatusIndicator), got.Status.ProjectStatus.UID, nil
+	})
+	return err
+}
+
+// importNewProject reports the Project of a dry run, that would be created with its Networks.
+func (i *importer) importNewProject(org Org, folder Folder, project Project) {
+	object := projectObject(org, folder, project)
+	i.report.Created = append(i.report.Created, object)
+	for _, network := range project.Networks {
+		i.report.Created = append(i.report.Created, networkObject(object, network))
+	}
+}
+
+func (i *importer) importNetwork(project *nexus_client.ProjectProject, projectObject string, network Network) error {
+	_, err := i.ensure(networkObject(projectObject, network), network.Spec, func() (any, error) {
+		got, err := project.GetNetworks(i.ctx, network.Name)
+		if err != nil {
+			return nil, err
+		}
+		return got.Spec, nil
+	}, func() error {
+		_, err := project.AddNetworks(i.ctx, &networkv1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: network.Name},
+			Spec:       network.Spec,
+		})
+		return err
+	})
+	return err
+}
+
+/*
+waitIdle waits up to the Wait of the options for object to be IDLE, and reports its UID if it is not the exported
+one. status returns the status indicator and the UID of object. waitIdle reports whether object is IDLE,
+or whether it did not wait.
+*/
+func (i *importer) waitIdle(object, exportedUID string,
+	status func() (orgv1.TenancyRequestStatus, string, error),
+) (bool, error) {
+	if i.opts.DryRun {
+		return true, nil
+	}
+	indicator, uid, err := status()
+	if err != nil {
+		return false, fmt.Errorf("unable to get %s: %w", object, err)
+	}
+	idle := indicator == orgv1.StatusIndicationIdle
+	if !idle && i.opts.Wait > 0 {
+		err = wait.PollUntilContextTimeout(i.ctx, pollInterval, i.opts.Wait, false,
+			func(context.Context) (bool, error) {
+				indicator, uid, err = status()
+				if err != nil {
+					return false, err
+				}
+				return indicator == orgv1.StatusIndicationIdle, nil
+			})
+		idle = err == nil
+		if err != nil && !wait.Interrupted(err) {
+			return false, fmt.Errorf("unable to get %s: %w", object, err)
+		}
+	}
+	if exportedUID != "" && uid != exportedUID {
+		i.report.UIDs = append(i.report.UIDs, UIDChange{Object: object, Exported: exportedUID, Imported: uid})
+	}
+	return idle || i.opts.Wait == 0, nil
+}
+
+func projectObject(org Org, folder Folder, project Project) string {
+	return "project/" + org.Name + "/" + folder.Name + "/" + project.Name
+}
+
+func networkObject(projectObject string, network Network) string {
+	return "network/" + projectObject[len("project/"):] + "/" + network.Name
+}
@@ -24,13 +24,32 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
-const defaultTimeout int32 = 300
+const (
+	defaultTimeout int32 = 300
+
+	defaultWorkers               int32 = 4
+	defaultMaxRetries            int32 = 10
+	defaultRetryBaseDelayInMsecs int32 = 500
+	defaultRetryMaxDelayInSecs   int32 = 60
+
+	defaultDriftReconcileIntervalInSecs int32 = 300
+)
 
 type Config struct {
 	OrgCreateTimeoutInSecs     int32 `yaml:"OrgCreateTimeoutInSecs"`
 	OrgDeleteTimeoutInSecs     int32 `yaml:"OrgDeleteTimeoutInSecs"`
 	ProjectCreateTimeoutInSecs int32 `yaml:"ProjectCreateTimeoutInSecs"`
 	ProjectDeleteTimeoutInSecs int32 `yaml:"ProjectDeleteTimeoutInSecs"`
+
+	// Number of workers processing Org and Project events concurrently.
+	OrgWorkers     int32 `yaml:"OrgWorkers"`
+	ProjectWorkers int32 `yaml:"ProjectWorkers"`
+	// Retries of an event after a transient API error, with exponential backoff between the two delays.
+	MaxRetries            int32 `yaml:"MaxRetries"`
+	RetryBaseDelayInMsecs int32 `yaml:"RetryBaseDelayInMsecs"`
+	RetryMaxDelayInSecs   int32 `yaml:"RetryMaxDelayInSecs"`
+	// Interval between two comparisons of the config and runtime trees, repairing the drift found.
+	DriftReconcileIntervalInSecs int32 `yaml:"DriftReconcileIntervalInSecs"`
 }
 
 // LoadConfig loads configuration from a YAML file mounted in the specified path.
@@ -45,15 +64,37 @@ func LoadConfig(filePath string) (*Config, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal YAML: %w", err)
 	}
+	if config == nil {
+		config = &Config{}
+	}
+	config.ApplyDefaults()
 	return config, nil
 }
 
 // LoadConfig loads configuration from a YAML file mounted in the specified path.
 func GetDefaultConfig() *Config {
-	return &Config{
+	config := &Config{
 		OrgCreateTimeoutInSecs:     defaultTimeout,
 		OrgDeleteTimeoutInSecs:     defaultTimeout,
 		ProjectCreateTimeoutInSecs: defaultTimeout,
 		ProjectDeleteTimeoutInSecs: defaultTimeout,
 	}
+	config.ApplyDefaults()
+	return config
+}
+
+// ApplyDefaults sets the worker, retry and drift settings that were left unset.
+func (c *Config) ApplyDefaults() {
+	setDefault(&c.OrgWorkers, defaultWorkers)
+	setDefault(&c.ProjectWorkers, defaultWorkers)
+	setDefault(&c.MaxRetries, defaultMaxRetries)
+	setDefault(&c.RetryBaseDelayInMsecs, defaultRetryBaseDelayInMsecs)
+	setDefault(&c.RetryMaxDelayInSecs, defaultRetryMaxDelayInSecs)
+	setDefault(&c.DriftReconcileIntervalInSecs, defaultDriftReconcileIntervalInSecs)
+}
+
+func setDefault(value *int32, def int32) {
+	if *value <= 0 {
+		*value = def
+	}
 }
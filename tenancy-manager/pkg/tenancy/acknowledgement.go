@@ -0,0 +1,708 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	orgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	// The start of an acknowledgement wait is stored on the runtime object,
+	// so that the deadline survives a tenancy-manager restart.
+	createStartedAnnotation = "tenancy-manager.edge-orchestrator.intel.com/create-started-at"
+	deleteStartedAnnotation = "tenancy-manager.edge-orchestrator.intel.com/delete-started-at"
+
+	orgKind     = "org"
+	projectKind = "project"
+)
+
+// ackRequest identifies an Org or Project create/delete that is waiting for its watchers.
+type ackRequest struct {
+	kind        string
+	event       Event
+	displayName string
+	orgName     string
+	folderName  string
+}
+
+func (req ackRequest) String() string {
+	if req.kind == projectKind {
+		return fmt.Sprintf("%s %s/%s/%s %s", req.kind, req.orgName, req.folderName, req.displayName, req.event)
+	}
+	return fmt.Sprintf("%s %s %s", req.kind, req.displayName, req.event)
+}
+
+// lockKey returns the lock of the Org or Project of req, the one the tasks processing it take.
+func (req ackRequest) lockKey() string {
+	if req.kind == projectKind {
+		return projectLockKey(req.orgName, req.folderName, req.displayName)
+	}
+	return orgLockKey(req.displayName)
+}
+
+// projectAck returns the request for the acknowledgement of event on the config project. The project is
+// located by its org label and its runtime Folder.
+func projectAck(project *nexus_client.ProjectProject, event Event) ackRequest {
+	return ackRequest{
+		kind:        projectKind,
+		event:       event,
+		displayName: project.DisplayName(),
+		orgName:     project.GetLabels()["orgs.org.edge-orchestrator.intel.com"],
+		folderName:  RuntimeFolder(project),
+	}
+}
+
+// configProjectLockKey returns the lock of the config project, the one its acknowledgement requests take.
+func configProjectLockKey(project *nexus_client.ProjectProject) string {
+	return projectAck(project, "").lockKey()
+}
+
+// runtimeObject is the part of the runtime Org and Project clients used to persist the start time.
+type runtimeObject interface {
+	metav1.Object
+	Update(ctx context.Context) error
+}
+
+// ackTracker requeues in-flight operations until their watchers acknowledge them or the deadline passes.
+type ackTracker struct {
+	queue workqueue.TypedDelayingInterface[ackRequest]
+	// started holds start times that could not be written to the runtime object.
+	started sync.Map
+}
+
+func newAckTracker() *ackTracker {
+	return &ackTracker{
+		queue: workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[ackRequest]{
+			Name: "tenancy-acknowledgements",
+		}),
+	}
+}
+
+func startedAnnotation(event Event) string {
+	if event == Delete {
+		return deleteStartedAnnotation
+	}
+	return createStartedAnnotation
+}
+
+// markAckStarted records now as the start of the wait for event, unless a start is already recorded.
+func markAckStarted(obj metav1.Object, event Event) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if _, ok := annotations[startedAnnotation(event)]; ok {
+		return
+	}
+	annotations[startedAnnotation(event)] = time.Now().UTC().Format(time.RFC3339)
+	clearRetries(annotations)
+	obj.SetAnnotations(annotations)
+}
+
+// Start runs the Org and Project workers, resumes every in-flight operation left by a previous run
+// and processes the acknowledgement queue until ctx is done.
+func (r *Reconciler) Start(ctx context.Context) {
+	go r.orgs.run(ctx, int(r.Config.OrgWorkers))
+	go r.projects.run(ctx, int(r.Config.ProjectWorkers))
+	go r.reconcileDrift(ctx, time.Duration(r.Config.DriftReconcileIntervalInSecs)*time.Second)
+	go func() {
+		<-ctx.Done()
+		r.acks.queue.ShutDown()
+	}()
+
+	r.resumeInFlight(ctx)
+
+	for r.processNextAck(ctx) {
+	}
+}
+
+// resumeInFlight enqueues every config Org and Project that is still waiting for its watchers
+// or that has a pending retry request.
+func (r *Reconciler) resumeInFlight(ctx context.Context) {
+	orgs, err := r.Client.Org().ListOrgs(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.InfraErr(err).Msg("Unable to list config Orgs to resume in-flight operations")
+	}
+	for _, org := range orgs {
+		r.resumeOrg(org)
+	}
+
+	projects, err := r.Client.Project().ListProjects(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.InfraErr(err).Msg("Unable to list config Projects to resume in-flight operations")
+	}
+	for _, project := range projects {
+		r.resumeProject(project)
+	}
+}
+
+// resumeOrg enqueues the config Org if it is still waiting for its watchers or has a pending retry request.
+func (r *Reconciler) resumeOrg(org *nexus_client.OrgOrg) {
+	event := Create
+	if !org.DeletionTimestamp.IsZero() {
+		event = Delete
+	}
+	r.metrics.restore(orgKind, orgLockKey(org.DisplayName()), event, string(org.Status.OrgStatus.StatusIndicator))
+	if org.GetAnnotations()[RetryRequestedAnnotation] != "" {
+		r.ProcessOrgRetry(org)
+		return
+	}
+	if org.Status.OrgStatus.StatusIndicator != orgsv1.StatusIndicationInProgress {
+		return
+	}
+	r.acks.queue.Add(ackRequest{kind: orgKind, event: event, displayName: org.DisplayName()})
+}
+
+// resumeProject enqueues the config Project if it is still waiting for its watchers or has a pending retry request.
+func (r *Reconciler) resumeProject(project *nexus_client.ProjectProject) {
+	event := Create
+	if !project.DeletionTimestamp.IsZero() {
+		event = Delete
+	}
+	r.metrics.restore(projectKind, configProjectLockKey(project), event,
+		string(project.Status.ProjectStatus.StatusIndicator))
+	if project.GetAnnotations()[RetryRequestedAnnotation] != "" {
+		r.ProcessProjectRetry(project)
+		return
+	}
+	if project.Status.ProjectStatus.StatusIndicator != projectv1.StatusIndicationInProgress {
+		return
+	}
+	r.acks.queue.Add(projectAck(project, event))
+}
+
+// enqueueAck schedules the first acknowledgement check of req one poll interval from now.
+func (r *Reconciler) enqueueAck(req ackRequest) {
+	if r.standby.Load() {
+		return
+	}
+	log.Debug().Msgf("Waiting for watchers to acknowledge %v", req)
+	r.acks.queue.AddAfter(req, pollInterval)
+}
+
+func (r *Reconciler) processNextAck(ctx context.Context) bool {
+	req, shutdown := r.acks.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer r.acks.queue.Done(req)
+
+	unlock := r.locks.lock(req.lockKey())
+	defer unlock()
+
+	var requeueAfter time.Duration
+	switch {
+	case req.kind == orgKind && req.event == Create:
+		requeueAfter = r.reconcileOrgCreate(ctx, req)
+	case req.kind == orgKind && req.event == Delete:
+		requeueAfter = r.reconcileOrgDelete(ctx, req)
+	case req.kind == projectKind && req.event == Create:
+		requeueAfter = r.reconcileProjectCreate(ctx, req)
+	case req.kind == projectKind && req.event == Delete:
+		requeueAfter = r.reconcileProjectDelete(ctx, req)
+	}
+
+	if requeueAfter > 0 {
+		r.acks.queue.AddAfter(req, requeueAfter)
+	} else {
+		r.acks.started.Delete(req)
+		log.Debug().Msgf("Stopped waiting for watchers of %v", req)
+	}
+	return true
+}
+
+// ackTimeout returns the configured time allowed for a watcher of req to respond, unless the watcher sets its own.
+func (r *Reconciler) ackTimeout(req ackRequest) time.Duration {
+	var secs int32
+	switch {
+	case req.kind == orgKind && req.event == Create:
+		secs = r.Config.OrgCreateTimeoutInSecs
+	case req.kind == orgKind && req.event == Delete:
+		secs = r.Config.OrgDeleteTimeoutInSecs
+	case req.kind == projectKind && req.event == Create:
+		secs = r.Config.ProjectCreateTimeoutInSecs
+	default:
+		secs = r.Config.ProjectDeleteTimeoutInSecs
+	}
+	return time.Duration(secs) * time.Second
+}
+
+/*
+ackStarted returns when req started waiting. The start time comes from the runtime object annotation.
+Objects created before the annotation existed are stamped on first sight; if that write fails,
+the start is kept in memory so that the deadlines are still enforced until the next restart.
+*/
+func (r *Reconciler) ackStarted(ctx context.Context, req ackRequest, obj runtimeObject) time.Time {
+	key := startedAnnotation(req.event)
+	started, err := time.Parse(time.RFC3339, obj.GetAnnotations()[key])
+	if err != nil {
+		if cached, ok := r.acks.started.Load(req); ok {
+			started, _ = cached.(time.Time)
+		} else {
+			markAckStarted(obj, req.event)
+			started, _ = time.Parse(time.RFC3339, obj.GetAnnotations()[key])
+			r.acks.started.Store(req, started)
+			if err := obj.Update(ctx); err != nil {
+				log.InfraErr(err).Msgf("Unable to record the start of %v, the deadline will not survive a restart", req)
+			}
+		}
+	}
+	return started
+}
+
+// clearAckStarted removes the start annotation and the retries once the wait is over, so a later retry gets
+// a full timeout. Once a create is over, every watcher is ready.
+func clearAckStarted(ctx context.Context, req ackRequest, obj runtimeObject) {
+	annotations := obj.GetAnnotations()
+	before := len(annotations)
+	delete(annotations, startedAnnotation(req.event))
+	if req.event == Create {
+		clearReadyWatchers(annotations)
+	}
+	clearRetries(annotations)
+	if len(annotations) == before {
+		return
+	}
+	obj.SetAnnotations(annotations)
+	if err := obj.Update(ctx); err != nil && !nexus_client.IsNotFound(err) {
+		log.InfraErr(err).Msgf("Unable to clear the start of %v", req)
+	}
+}
+
+// retryWatchers notifies watchers again, through an update of the runtime object.
+func retryWatchers(ctx context.Context, req ackRequest, obj runtimeObject, watchers []string) {
+	if len(watchers) == 0 {
+		return
+	}
+	now := time.Now()
+	for _, watcher := range watchers {
+		requestRetry(obj, watcher, now)
+	}
+	log.Debug().Msgf("Retrying watchers %v of %v", watchers, req)
+	if err := obj.Update(ctx); err != nil {
+		log.InfraErr(err).Msgf("Unable to retry watchers %v of %v", watchers, req)
+	}
+}
+
+// statusRetry logs that the status of req could not be set, and returns when to check req again.
+func statusRetry(req ackRequest, err error) time.Duration {
+	log.InfraErr(err).Msgf("Unable to set the status of %v, retrying", req)
+	return pollInterval
+}
+
+// nextPoll returns when to check req again, at the latest when the next watcher deadline passes.
+func nextPoll(remaining time.Duration) time.Duration {
+	if remaining <= 0 {
+		return pollInterval
+	}
+	return min(pollInterval, remaining)
+}
+
+func (r *Reconciler) reconcileOrgCreate(ctx context.Context, req ackRequest) time.Duration {
+	configOrg, err := getConfigOrg(r.Client, req.displayName)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			log.InfraErr(err).Msgf("Unable to get config org %s, retrying", req.displayName)
+			return pollInterval
+		}
+		return 0
+	}
+	hashName := configOrg.Name
+
+	runtimeOrg, err := r.Client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, req.displayName)
+	if err != nil {
+		if !nexus_client.IsNotFound(err) {
+			log.InfraErr(err).Msgf("Unable to get runtime org %s, retrying", req.displayName)
+			return pollInterval
+		}
+		return 0
+	}
+
+	plan, err := getOrgWatcherPlan(r.Client)
+	if err != nil {
+		log.InfraErr(err).Msgf("Creation of org %s (hashName: %s) failed", req.displayName, hashName)
+		if err := r.setOrgStatus(req.displayName, hashName, orgsv1.StatusIndicationError,
+			fmt.Sprintf("Org creation failed: unable to fetch expectedOrgWatchers, error: %v", err),
+			Create); err != nil {
+			return statusRetry(req, err)
+		}
+		return 0
+	}
+
+	success, err := isOrgCreationSuccessful(r.Client, req.displayName, runtimeOrg, plan.watchers())
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			log.InfraErr(err).Msgf("Creation of org %s (hashName: %s) failed", req.displayName, hashName)
+			if err := r.setOrgStatus(req.displayName, hashName, orgsv1.StatusIndicationError,
+				fmt.Sprintf("Org creation failed with an error: %v", err),
+				Create); err != nil {
+				return statusRetry(req, err)
+			}
+		}
+		return 0
+	}
+	if success {
+		if err := r.setOrgStatus(req.displayName, hashName, orgsv1.StatusIndicationIdle,
+			fmt.Sprintf("Org %s CREATE is complete", req.displayName),
+			Create); err != nil {
+			return statusRetry(req, err)
+		}
+		log.Debug().Msgf("Creation of org %s (hashName: %s) is successful", req.displayName, hashName)
+		clearAckStarted(ctx, req, runtimeOrg)
+		return 0
+	}
+
+	verdict := plan.evaluate(req.event, orgWatcherStates(ctx, runtimeOrg), runtimeOrg.GetAnnotations(),
+		r.ackStarted(ctx, req, runtimeOrg), r.ackTimeout(req), time.Now())
+	r.metrics.observeTimeouts(req.kind, req.event, verdict, verdict.done() || len(verdict.failures) > 0)
+
+	// If a required watcher failed, set error state.
+	if len(verdict.failures) > 0 {
+		log.Debug().Msgf("Creation of org %s (hashName: %s) failed: %s, marking as 'ERROR'",
+			req.displayName, hashName, strings.Join(verdict.failures, "; "))
+		if err := r.setOrgStatus(req.displayName, hashName, orgsv1.StatusIndicationError,
+			withWarnings(fmt.Sprintf("Org creation failed: %s", strings.Join(verdict.failures, "; ")),
+				verdict.warnings),
+			Create); err != nil {
+			return statusRetry(req, err)
+		}
+		clearAckStarted(ctx, req, runtimeOrg)
+		return 0
+	}
+	// If only optional or ignored watchers are missing, the org is created with warnings.
+	if verdict.done() {
+		if err := r.setOrgStatus(req.displayName, hashName, orgsv1.StatusIndicationIdle,
+			withWarnings(fmt.Sprintf("Org %s CREATE is complete", req.displayName), verdict.warnings),
+			Create); err != nil {
+			return statusRetry(req, err)
+		}
+		log.Debug().Msgf("Creation of org %s (hashName: %s) is successful, with warnings %v",
+			req.displayName, hashName, verdict.warnings)
+		clearAckStarted(ctx, req, runtimeOrg)
+		return 0
+	}
+
+	retryWatchers(ctx, req, runtimeOrg, verdict.retry)
+	if err := signalOrgWatchers(ctx, r.Client, runtimeOrg, req.event, verdict.givenUp); err != nil {
+		log.InfraErr(err).Msgf("Unable to signal the ready watchers of %v", req)
+	}
+	refreshOrgConditions(r.Client, req.displayName, req.event)
+	return nextPoll(verdict.next)
+}
+
+func (r *Reconciler) reconcileOrgDelete(ctx context.Context, req ackRequest) time.Duration {
+	configOrg, err := getConfigOrg(r.Client, req.displayName)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			log.InfraErr(err).Msgf("Unable to get config org %s, retrying", req.displayName)
+			return pollInterval
+		}
+		return 0
+	}
+	hashName := configOrg.Name
+
+	runtimeOrg, err := r.Client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, req.displayName)
+	if err != nil {
+		if !nexus_client.IsNotFound(err) {
+			log.InfraErr(err).Msgf("Unable to get runtime org %s, retrying", req.displayName)
+			return pollInterval
+		}
+		log.Debug().Msgf("Deletion of org %s (hashName: %s) is successful", req.displayName, hashName)
+		return 0
+	}
+
+	plan, err := getOrgWatcherPlan(r.Client)
+	if err != nil {
+		log.InfraErr(err).Msgf("Deletion of org %s (hashName: %s) failed", req.displayName, hashName)
+		if err := r.setOrgStatus(req.displayName, hashName, orgsv1.StatusIndicationError,
+			fmt.Sprintf("Org deletion failed: unable to fetch expectedOrgWatchers, error: %v", err),
+			Delete); err != nil {
+			return statusRetry(req, err)
+		}
+		return 0
+	}
+
+	success, _, err := isOrgDeletionSuccessful(r.Client, req.displayName, runtimeOrg, plan.watchers())
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			log.InfraErr(err).Msgf("Deletion of org %s (hashName: %s) failed", req.displayName, hashName)
+			if err := r.setOrgStatus(req.displayName, hashName,
+				orgsv1.StatusIndicationError,
+				fmt.Sprintf("Org deletion failed with an error: %v", err),
+				Delete); err != nil {
+				return statusRetry(req, err)
+			}
+		}
+		return 0
+	}
+	if success {
+		log.Debug().Msgf("Deletion of org %s (hashName: %s) is successful", req.displayName, hashName)
+		return 0
+	}
+
+	verdict := plan.evaluate(req.event, orgWatcherStates(ctx, runtimeOrg), runtimeOrg.GetAnnotations(),
+		r.ackStarted(ctx, req, runtimeOrg), r.ackTimeout(req), time.Now())
+	r.metrics.observeTimeouts(req.kind, req.event, verdict, verdict.done() || len(verdict.failures) > 0)
+
+	// If a required watcher failed, then mark org as error state.
+	if len(verdict.failures) > 0 {
+		log.Debug().Msgf("Deletion of org %s (hashName: %s) failed: %s, marking as 'ERROR'",
+			req.displayName, hashName, strings.Join(verdict.failures, "; "))
+		if err := r.setOrgStatus(req.displayName, hashName,
+			orgsv1.StatusIndicationError,
+			withWarnings(fmt.Sprintf("Org deletion failed: %s", strings.Join(verdict.failures, "; ")),
+				verdict.warnings),
+			Delete); err != nil {
+			return statusRetry(req, err)
+		}
+		clearAckStarted(ctx, req, runtimeOrg)
+		return 0
+	}
+	// If only optional or ignored watchers are left, the org is deleted without them.
+	if verdict.done() {
+		log.Debug().Msgf("Deleting org %s (hashName: %s), with warnings %v",
+			req.displayName, hashName, verdict.warnings)
+		configOrg.SetFinalizers([]string{})
+		if err := configOrg.Update(ctx); err != nil && !nexus_client.IsNotFound(err) {
+			log.InfraErr(err).Msgf("Failed to remove the finalizers of config Org %s (hashName %s), retrying",
+				req.displayName, hashName)
+			return pollInterval
+		}
+		if err := runtimeOrg.Delete(ctx); err != nil && !nexus_client.IsNotFound(err) {
+			log.InfraErr(err).Msgf("Failed to delete runtime Org %s, retrying", req.displayName)
+			return pollInterval
+		}
+		r.orgDeleted(configOrg)
+		return 0
+	}
+
+	retryWatchers(ctx, req, runtimeOrg, verdict.retry)
+	if err := signalOrgWatchers(ctx, r.Client, runtimeOrg, req.event, verdict.givenUp); err != nil {
+		log.InfraErr(err).Msgf("Unable to signal the ready watchers of %v", req)
+	}
+	refreshOrgConditions(r.Client, req.displayName, req.event)
+	return nextPoll(verdict.next)
+}
+
+func (r *Reconciler) reconcileProjectCreate(ctx context.Context, req ackRequest) time.Duration {
+	configProject, err := getConfigProject(r.Client, req.orgName, req.folderName, req.displayName)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			log.InfraErr(err).Msgf("Unable to get config project %s, retrying", req.displayName)
+			return pollInterval
+		}
+		return 0
+	}
+	hashName := configProject.Name
+
+	runtimeProject, err := r.Client.TenancyMultiTenancy().Runtime().Orgs(req.orgName).Folders(req.folderName).
+		GetProjects(ctx, req.displayName)
+	if err != nil {
+		if !nexus_client.IsNotFound(err) {
+			log.InfraErr(err).Msgf("Unable to get runtime project %s, retrying", req.displayName)
+			return pollInterval
+		}
+		return 0
+	}
+
+	plan, err := getProjectWatcherPlan(r.Client)
+	if err != nil {
+		log.InfraErr(err).Msgf("Creation of project %s (hashName: %s) failed", req.displayName, hashName)
+		if err := r.setProjectStatus(req.displayName, hashName, req.orgName, req.folderName,
+			projectv1.StatusIndicationError,
+			fmt.Sprintf("Project creation failed: unable to fetch expectedProjectWatchers, error: %v", err),
+			Create); err != nil {
+			return statusRetry(req, err)
+		}
+		return 0
+	}
+
+	success, err := isProjectCreationSuccessful(r.Client, req.displayName, req.orgName, req.folderName,
+		runtimeProject, plan.watchers())
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			log.InfraErr(err).Msgf("Creation of project %s (hashName: %s) failed", req.displayName, hashName)
+			if err := r.setProjectStatus(req.displayName, hashName, req.orgName, req.folderName,
+				projectv1.StatusIndicationError,
+				fmt.Sprintf("Project creation failed with an error: %v", err),
+				Create); err != nil {
+				return statusRetry(req, err)
+			}
+		}
+		return 0
+	}
+	if success {
+		if err := r.setProjectStatus(req.displayName, hashName, req.orgName, req.folderName,
+			projectv1.StatusIndicationIdle,
+			fmt.Sprintf("Project %s CREATE is complete", req.displayName),
+			Create); err != nil {
+			return statusRetry(req, err)
+		}
+		log.Debug().Msgf("Creation of project %s (hashName: %s) is successful", req.displayName, hashName)
+		clearAckStarted(ctx, req, runtimeProject)
+		return 0
+	}
+
+	verdict := plan.evaluate(req.event, projectWatcherStates(ctx, runtimeProject), runtimeProject.GetAnnotations(),
+		r.ackStarted(ctx, req, runtimeProject), r.ackTimeout(req), time.Now())
+	r.metrics.observeTimeouts(req.kind, req.event, verdict, verdict.done() || len(verdict.failures) > 0)
+
+	// If a required watcher failed, set error state.
+	if len(verdict.failures) > 0 {
+		log.Debug().Msgf("Creation of project %s (hashName: %s) failed: %s, marking as 'ERROR'",
+			req.displayName, hashName, strings.Join(verdict.failures, "; "))
+		if err := r.setProjectStatus(req.displayName, hashName, req.orgName, req.folderName,
+			projectv1.StatusIndicationError,
+			withWarnings(fmt.Sprintf("Project creation failed: %s", strings.Join(verdict.failures, "; ")),
+				verdict.warnings),
+			Create); err != nil {
+			return statusRetry(req, err)
+		}
+		clearAckStarted(ctx, req, runtimeProject)
+		return 0
+	}
+	// If only optional or ignored watchers are missing, the project is created with warnings.
+	if verdict.done() {
+		if err := r.setProjectStatus(req.displayName, hashName, req.orgName, req.folderName,
+			projectv1.StatusIndicationIdle,
+			withWarnings(fmt.Sprintf("Project %s CREATE is complete", req.displayName), verdict.warnings),
+			Create); err != nil {
+			return statusRetry(req, err)
+		}
+		log.Debug().Msgf("Creation of project %s (hashName: %s) is successful, with warnings %v",
+			req.displayName, hashName, verdict.warnings)
+		clearAckStarted(ctx, req, runtimeProject)
+		return 0
+	}
+
+	retryWatchers(ctx, req, runtimeProject, verdict.retry)
+	if err := signalProjectWatchers(ctx, r.Client, runtimeProject, req.event, verdict.givenUp); err != nil {
+		log.InfraErr(err).Msgf("Unable to signal the ready watchers of %v", req)
+	}
+	refreshProjectConditions(r.Client, req.displayName, req.orgName, req.folderName, req.event)
+	return nextPoll(verdict.next)
+}
+
+func (r *Reconciler) reconcileProjectDelete(ctx context.Context, req ackRequest) time.Duration {
+	configProject, err := getConfigProject(r.Client, req.orgName, req.folderName, req.displayName)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			log.InfraErr(err).Msgf("Unable to get config project %s, retrying", req.displayName)
+			return pollInterval
+		}
+		return 0
+	}
+	hashName := configProject.Name
+
+	runtimeProject, err := r.Client.TenancyMultiTenancy().Runtime().Orgs(req.orgName).Folders(req.folderName).
+		GetProjects(ctx, req.displayName)
+	if err != nil {
+		if !nexus_client.IsNotFound(err) {
+			log.InfraErr(err).Msgf("Unable to get runtime project %s, retrying", req.displayName)
+			return pollInterval
+		}
+		log.Debug().Msgf("Deletion of project %s (hashName: %s) is successful", req.displayName, hashName)
+		return 0
+	}
+
+	plan, err := getProjectWatcherPlan(r.Client)
+	if err != nil {
+		log.InfraErr(err).Msgf("Deletion of project %s (hashName: %s) failed", req.displayName, hashName)
+		if err := r.setProjectStatus(req.displayName, hashName, req.orgName, req.folderName,
+			projectv1.StatusIndicationError,
+			fmt.Sprintf("Project deletion failed: unable to fetch expectedProjectWatchers, error: %v", err),
+			Delete); err != nil {
+			return statusRetry(req, err)
+		}
+		return 0
+	}
+
+	success, _, err := isProjectDeletionSuccessful(r.Client,
+		req.displayName, req.orgName, req.folderName, runtimeProject, plan.watchers())
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			log.InfraErr(err).Msgf("Deletion of project %s (hashName: %s) failed", req.displayName, hashName)
+			if err := r.setProjectStatus(req.displayName, hashName, req.orgName, req.folderName,
+				projectv1.StatusIndicationError,
+				fmt.Sprintf("Project deletion failed with an error: %v", err),
+				Delete); err != nil {
+				return statusRetry(req, err)
+			}
+		}
+		return 0
+	}
+	if success {
+		log.Debug().Msgf("Deletion of project %s (hashName: %s) is successful", req.displayName, hashName)
+		return 0
+	}
+
+	verdict := plan.evaluate(req.event, projectWatcherStates(ctx, runtimeProject), runtimeProject.GetAnnotations(),
+		r.ackStarted(ctx, req, runtimeProject), r.ackTimeout(req), time.Now())
+	r.metrics.observeTimeouts(req.kind, req.event, verdict, verdict.done() || len(verdict.failures) > 0)
+
+	// If a required watcher failed, then mark project as error state.
+	if len(verdict.failures) > 0 {
+		log.Debug().Msgf("Deletion of project %s (hashName: %s) failed: %s, marking as 'ERROR'",
+			req.displayName, hashName, strings.Join(verdict.failures, "; "))
+		if err := r.setProjectStatus(req.displayName, hashName, req.orgName, req.folderName,
+			projectv1.StatusIndicationError,
+			withWarnings(fmt.Sprintf("Project deletion failed: %s", strings.Join(verdict.failures, "; ")),
+				verdict.warnings),
+			Delete); err != nil {
+			return statusRetry(req, err)
+		}
+		clearAckStarted(ctx, req, runtimeProject)
+		return 0
+	}
+	// If only optional or ignored watchers are left, the project is deleted without them.
+	if verdict.done() {
+		log.Debug().Msgf("Deleting project %s (hashName: %s), with warnings %v",
+			req.displayName, hashName, verdict.warnings)
+		configProject.SetFinalizers([]string{})
+		if err := configProject.Update(ctx); err != nil && !nexus_client.IsNotFound(err) {
+			log.InfraErr(err).Msgf("Failed to remove the finalizers of config Project %s (hashName %s), retrying",
+				req.displayName, hashName)
+			return pollInterval
+		}
+		if err := runtimeProject.Delete(ctx); err != nil && !nexus_client.IsNotFound(err) {
+			log.InfraErr(err).Msgf("Failed to delete runtime Project %s, retrying", req.displayName)
+			return pollInterval
+		}
+		r.projectDeleted(configProject)
+		return 0
+	}
+
+	retryWatchers(ctx, req, runtimeProject, verdict.retry)
+	if err := signalProjectWatchers(ctx, r.Client, runtimeProject, req.event, verdict.givenUp); err != nil {
+		log.InfraErr(err).Msgf("Unable to signal the ready watchers of %v", req)
+	}
+	refreshProjectConditions(r.Client, req.displayName, req.orgName, req.folderName, req.event)
+	return nextPoll(verdict.next)
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package tenancy_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	orgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/config"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// runtimeObject is a runtime Org whose updates are counted, and fail with err.
+type runtimeObject struct {
+	metav1.ObjectMeta
+	updates int
+	err     error
+}
+
+func (o *runtimeObject) Update(context.Context) error {
+	o.updates++
+	return o.err
+}
+
+var _ = ginkgo.Describe("Acknowledgements", func() {
+	ctx := context.Background()
+	var reconciler *tenancy.Reconciler
+
+	ginkgo.BeforeEach(func() {
+		reconciler = tenancy.NewReconciler(nexus_client.NewFakeClient(), &config.Config{})
+	})
+
+	ginkgo.It("should record the start of a wait once", func() {
+		obj := &metav1.ObjectMeta{Name: "acme"}
+		tenancy.MarkAckStarted(obj, tenancy.Create)
+		started, err := time.Parse(time.RFC3339, obj.Annotations[tenancy.CreateStartedAnnotation])
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(started).To(gomega.BeTemporally("~", time.Now(), time.Minute))
+
+		obj.Annotations[tenancy.CreateStartedAnnotation] = "2025-01-01T00:00:00Z"
+		tenancy.MarkAckStarted(obj, tenancy.Create)
+		gomega.Expect(obj.Annotations).To(gomega.HaveKeyWithValue(tenancy.CreateStartedAnnotation, "2025-01-01T00:00:00Z"))
+	})
+
+	ginkgo.It("should count a deadline from the start recorded before a restart", func() {
+		recorded := time.Now().Add(-10 * time.Minute).UTC().Truncate(time.Second)
+		obj := &runtimeObject{ObjectMeta: metav1.ObjectMeta{
+			Name:        "acme",
+			Annotations: map[string]string{tenancy.CreateStartedAnnotation: recorded.Format(time.RFC3339)},
+		}}
+		gomega.Expect(reconciler.AckStarted(ctx, obj)).To(gomega.BeTemporally("==", recorded))
+		gomega.Expect(obj.updates).To(gomega.BeZero())
+	})
+
+	ginkgo.It("should record the start of a wait that has none", func() {
+		obj := &runtimeObject{ObjectMeta: metav1.ObjectMeta{Name: "acme"}}
+		gomega.Expect(reconciler.AckStarted(ctx, obj)).To(gomega.BeTemporally("~", time.Now(), time.Minute))
+		gomega.Expect(obj.Annotations).To(gomega.HaveKey(tenancy.CreateStartedAnnotation))
+		gomega.Expect(obj.updates).To(gomega.Equal(1))
+	})
+
+	ginkgo.It("should keep the start of a wait it cannot record until the next restart", func() {
+		obj := &runtimeObject{ObjectMeta: metav1.ObjectMeta{Name: "acme"}, err: errors.New("unavailable")}
+		started := reconciler.AckStarted(ctx, obj)
+
+		// The runtime Org is read again without the annotation that was not written.
+		obj.Annotations = nil
+		gomega.Expect(reconciler.AckStarted(ctx, obj)).To(gomega.BeTemporally("==", started))
+		gomega.Expect(obj.updates).To(gomega.Equal(1))
+	})
+
+	ginkgo.It("should resume the orgs waiting for their watchers on start", func() {
+		deleted := metav1.Now()
+		for _, tt := range []struct {
+			status   orgsv1.TenancyRequestStatus
+			deleted  *metav1.Time
+			expected string
+		}{
+			{status: orgsv1.StatusIndicationInProgress, expected: "org acme CREATE"},
+			{status: orgsv1.StatusIndicationInProgress, deleted: &deleted, expected: "org acme DELETE"},
+			{status: orgsv1.StatusIndicationIdle},
+			{status: orgsv1.StatusIndicationError},
+		} {
+			org := &nexus_client.OrgOrg{Org: &orgsv1.Org{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "acme-hash",
+					Labels:            map[string]string{"nexus/display_name": "acme"},
+					DeletionTimestamp: tt.deleted,
+				},
+				Status: orgsv1.OrgNexusStatus{OrgStatus: orgsv1.OrgStatus{StatusIndicator: tt.status}},
+			}}
+			gomega.Expect(reconciler.ResumeOrg(org)).To(gomega.Equal(tt.expected), "org %s", tt.status)
+		}
+	})
+
+	ginkgo.It("should resume the projects waiting for their watchers on start", func() {
+		project := &nexus_client.ProjectProject{Project: &projectv1.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "foo-hash",
+				Labels: map[string]string{
+					"nexus/display_name":                         "foo",
+					"orgs.org.edge-orchestrator.intel.com":       "acme",
+					"folders.folder.edge-orchestrator.intel.com": "team-a",
+				},
+			},
+			Status: projectv1.ProjectNexusStatus{
+				ProjectStatus: projectv1.ProjectStatus{StatusIndicator: projectv1.StatusIndicationInProgress},
+			},
+		}}
+		gomega.Expect(reconciler.ResumeProject(project)).To(gomega.Equal("project acme/team-a/foo CREATE"))
+
+		project.Status.ProjectStatus.StatusIndicator = projectv1.StatusIndicationIdle
+		gomega.Expect(reconciler.ResumeProject(project)).To(gomega.BeEmpty())
+	})
+})
@@ -0,0 +1,204 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"context"
+	"slices"
+
+	orgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	orgactivewatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgactivewatcher.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	conditionWaitingForPrerequisites = "Waiting for the watchers ordered before it"
+	conditionWaitingForDependents    = "Waiting for the watchers ordered after it"
+	conditionWaitingForAck           = "Waiting for the watcher to acknowledge"
+	conditionWaitingForRelease       = "Waiting for the watcher to remove its active watcher"
+	conditionReleased                = "Released"
+)
+
+// watcherCondition is the state of one watcher on the last request, common to orgs and projects.
+type watcherCondition struct {
+	watcher            string
+	state              string
+	message            string
+	lastTransitionTime uint64
+	attempts           int32
+}
+
+/*
+conditions returns the state of every registered watcher on event, from the active watchers and the annotations of
+the runtime object obj. The transition time of a watcher whose state did not change since previous is kept.
+*/
+func (p watcherPlan) conditions(event Event, obj metav1.Object, states watcherStates,
+	previous map[string]watcherCondition, now uint64,
+) []watcherCondition {
+	names := getMapKeys(p.watchers())
+	slices.Sort(names)
+	conditions := make([]watcherCondition, 0, len(names))
+	for _, name := range names {
+		state, active := states[name]
+		ready := WatcherReady(obj, name)
+		condition := watcherCondition{watcher: name}
+		if ready || active {
+			condition.attempts = int32(1 + WatcherRetries(obj, name)) //nolint:gosec // Retries are few.
+		}
+
+		switch {
+		case event == Create && active:
+			condition.state, condition.message = state.status, state.message
+		case event == Create && ready:
+			condition.state = string(orgactivewatcherv1.StatusIndicationInProgress)
+			condition.message = conditionWaitingForAck
+		case event == Create:
+			condition.state = string(orgsv1.StatusIndicationUnspecified)
+			condition.message = conditionWaitingForPrerequisites
+		case !active:
+			condition.state = string(orgactivewatcherv1.StatusIndicationIdle)
+			condition.message = conditionReleased
+		case state.status == string(orgactivewatcherv1.StatusIndicationError):
+			condition.state, condition.message = state.status, state.message
+		case ready:
+			condition.state = string(orgactivewatcherv1.StatusIndicationInProgress)
+			condition.message = conditionWaitingForRelease
+		default:
+			condition.state = string(orgsv1.StatusIndicationUnspecified)
+			condition.message = conditionWaitingForDependents
+		}
+
+		switch last, ok := previous[name]; {
+		case ok && last.state == condition.state:
+			condition.lastTransitionTime = last.lastTransitionTime
+		case event == Create && active && state.timeStamp > 0:
+			condition.lastTransitionTime = state.timeStamp
+		default:
+			condition.lastTransitionTime = now
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+// orgConditions returns the conditions of the OrgWatchers on the runtime org of displayName.
+// The previous conditions are returned as they are if the runtime org or the watchers cannot be read.
+func orgConditions(client *nexus_client.Clientset, displayName string, event Event,
+	previous []orgsv1.WatcherCondition,
+) []orgsv1.WatcherCondition {
+	ctx := context.Background()
+	runtimeOrg, err := client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, displayName)
+	if err != nil {
+		return previous
+	}
+	plan, err := getOrgWatcherPlan(client)
+	if err != nil {
+		return previous
+	}
+
+	last := make(map[string]watcherCondition, len(previous))
+	for _, c := range previous {
+		last[c.Watcher] = watcherCondition{state: string(c.State), lastTransitionTime: c.LastTransitionTime}
+	}
+	var conditions []orgsv1.WatcherCondition
+	for _, c := range plan.conditions(event, runtimeOrg, orgWatcherStates(ctx, runtimeOrg), last, safeUnixTime()) {
+		conditions = append(conditions, orgsv1.WatcherCondition{
+			Watcher:            c.watcher,
+			State:              orgsv1.TenancyRequestStatus(c.state),
+			Message:            c.message,
+			LastTransitionTime: c.lastTransitionTime,
+			Attempts:           c.attempts,
+		})
+	}
+	return conditions
+}
+
+// projectConditions returns the conditions of the ProjectWatchers on the runtime project of displayName.
+// The previous conditions are returned as they are if the runtime project or the watchers cannot be read.
+func projectConditions(client *nexus_client.Clientset, displayName, parentOrgName, parentFolderName string,
+	event Event, previous []projectv1.WatcherCondition,
+) []projectv1.WatcherCondition {
+	ctx := context.Background()
+	runtimeProject, err := client.TenancyMultiTenancy().Runtime().Orgs(parentOrgName).Folders(parentFolderName).
+		GetProjects(ctx, displayName)
+	if err != nil {
+		return previous
+	}
+	plan, err := getProjectWatcherPlan(client)
+	if err != nil {
+		return previous
+	}
+
+	last := make(map[string]watcherCondition, len(previous))
+	for _, c := range previous {
+		last[c.Watcher] = watcherCondition{state: string(c.State), lastTransitionTime: c.LastTransitionTime}
+	}
+	var conditions []projectv1.WatcherCondition
+	for _, c := range plan.conditions(event, runtimeProject, projectWatcherStates(ctx, runtimeProject), last,
+		safeUnixTime()) {
+		conditions = append(conditions, projectv1.WatcherCondition{
+			Watcher:            c.watcher,
+			State:              projectv1.TenancyRequestStatus(c.state),
+			Message:            c.message,
+			LastTransitionTime: c.lastTransitionTime,
+			Attempts:           c.attempts,
+		})
+	}
+	return conditions
+}
+
+// refreshOrgConditions updates the conditions of the org of displayName while it waits for its watchers,
+// without changing its status indicator.
+func refreshOrgConditions(client *nexus_client.Clientset, displayName string, event Event) {
+	configOrg, err := getConfigOrg(client, displayName)
+	if err != nil {
+		return
+	}
+	status := configOrg.Status.OrgStatus
+	conditions := orgConditions(client, displayName, event, status.Conditions)
+	if slices.Equal(conditions, status.Conditions) {
+		return
+	}
+	status.Conditions = conditions
+	if err := configOrg.SetOrgStatus(context.Background(), &status); err != nil {
+		log.InfraErr(err).Msgf("Unable to update the watcher conditions of org %s", displayName)
+	}
+}
+
+// refreshProjectConditions updates the conditions of the project of displayName while it waits for its watchers,
+// without changing its status indicator.
+func refreshProjectConditions(client *nexus_client.Clientset, displayName, parentOrgName, parentFolderName string,
+	event Event,
+) {
+	configProject, err := getConfigProject(client, parentOrgName, parentFolderName, displayName)
+	if err != nil {
+		return
+	}
+	status := configProject.Status.ProjectStatus
+	conditions := projectConditions(client, displayName, parentOrgName, parentFolderName, event, status.Conditions)
+	if slices.Equal(conditions, status.Conditions) {
+		return
+	}
+	status.Conditions = conditions
+	if err := configProject.SetProjectStatus(context.Background(), &status); err != nil {
+		log.InfraErr(err).Msgf("Unable to update the watcher conditions of project %s", displayName)
+	}
+}
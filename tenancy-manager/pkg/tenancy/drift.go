@@ -0,0 +1,515 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	orgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	runtimefoldersv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimefolder.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Drifts between the config and runtime trees.
+const (
+	// driftMissedEvent is a config Org or Project whose add event was never processed.
+	driftMissedEvent = "missed_event"
+	// driftMissingRuntime is a created config Org, Folder or Project without its runtime counterpart.
+	driftMissingRuntime = "missing_runtime"
+	// driftOrphanedRuntime is a runtime Org, Folder or Project without its config counterpart.
+	driftOrphanedRuntime = "orphaned_runtime"
+	// driftStaleStatus is a status that does not refer to the UID of the runtime counterpart.
+	driftStaleStatus = "stale_status"
+)
+
+const (
+	folderKind = "folder"
+
+	eventOrgDrift     = "OrgDrift"
+	eventFolderDrift  = "FolderDrift"
+	eventProjectDrift = "ProjectDrift"
+
+	// driftGracePeriod keeps the runtime nodes created recently, whose config counterpart may not be cached yet.
+	driftGracePeriod = time.Minute
+)
+
+// driftOf returns the drift of a config Org or Project from its status and the UID of its runtime counterpart,
+// empty if it does not exist. Orgs and Projects being deleted, in progress or in error are left to their operation.
+func driftOf(deleting bool, status, statusUID, runtimeUID string) string {
+	switch {
+	case deleting:
+		return ""
+	case status == "":
+		return driftMissedEvent
+	case status != string(orgsv1.StatusIndicationIdle):
+		return ""
+	case runtimeUID == "":
+		return driftMissingRuntime
+	case statusUID != runtimeUID:
+		return driftStaleStatus
+	}
+	return ""
+}
+
+// orphaned reports whether a runtime node created at created, whose config counterpart was not found,
+// should be collected.
+func orphaned(created, now time.Time) bool {
+	return now.Sub(created) >= driftGracePeriod
+}
+
+// reconcileDrift compares the config and runtime trees every interval until ctx is done.
+func (r *Reconciler) reconcileDrift(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.checkDrift(ctx)
+		}
+	}
+}
+
+/*
+checkDrift walks the config tree, then the runtime tree, and queues a repair for every drift found.
+The repairs run on the Org and Project queues, under the lock of the object they repair, and check the drift
+again before acting. Runtime nodes are collected only if the whole config tree could be read.
+*/
+func (r *Reconciler) checkDrift(ctx context.Context) {
+	configRoot, err := r.Client.TenancyMultiTenancy().GetConfig(ctx)
+	if err != nil {
+		log.InfraErr(err).Msg("Unable to get the config root to check for drift")
+		return
+	}
+	runtimeRoot, err := r.Client.TenancyMultiTenancy().GetRuntime(ctx)
+	if err != nil {
+		log.InfraErr(err).Msg("Unable to get the runtime root to check for drift")
+		return
+	}
+	orgs, err := configRoot.GetAllOrgs(ctx)
+	if err != nil {
+		log.InfraErr(err).Msg("Unable to list config Orgs to check for drift")
+		return
+	}
+	defer r.metrics.driftChecked(time.Now())
+
+	configured := map[string]struct{}{}
+	complete := true
+	for _, org := range orgs {
+		configured[orgLockKey(org.DisplayName())] = struct{}{}
+		r.checkOrgDrift(ctx, org)
+		r.updateUsage(org.DisplayName())
+		r.syncRuntimeOrg(org.DisplayName())
+		folders, err := org.GetAllFolders(ctx)
+		if err != nil {
+			log.InfraErr(err).Msgf("Unable to list the config Folders of org %s to check for drift", org.DisplayName())
+			complete = false
+			continue
+		}
+		for _, folder := range folders {
+			configured[folderKey(org.DisplayName(), folder.DisplayName())] = struct{}{}
+			projects, err := folder.GetAllProjects(ctx)
+			if err != nil {
+				log.InfraErr(err).Msgf("Unable to list the config Projects of folder %s/%s to check for drift",
+					org.DisplayName(), folder.DisplayName())
+				complete = false
+				continue
+			}
+			for _, project := range projects {
+				// A moved project keeps its runtime Project, and the runtime Folder of the latter.
+				runtimeFolder := RuntimeFolder(project)
+				configured[folderKey(org.DisplayName(), runtimeFolder)] = struct{}{}
+				configured[projectLockKey(org.DisplayName(), runtimeFolder, project.DisplayName())] = struct{}{}
+				r.checkProjectDrift(ctx, project)
+				r.syncRuntimeProject(org.DisplayName(), runtimeFolder, project.DisplayName())
+			}
+		}
+	}
+	// The Networks are reconciled on the way, for the events they missed.
+	networks, err := r.Client.Network().ListNetworks(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.InfraErr(err).Msg("Unable to list config Networks to reconcile them")
+	}
+	for _, network := range networks {
+		r.syncNetwork(network)
+	}
+	if !complete {
+		log.Warn().Msg("Skipping the collection of orphaned runtime nodes, the config tree was read partially")
+		return
+	}
+
+	runtimeOrgs, err := runtimeRoot.GetAllOrgs(ctx)
+	if err != nil {
+		log.InfraErr(err).Msg("Unable to list runtime Orgs to check for drift")
+		return
+	}
+	now := time.Now()
+	for _, runtimeOrg := range runtimeOrgs {
+		orgName := runtimeOrg.DisplayName()
+		if _, ok := configured[orgLockKey(orgName)]; !ok {
+			if orphaned(runtimeOrg.CreationTimestamp.Time, now) {
+				r.collectRuntimeOrg(orgName)
+			}
+			continue
+		}
+		folders, err := runtimeOrg.GetAllFolders(ctx)
+		if err != nil {
+			log.InfraErr(err).Msgf("Unable to list the runtime Folders of org %s to check for drift", orgName)
+			continue
+		}
+		for _, folder := range folders {
+			if _, ok := configured[folderKey(orgName, folder.DisplayName())]; !ok {
+				if orphaned(folder.CreationTimestamp.Time, now) {
+					r.collectRuntimeFolder(orgName, folder.DisplayName())
+				}
+				continue
+			}
+			projects, err := folder.GetAllProjects(ctx)
+			if err != nil {
+				log.InfraErr(err).Msgf("Unable to list the runtime Projects of folder %s/%s to check for drift",
+					orgName, folder.DisplayName())
+				continue
+			}
+			for _, project := range projects {
+				if _, ok := configured[projectLockKey(orgName, folder.DisplayName(), project.DisplayName())]; !ok &&
+					orphaned(project.CreationTimestamp.Time, now) {
+					r.collectRuntimeProject(orgName, folder.DisplayName(), project.DisplayName())
+				}
+			}
+		}
+	}
+}
+
+func folderKey(orgName, folderName string) string {
+	return "folder/" + orgName + "/" + folderName
+}
+
+// runtimeOrgUID returns the UID of the runtime Org of orgName, empty if it does not exist.
+func (r *Reconciler) runtimeOrgUID(ctx context.Context, orgName string) (string, error) {
+	runtimeOrg, err := r.Client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, orgName)
+	if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(runtimeOrg.UID), nil
+}
+
+// runtimeProjectUID returns the UID of the runtime Project of projectName, empty if it does not exist.
+func (r *Reconciler) runtimeProjectUID(ctx context.Context, orgName, folderName, projectName string) (string, error) {
+	runtimeProject, err := r.Client.TenancyMultiTenancy().Runtime().Orgs(orgName).Folders(folderName).
+		GetProjects(ctx, projectName)
+	if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(runtimeProject.UID), nil
+}
+
+func (r *Reconciler) orgDrift(ctx context.Context, org *nexus_client.OrgOrg) (string, string, error) {
+	runtimeUID, err := r.runtimeOrgUID(ctx, org.DisplayName())
+	if err != nil {
+		return "", "", err
+	}
+	status := org.Status.OrgStatus
+	return driftOf(!org.DeletionTimestamp.IsZero(), string(status.StatusIndicator), status.UID, runtimeUID), runtimeUID, nil
+}
+
+func (r *Reconciler) projectDrift(ctx context.Context, project *nexus_client.ProjectProject) (string, string, error) {
+	parentOrgName := project.GetLabels()["orgs.org.edge-orchestrator.intel.com"]
+	parentFolderName := RuntimeFolder(project)
+	runtimeUID, err := r.runtimeProjectUID(ctx, parentOrgName, parentFolderName, project.DisplayName())
+	if err != nil {
+		return "", "", err
+	}
+	status := project.Status.ProjectStatus
+	return driftOf(!project.DeletionTimestamp.IsZero(), string(status.StatusIndicator), status.UID, runtimeUID),
+		runtimeUID, nil
+}
+
+// checkOrgDrift queues the repair of org if it drifted. The config Folders of an IDLE Org are checked
+// on every pass, as their runtime counterparts are created with it.
+func (r *Reconciler) checkOrgDrift(ctx context.Context, org *nexus_client.OrgOrg) {
+	drift, _, err := r.orgDrift(ctx, org)
+	if err != nil {
+		log.InfraErr(err).Msgf("Unable to check org %s for drift", org.DisplayName())
+		return
+	}
+	idle := org.Status.OrgStatus.StatusIndicator == orgsv1.StatusIndicationIdle && org.DeletionTimestamp.IsZero()
+	if drift == "" && !idle {
+		return
+	}
+	if drift == "" && !r.foldersDrifted(ctx, org) {
+		return
+	}
+	displayName := org.DisplayName()
+	r.orgs.enqueue(workKey{event: eventOrgDrift, hashName: org.Name}, task{
+		lock: orgLockKey(displayName),
+		run:  func() error { return r.repairOrg(displayName) },
+	})
+}
+
+// foldersDrifted reports whether a config Folder of org has no runtime counterpart.
+func (r *Reconciler) foldersDrifted(ctx context.Context, org *nexus_client.OrgOrg) bool {
+	folders, err := org.GetAllFolders(ctx)
+	if err != nil {
+		return false
+	}
+	for _, folder := range folders {
+		_, err := r.Client.TenancyMultiTenancy().Runtime().Orgs(org.DisplayName()).GetFolders(ctx, folder.DisplayName())
+		if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// repairOrg repairs the drift of the config Org of displayName.
+func (r *Reconciler) repairOrg(displayName string) error {
+	ctx := context.Background()
+	org, err := getConfigOrg(r.Client, displayName)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get config Org: %w", err)
+	}
+	drift, runtimeUID, err := r.orgDrift(ctx, org)
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Org: %w", err)
+	}
+	switch drift {
+	case driftMissedEvent:
+		r.driftRepaired(orgKind, drift, configRef(orgKind, org.Name, org.UID),
+			fmt.Sprintf("Org %s was never created, creating it", displayName))
+		return r.processOrgsAdd(org)
+	case driftMissingRuntime:
+		// The status is reset so that the Org is created again, and its watchers acknowledge it again.
+		status := org.Status.OrgStatus
+		status.StatusIndicator, status.Message, status.UID = "", "Runtime Org is missing, recreating it", ""
+		if err := org.SetOrgStatus(ctx, &status); err != nil {
+			return fmt.Errorf("unable to reset the status of config Org: %w", err)
+		}
+		r.driftRepaired(orgKind, drift, configRef(orgKind, org.Name, org.UID),
+			fmt.Sprintf("Runtime Org %s is missing, recreating it", displayName))
+		return r.processOrgsAdd(org)
+	case driftStaleStatus:
+		status := org.Status.OrgStatus
+		status.UID = runtimeUID
+		if err := org.SetOrgStatus(ctx, &status); err != nil {
+			return fmt.Errorf("unable to repair the status of config Org: %w", err)
+		}
+		r.driftRepaired(orgKind, drift, configRef(orgKind, org.Name, org.UID),
+			fmt.Sprintf("Status of org %s referred to a former runtime Org, repaired", displayName))
+	}
+	if org.Status.OrgStatus.StatusIndicator != orgsv1.StatusIndicationIdle || !org.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	return r.repairRuntimeFolders(ctx, org)
+}
+
+// repairRuntimeFolders adds the runtime Folders missing for the config Folders of the IDLE org.
+func (r *Reconciler) repairRuntimeFolders(ctx context.Context, org *nexus_client.OrgOrg) error {
+	runtimeOrg, err := r.Client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, org.DisplayName())
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Org: %w", err)
+	}
+	folders, err := org.GetAllFolders(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list config Folders: %w", err)
+	}
+	for _, folder := range folders {
+		_, err := runtimeOrg.GetFolders(ctx, folder.DisplayName())
+		if err == nil {
+			continue
+		}
+		if !nexus_client.IsNotFound(err) && !nexus_client.IsChildNotFound(err) {
+			return fmt.Errorf("unable to get runtime Folder: %w", err)
+		}
+		_, err = runtimeOrg.AddFolders(ctx, &runtimefoldersv1.RuntimeFolder{
+			ObjectMeta: metav1.ObjectMeta{Name: folder.DisplayName()},
+		})
+		if err != nil && !nexus_client.IsAlreadyExists(err) {
+			return fmt.Errorf("unable to add runtime Folder: %w", err)
+		}
+		r.driftRepaired(folderKind, driftMissingRuntime, configRef(orgKind, org.Name, org.UID),
+			fmt.Sprintf("Runtime Folder %s/%s was missing, recreated", org.DisplayName(), folder.DisplayName()))
+	}
+	return nil
+}
+
+// checkProjectDrift queues the repair of project if it drifted.
+func (r *Reconciler) checkProjectDrift(ctx context.Context, project *nexus_client.ProjectProject) {
+	drift, _, err := r.projectDrift(ctx, project)
+	if err != nil {
+		log.InfraErr(err).Msgf("Unable to check project %s for drift", project.DisplayName())
+		return
+	}
+	if drift == "" {
+		return
+	}
+	parentOrgName := project.GetLabels()["orgs.org.edge-orchestrator.intel.com"]
+	parentFolderName := RuntimeFolder(project)
+	displayName := project.DisplayName()
+	r.projects.enqueue(workKey{event: eventProjectDrift, hashName: project.Name}, task{
+		lock: projectLockKey(parentOrgName, parentFolderName, displayName),
+		run:  func() error { return r.repairProject(parentOrgName, parentFolderName, displayName) },
+	})
+}
+
+// repairProject repairs the drift of a config Project. A missing runtime Project is recreated only
+// once its runtime Org exists, which the repair of the Org takes care of.
+func (r *Reconciler) repairProject(orgName, folderName, displayName string) error {
+	ctx := context.Background()
+	project, err := getConfigProject(r.Client, orgName, folderName, displayName)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get config Project: %w", err)
+	}
+	drift, runtimeUID, err := r.projectDrift(ctx, project)
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Project: %w", err)
+	}
+	ref := configRef(projectKind, project.Name, project.UID)
+	switch drift {
+	case driftMissedEvent:
+		r.driftRepaired(projectKind, drift, ref, fmt.Sprintf("Project %s was never created, creating it", displayName))
+		return r.processProjectsAdd(project)
+	case driftMissingRuntime:
+		runtimeOrg, err := r.Client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, orgName)
+		if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+			log.Debug().Msgf("Runtime Org %s of project %s is missing, waiting for its repair", orgName, displayName)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to get runtime Org: %w", err)
+		}
+		_, err = runtimeOrg.AddFolders(ctx, &runtimefoldersv1.RuntimeFolder{ObjectMeta: metav1.ObjectMeta{Name: folderName}})
+		if err != nil && !nexus_client.IsAlreadyExists(err) {
+			return fmt.Errorf("unable to add runtime Folder: %w", err)
+		}
+		status := project.Status.ProjectStatus
+		status.StatusIndicator, status.Message, status.UID = "", "Runtime Project is missing, recreating it", ""
+		if err := project.SetProjectStatus(ctx, &status); err != nil {
+			return fmt.Errorf("unable to reset the status of config Project: %w", err)
+		}
+		r.driftRepaired(projectKind, drift, ref, fmt.Sprintf("Runtime Project %s is missing, recreating it", displayName))
+		return r.processProjectsAdd(project)
+	case driftStaleStatus:
+		status := project.Status.ProjectStatus
+		status.UID = runtimeUID
+		if err := project.SetProjectStatus(ctx, &status); err != nil {
+			return fmt.Errorf("unable to repair the status of config Project: %w", err)
+		}
+		r.driftRepaired(projectKind, drift, ref,
+			fmt.Sprintf("Status of project %s referred to a former runtime Project, repaired", displayName))
+	}
+	return nil
+}
+
+// collectRuntimeOrg queues the delete of the runtime Org of orgName, with its Folders, Projects and active watchers.
+func (r *Reconciler) collectRuntimeOrg(orgName string) {
+	r.orgs.enqueue(workKey{event: eventOrgDrift, hashName: "runtime/" + orgName}, task{
+		lock: orgLockKey(orgName),
+		run: func() error {
+			ctx := context.Background()
+			if _, err := getConfigOrg(r.Client, orgName); !errors.Is(err, ErrNotFound) {
+				return err
+			}
+			err := r.Client.TenancyMultiTenancy().Runtime().DeleteOrgs(ctx, orgName)
+			if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("unable to delete orphaned runtime Org: %w", err)
+			}
+			r.driftRepaired(orgKind, driftOrphanedRuntime, nil, fmt.Sprintf("Deleted orphaned runtime Org %s", orgName))
+			return nil
+		},
+	})
+}
+
+// collectRuntimeFolder queues the delete of the runtime Folder of folderName, with its Projects.
+func (r *Reconciler) collectRuntimeFolder(orgName, folderName string) {
+	r.orgs.enqueue(workKey{event: eventFolderDrift, hashName: "runtime/" + orgName + "/" + folderName}, task{
+		lock: orgLockKey(orgName),
+		run: func() error {
+			ctx := context.Background()
+			_, err := r.Client.TenancyMultiTenancy().Config().Orgs(orgName).GetFolders(ctx, folderName)
+			if err == nil || (!nexus_client.IsNotFound(err) && !nexus_client.IsChildNotFound(err)) {
+				return err
+			}
+			err = r.Client.TenancyMultiTenancy().Runtime().Orgs(orgName).DeleteFolders(ctx, folderName)
+			if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("unable to delete orphaned runtime Folder: %w", err)
+			}
+			r.driftRepaired(folderKind, driftOrphanedRuntime, nil,
+				fmt.Sprintf("Deleted orphaned runtime Folder %s/%s", orgName, folderName))
+			return nil
+		},
+	})
+}
+
+// collectRuntimeProject queues the delete of a runtime Project, with its active watchers.
+func (r *Reconciler) collectRuntimeProject(orgName, folderName, projectName string) {
+	r.projects.enqueue(workKey{event: eventProjectDrift, hashName: "runtime/" + orgName + "/" + folderName + "/" + projectName},
+		task{
+			lock: projectLockKey(orgName, folderName, projectName),
+			run: func() error {
+				ctx := context.Background()
+				if _, err := getConfigProject(r.Client, orgName, folderName, projectName); !errors.Is(err, ErrNotFound) {
+					return err
+				}
+				err := r.Client.TenancyMultiTenancy().Runtime().Orgs(orgName).Folders(folderName).
+					DeleteProjects(ctx, projectName)
+				if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+					return nil
+				}
+				if err != nil {
+					return fmt.Errorf("unable to delete orphaned runtime Project: %w", err)
+				}
+				r.driftRepaired(projectKind, driftOrphanedRuntime, nil,
+					fmt.Sprintf("Deleted orphaned runtime Project %s/%s/%s", orgName, folderName, projectName))
+				return nil
+			},
+		})
+}
+
+// driftRepaired counts a repaired drift and, if ref is set, emits a Kubernetes Event on the config object.
+func (r *Reconciler) driftRepaired(kind, drift string, ref *corev1.ObjectReference, msg string) {
+	log.Info().Msgf("Drift %s: %s", drift, msg)
+	r.metrics.drift.WithLabelValues(kind, drift).Inc()
+	if ref != nil && r.Recorder != nil {
+		r.Recorder.Event(ref, corev1.EventTypeWarning, reasonDriftRepaired, msg)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package tenancy_test
+
+import (
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	orgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/config"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+)
+
+var _ = ginkgo.Describe("Drift reconciliation", func() {
+	var (
+		idle       = string(orgsv1.StatusIndicationIdle)
+		inProgress = string(orgsv1.StatusIndicationInProgress)
+		inError    = string(orgsv1.StatusIndicationError)
+	)
+
+	ginkgo.It("should create an org or project whose add event was missed", func() {
+		gomega.Expect(tenancy.DriftOf(false, "", "", "")).To(gomega.Equal(tenancy.DriftMissedEvent))
+		gomega.Expect(tenancy.DriftOf(false, "", "", "uid-1")).To(gomega.Equal(tenancy.DriftMissedEvent))
+	})
+
+	ginkgo.It("should recreate the missing runtime node of a created org or project", func() {
+		gomega.Expect(tenancy.DriftOf(false, idle, "uid-1", "")).To(gomega.Equal(tenancy.DriftMissingRuntime))
+	})
+
+	ginkgo.It("should repair a status referring to a former runtime node", func() {
+		gomega.Expect(tenancy.DriftOf(false, idle, "uid-1", "uid-2")).To(gomega.Equal(tenancy.DriftStaleStatus))
+		gomega.Expect(tenancy.DriftOf(false, idle, "", "uid-2")).To(gomega.Equal(tenancy.DriftStaleStatus))
+		gomega.Expect(tenancy.DriftOf(false, idle, "uid-2", "uid-2")).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should leave orgs and projects being deleted, in progress or in error to their operation", func() {
+		gomega.Expect(tenancy.DriftOf(true, "", "", "")).To(gomega.BeEmpty())
+		gomega.Expect(tenancy.DriftOf(true, idle, "uid-1", "")).To(gomega.BeEmpty())
+		gomega.Expect(tenancy.DriftOf(false, inProgress, "", "")).To(gomega.BeEmpty())
+		gomega.Expect(tenancy.DriftOf(false, inError, "uid-1", "")).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should collect orphaned runtime nodes only after a grace period", func() {
+		now := time.Now()
+		gomega.Expect(tenancy.Orphaned(now.Add(-10*time.Second), now)).To(gomega.BeFalse())
+		gomega.Expect(tenancy.Orphaned(now.Add(-2*time.Minute), now)).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("should check for drift every 5 minutes by default", func() {
+		gomega.Expect(config.GetDefaultConfig().DriftReconcileIntervalInSecs).To(gomega.BeEquivalentTo(300))
+	})
+})
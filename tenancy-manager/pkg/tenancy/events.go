@@ -0,0 +1,108 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"fmt"
+	"time"
+
+	orgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Reasons of the Kubernetes Events emitted on config Orgs and Projects.
+const (
+	reasonCreating     = "Creating"
+	reasonCreated      = "Created"
+	reasonCreateFailed = "CreateFailed"
+	reasonDeleting     = "Deleting"
+	reasonDeleted      = "Deleted"
+	reasonDeleteFailed = "DeleteFailed"
+	// reasonDriftRepaired is emitted when the runtime tree is repaired after drifting from the config tree.
+	reasonDriftRepaired = "DriftRepaired"
+	// Reasons of the suspension of Orgs and the archival of Projects.
+	reasonSuspended  = "Suspended"
+	reasonResumed    = "Resumed"
+	reasonArchived   = "Archived"
+	reasonUnarchived = "Unarchived"
+)
+
+// configRef returns the reference of a config Org or Project for the Kubernetes Events emitted on it.
+func configRef(kind, name string, uid types.UID) *corev1.ObjectReference {
+	ref := &corev1.ObjectReference{
+		APIVersion: "org.edge-orchestrator.intel.com/v1",
+		Kind:       "Org",
+		Name:       name,
+		UID:        uid,
+	}
+	if kind == projectKind {
+		ref.APIVersion, ref.Kind = "project.edge-orchestrator.intel.com/v1", "Project"
+	}
+	return ref
+}
+
+// eventReason returns the type and the reason of the Kubernetes Event of status on event.
+func eventReason(event Event, status string) (string, string) {
+	switch {
+	case status == string(orgsv1.StatusIndicationError) && event == Delete:
+		return corev1.EventTypeWarning, reasonDeleteFailed
+	case status == string(orgsv1.StatusIndicationError):
+		return corev1.EventTypeWarning, reasonCreateFailed
+	case event == Delete:
+		return corev1.EventTypeNormal, reasonDeleting
+	case status == string(orgsv1.StatusIndicationIdle):
+		return corev1.EventTypeNormal, reasonCreated
+	default:
+		return corev1.EventTypeNormal, reasonCreating
+	}
+}
+
+// statusChanged records the metrics of a status set on a config Org or Project, and emits a Kubernetes Event
+// if the status or the operation changed.
+func (r *Reconciler) statusChanged(kind, key string, ref *corev1.ObjectReference, event Event, status, msg string,
+	conditions []watcherCondition,
+) {
+	if !r.metrics.observeStatus(kind, key, event, status, conditions, time.Now()) || r.Recorder == nil {
+		return
+	}
+	eventType, reason := eventReason(event, status)
+	r.Recorder.Event(ref, eventType, reason, msg)
+}
+
+// deleted records the metrics of a completed delete of a config Org or Project, and emits a Kubernetes Event.
+func (r *Reconciler) deleted(kind, key string, ref *corev1.ObjectReference, displayName string) {
+	r.metrics.observeDeleted(kind, key, time.Now())
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Event(ref, corev1.EventTypeNormal, reasonDeleted, fmt.Sprintf("%s %s DELETE is complete", kind, displayName))
+}
+
+// orgDeleted records the completed delete of a config Org.
+func (r *Reconciler) orgDeleted(org *nexus_client.OrgOrg) {
+	r.deleted(orgKind, orgLockKey(org.DisplayName()), configRef(orgKind, org.Name, org.UID), org.DisplayName())
+}
+
+// projectDeleted records the completed delete of a config Project.
+func (r *Reconciler) projectDeleted(project *nexus_client.ProjectProject) {
+	r.deleted(projectKind, configProjectLockKey(project),
+		configRef(projectKind, project.Name, project.UID), project.DisplayName())
+}
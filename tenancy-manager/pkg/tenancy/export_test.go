@@ -0,0 +1,298 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	networkv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/network.edge-orchestrator.intel.com/v1"
+	orgactivewatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgactivewatcher.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WatcherOrder is the phase and dependencies of a watcher, as declared in its OrgWatcher or ProjectWatcher.
+type WatcherOrder struct {
+	Phase     int32
+	DependsOn []string
+}
+
+// ReadyWatchers returns the watchers that may act on event, given the registered watchers,
+// the watchers with an active watcher and the IDLE ones.
+func ReadyWatchers(orders map[string]WatcherOrder, event Event, active, idle []string) ([]string, error) {
+	plan := watcherPlan{}
+	for name, order := range orders {
+		plan[name] = watcherRegistration{phase: order.Phase, dependsOn: order.DependsOn}
+	}
+	if err := plan.validate(); err != nil {
+		return nil, err
+	}
+	return plan.ready(event, toStates(active, idle), nil), nil
+}
+
+// MarkReady records on obj that the watchers in ready became ready at now.
+func MarkReady(obj metav1.Object, ready []string, now time.Time) {
+	setReadyWatchers(obj, ready, now)
+}
+
+func toStates(active, idle []string) watcherStates {
+	states := watcherStates{}
+	for _, name := range active {
+		states[name] = watcherState{status: string(orgactivewatcherv1.StatusIndicationInProgress)}
+	}
+	for _, name := range idle {
+		states[name] = watcherState{status: string(orgactivewatcherv1.StatusIndicationIdle)}
+	}
+	return states
+}
+
+// WatcherPolicy is the timeouts and failure policy of a watcher, as declared in its OrgWatcher or ProjectWatcher.
+type WatcherPolicy struct {
+	Timeout       time.Duration
+	Optional      bool
+	FailurePolicy string
+	Retries       int
+}
+
+// WatcherReport is the state of the active watcher of a watcher.
+type WatcherReport struct {
+	Status    string
+	Message   string
+	TimeStamp time.Time
+}
+
+// Verdict is the outcome of Evaluate.
+type Verdict struct {
+	Done     bool
+	Pending  []string
+	Retry    []string
+	Failures []string
+	Warnings []string
+}
+
+// Evaluate applies the policies of the watchers to their reports, for an operation started at started.
+// Retries made so far are read from the annotations of obj.
+func Evaluate(policies map[string]WatcherPolicy, event Event, reports map[string]WatcherReport,
+	obj metav1.Object, started, now time.Time,
+) Verdict {
+	plan := watcherPlan{}
+	for name, policy := range policies {
+		plan[name] = watcherRegistration{
+			createTimeout: policy.Timeout,
+			deleteTimeout: policy.Timeout,
+			required:      !policy.Optional,
+			failurePolicy: policy.FailurePolicy,
+			retries:       policy.Retries,
+		}
+	}
+	states := watcherStates{}
+	for name, report := range reports {
+		states[name] = watcherState{
+			status:    report.Status,
+			message:   report.Message,
+			timeStamp: uint64(report.TimeStamp.Unix()), //nolint:gosec // Test timestamps are positive.
+		}
+	}
+	verdict := plan.evaluate(event, states, obj.GetAnnotations(), started, time.Minute, now)
+	return Verdict{
+		Done:     verdict.done(),
+		Pending:  verdict.pending,
+		Retry:    verdict.retry,
+		Failures: verdict.failures,
+		Warnings: verdict.warnings,
+	}
+}
+
+// RequestRetry records one more retry of watcher on obj.
+func RequestRetry(obj metav1.Object, watcher string, now time.Time) {
+	requestRetry(obj, watcher, now)
+}
+
+// UnfinishedWatchers returns the registered watchers that did not complete event, given the watchers
+// with an active watcher and the IDLE ones.
+func UnfinishedWatchers(registered []string, event Event, active, idle []string) []string {
+	plan := watcherPlan{}
+	for _, name := range registered {
+		plan[name] = watcherRegistration{}
+	}
+	return plan.unfinished(event, toStates(active, idle))
+}
+
+// RestartWatchers starts a new wait for event on obj, in which the failed watchers are asked to process it again.
+func RestartWatchers(obj metav1.Object, event Event, failed []string, now time.Time) {
+	restartWatchers(obj, event, failed, now)
+}
+
+// RetryRequested reports whether updated carries a retry request that old did not.
+func RetryRequested(old, updated metav1.Object) bool {
+	return retryRequested(old, updated)
+}
+
+// ForceDeleteConfirmed reports whether obj, marked deleted, is to be deleted without waiting for its watchers.
+func ForceDeleteConfirmed(obj metav1.Object, displayName string) bool {
+	return forceDeleteConfirmed(obj, displayName)
+}
+
+// MoveRequested reports whether updated, not marked deleted, carries a move request that old did not.
+func MoveRequested(old, updated metav1.Object) bool {
+	return moveRequested(old, updated)
+}
+
+// RuntimeFolderAnnotation names the runtime Folder of a moved config Project.
+const RuntimeFolderAnnotation = runtimeFolderAnnotation
+
+// ProjectFolder returns the folder of the runtime Project of the config project.
+func ProjectFolder(project metav1.Object) string {
+	return RuntimeFolder(project)
+}
+
+// ProjectLockKeys returns the lock taken by the tasks of the config project, and the one taken by its
+// acknowledgement requests.
+func ProjectLockKeys(project *projectv1.Project) (string, string) {
+	configProject := &nexus_client.ProjectProject{Project: project}
+	return configProjectLockKey(configProject), projectAck(configProject, Create).lockKey()
+}
+
+// ObserveStatus records a status set on the org displayName, as when it is written to the config Org.
+func (r *Reconciler) ObserveStatus(displayName string, event Event, status string, now time.Time) {
+	r.metrics.observeStatus(orgKind, orgLockKey(displayName), event, status, nil, now)
+	if eventType, reason := eventReason(event, status); r.Recorder != nil {
+		r.Recorder.Event(configRef(orgKind, displayName, ""), eventType, reason, status)
+	}
+}
+
+// Drifts between the config and runtime trees.
+const (
+	DriftMissedEvent    = driftMissedEvent
+	DriftMissingRuntime = driftMissingRuntime
+	DriftStaleStatus    = driftStaleStatus
+)
+
+// DriftOf returns the drift of a config Org or Project from its status and the UID of its runtime counterpart.
+func DriftOf(deleting bool, status, statusUID, runtimeUID string) string {
+	return driftOf(deleting, status, statusUID, runtimeUID)
+}
+
+// Orphaned reports whether a runtime node created at created, without config counterpart, is to be collected.
+func Orphaned(created, now time.Time) bool {
+	return orphaned(created, now)
+}
+
+// Kinds of the config nodes.
+const (
+	OrgKind     = orgKind
+	ProjectKind = projectKind
+)
+
+// LifecycleReason returns the reason and the message of the Kubernetes Event of a suspension or an archival.
+func LifecycleReason(kind, displayName string, set bool) (string, string) {
+	return lifecycleReason(kind, displayName, set)
+}
+
+// NetworkState returns the state of a Network of networkType, and the message explaining it, once its Project
+// is in projectStatus.
+func NetworkState(networkType networkv1.NetworkType, projectStatus projectv1.TenancyRequestStatus) (string, string) {
+	return networkState(networkType, projectStatus)
+}
+
+// InProject reports whether the labels of a config Network are those of a child of the config Project
+// of displayName.
+func InProject(network, project metav1.Object, displayName string) bool {
+	return inProject(network, project, displayName)
+}
+
+// ExpandTemplate expands the ProjectTemplate named by a config Project into it, unless it was already.
+func ExpandTemplate(ctx context.Context, client *nexus_client.Clientset,
+	project *nexus_client.ProjectProject,
+) (*nexus_client.ProjectProject, error) {
+	return expandTemplate(ctx, client, project)
+}
+
+// TemplateParameters returns the parameters annotations of a config Project, to copy to its runtime Project.
+func TemplateParameters(project metav1.Object) map[string]string {
+	return templateParameters(project)
+}
+
+// RunTask queues a task running run, allowed maxRetries retries, and processes the queue until the task succeeds
+// or fails. It returns how many times run was called and the error the task failed with.
+func RunTask(run func() error, maxRetries int) (int, error) {
+	var standby atomic.Bool
+	q := newKeyedQueue("test", newKeyedMutex(), &standby, time.Millisecond, time.Millisecond, maxRetries)
+	defer q.queue.ShutDown()
+
+	runs, done := 0, false
+	var failed error
+	q.enqueue(workKey{event: eventOrgAdd, hashName: "test"}, task{
+		lock: orgLockKey("test"),
+		run: func() error {
+			runs++
+			err := run()
+			done = err == nil
+			return err
+		},
+		fail: func(err error) {
+			failed, done = err, true
+		},
+	})
+	for !done {
+		q.processNext()
+	}
+	return runs, failed
+}
+
+// CreateStartedAnnotation records on a runtime object the start of the wait for the watchers of its create.
+const CreateStartedAnnotation = createStartedAnnotation
+
+// MarkAckStarted records now as the start of the wait for event on obj, unless a start is already recorded.
+func MarkAckStarted(obj metav1.Object, event Event) {
+	markAckStarted(obj, event)
+}
+
+// AckStarted returns when the create of the org of the runtime object obj started waiting for its watchers.
+func (r *Reconciler) AckStarted(ctx context.Context, obj interface {
+	metav1.Object
+	Update(ctx context.Context) error
+},
+) time.Time {
+	return r.ackStarted(ctx, ackRequest{kind: orgKind, event: Create, displayName: obj.GetName()}, obj)
+}
+
+// ResumeOrg resumes the config Org as on start, and returns the acknowledgement it queued, empty if none.
+func (r *Reconciler) ResumeOrg(org *nexus_client.OrgOrg) string {
+	r.resumeOrg(org)
+	return r.queuedAck()
+}
+
+// ResumeProject resumes the config Project as on start, and returns the acknowledgement it queued, empty if none.
+func (r *Reconciler) ResumeProject(project *nexus_client.ProjectProject) string {
+	r.resumeProject(project)
+	return r.queuedAck()
+}
+
+func (r *Reconciler) queuedAck() string {
+	if r.acks.queue.Len() == 0 {
+		return ""
+	}
+	req, _ := r.acks.queue.Get()
+	r.acks.queue.Done(req)
+	return req.String()
+}
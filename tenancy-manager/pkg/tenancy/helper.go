@@ -29,7 +29,9 @@ import (
 	orgactivewatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgactivewatcher.edge-orchestrator.intel.com/v1"
 	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
 	projectactivewatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectactivewatcher.edge-orchestrator.intel.com/v1"
+	runtimefoldersv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimefolder.edge-orchestrator.intel.com/v1"
 	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var ErrNotFound = errors.New("not found")
@@ -73,6 +75,18 @@ func getRuntimeOrgUID(c *nexus_client.Clientset, org *nexus_client.OrgOrg) strin
 	return uid
 }
 
+// runtimeFolderAnnotation, on a moved config Project, names the runtime Folder of its runtime Project.
+// A moved Project keeps its runtime Project, and so its UID, in the runtime Folder it was created in.
+const runtimeFolderAnnotation = "tenancy-manager.edge-orchestrator.intel.com/runtime-folder"
+
+// RuntimeFolder returns the folder of the runtime Project of the config project.
+func RuntimeFolder(project metav1.Object) string {
+	if folder := project.GetAnnotations()[runtimeFolderAnnotation]; folder != "" {
+		return folder
+	}
+	return project.GetLabels()["folders.folder.edge-orchestrator.intel.com"]
+}
+
 // getRuntimeProjectUID returns the uID of the runtime project, corresponding to the input project.
 // The determination is best effort and only if all relevant objects are found.
 func getRuntimeProjectUID(c *nexus_client.Clientset, project *nexus_client.ProjectProject) string {
@@ -83,7 +97,7 @@ func getRuntimeProjectUID(c *nexus_client.Clientset, project *nexus_client.Proje
 			org, err := folder.GetParent(context.Background())
 			if err == nil && org != nil {
 				runtimeProject, err := c.TenancyMultiTenancy().Runtime().Orgs(org.DisplayName()).
-					Folders(folder.DisplayName()).GetProjects(context.Background(), project.DisplayName())
+					Folders(RuntimeFolder(project)).GetProjects(context.Background(), project.DisplayName())
 				if err == nil {
 					uid = string(runtimeProject.UID)
 				}
@@ -93,353 +107,148 @@ func getRuntimeProjectUID(c *nexus_client.Clientset, project *nexus_client.Proje
 	return uid
 }
 
-func setOrgStatus(client *nexus_client.Clientset, displayName, hashName string,
+/*
+setOrgStatus sets the status of the config Org of displayName, unless it is deleted. It returns an error when
+the org cannot be read or updated, for the caller to retry it.
+*/
+func (r *Reconciler) setOrgStatus(displayName, hashName string,
 	status orgsv1.TenancyRequestStatus, msg string, eventType Event,
-) {
-	orgMutex.Lock()
-	defer orgMutex.Unlock()
-
+) error {
+	client := r.Client
 	configOrg, defaultErr := getConfigOrg(client, displayName)
 	if defaultErr != nil {
 		if !errors.Is(defaultErr, ErrNotFound) {
-			log.Panic().Msgf("Failed to get config org %s (hashName: %s) object to add status: %v",
+			return fmt.Errorf("unable to get config Org %s (hashName: %s) to set its status: %w",
 				displayName, hashName, defaultErr)
 		}
-		return
+		return nil
 	}
 	if eventType != Delete && !configOrg.DeletionTimestamp.IsZero() && !Testing {
 		log.Debug().Msgf("Org of %s (hashName: %s) is marked for delete, skip processing Create",
 			displayName, hashName)
-		return
+		return nil
 	}
 	if eventType == Create &&
 		configOrg.Status.OrgStatus.StatusIndicator == orgsv1.StatusIndicationIdle {
 		log.Debug().Msgf("OrgStatus of %s (hashName: %s) is already set to %v, skip processing",
 			displayName, hashName, orgsv1.StatusIndicationIdle)
-		return
+		return nil
 	}
 	log.Debug().Msgf("Setting OrgStatus of %s (hashName: %s) to %v", displayName, hashName, status)
+	conditions := orgConditions(client, displayName, eventType, configOrg.Status.OrgStatus.Conditions)
 	err := configOrg.SetOrgStatus(context.Background(), &orgsv1.OrgStatus{
 		StatusIndicator: status,
 		Message:         msg,
 		TimeStamp:       safeUnixTime(),
 		UID:             getRuntimeOrgUID(client, configOrg),
+		Conditions:      conditions,
+		Usage:           configOrg.Status.OrgStatus.Usage,
 	})
 	if err != nil {
-		log.Panic().Msgf("failed to set OrgStatus of %s to %v status due error: %v", hashName, status, err)
+		return fmt.Errorf("unable to set OrgStatus of %s to %v: %w", hashName, status, err)
+	}
+	observed := make([]watcherCondition, 0, len(conditions))
+	for _, c := range conditions {
+		observed = append(observed, watcherCondition{
+			watcher: c.Watcher, state: string(c.State), lastTransitionTime: c.LastTransitionTime,
+		})
 	}
+	r.statusChanged(orgKind, orgLockKey(displayName), configRef(orgKind, configOrg.Name, configOrg.UID), eventType,
+		string(status), msg, observed)
 	// Verify if the status is set as expected.
-	verifyOrgStatus(client, displayName, hashName, status)
+	return verifyOrgStatus(client, displayName, hashName, status)
 }
 
 func verifyOrgStatus(client *nexus_client.Clientset, displayName, hashName string,
 	status orgsv1.TenancyRequestStatus,
-) {
+) error {
 	updatedOrg, defaultErr := getConfigOrg(client, displayName)
 	if defaultErr != nil {
 		if !errors.Is(defaultErr, ErrNotFound) {
-			log.Panic().Msgf("Failed to get config org %s (hashName: %s) object to add status: %v",
+			return fmt.Errorf("unable to get config Org %s (hashName: %s) to verify its status: %w",
 				displayName, hashName, defaultErr)
 		}
-		return
+		return nil
 	}
 	if status != updatedOrg.Status.OrgStatus.StatusIndicator {
 		log.Error().Msgf("Expected Status: %v. Actual status of Org %s (hashName: %s): %v, Timestamp in Object: %v",
 			status, displayName, hashName, updatedOrg.Status.OrgStatus.StatusIndicator,
 			updatedOrg.Status.OrgStatus.TimeStamp)
 	}
+	return nil
 }
 
-func setProjectStatus(client *nexus_client.Clientset, displayName, hashName string,
+/*
+setProjectStatus sets the status of the config Project of displayName, unless it is deleted. It returns an error
+when the project cannot be read or updated, for the caller to retry it.
+*/
+func (r *Reconciler) setProjectStatus(displayName, hashName string,
 	parentOrgName, parentFolderName string,
 	status projectv1.TenancyRequestStatus, msg string, eventType Event,
-) {
-	projectMutex.Lock()
-	defer projectMutex.Unlock()
-
+) error {
+	client := r.Client
 	configProject, err := getConfigProject(client, parentOrgName, parentFolderName, displayName)
 	if err != nil {
 		if !errors.Is(err, ErrNotFound) {
-			log.Panic().Msgf("Failed to get config project %s object to add status: %v", displayName, err)
+			return fmt.Errorf("unable to get config Project %s (hashName: %s) to set its status: %w",
+				displayName, hashName, err)
 		}
-		return
+		return nil
 	}
 	if eventType != Delete && !configProject.DeletionTimestamp.IsZero() && !Testing {
 		log.Debug().Msgf("Proeject of %s (hashName: %s) is marked for delete, skip processing Create",
 			displayName, hashName)
-		return
+		return nil
 	}
 	if eventType == Create &&
 		configProject.Status.ProjectStatus.StatusIndicator == projectv1.StatusIndicationIdle {
 		log.Debug().Msgf("ProjectStatus of %s (hashName: %s) is already set to %v, skip processing",
 			displayName, hashName, projectv1.StatusIndicationIdle)
-		return
+		return nil
 	}
 	log.Debug().Msgf("Setting ProjectStatus of %s (hashName: %s) to %v", displayName, hashName, status)
+	conditions := projectConditions(client, displayName, parentOrgName, parentFolderName, eventType,
+		configProject.Status.ProjectStatus.Conditions)
 	err = configProject.SetProjectStatus(context.Background(), &projectv1.ProjectStatus{
 		StatusIndicator: status,
 		Message:         msg,
 		TimeStamp:       safeUnixTime(),
 		UID:             getRuntimeProjectUID(client, configProject),
+		Conditions:      conditions,
 	})
 	if err != nil {
-		log.Panic().Msgf("failed to set ProjectStatus of %s to %s, due error: %v", hashName, status, err)
+		return fmt.Errorf("unable to set ProjectStatus of %s to %s: %w", hashName, status, err)
+	}
+	observed := make([]watcherCondition, 0, len(conditions))
+	for _, c := range conditions {
+		observed = append(observed, watcherCondition{
+			watcher: c.Watcher, state: string(c.State), lastTransitionTime: c.LastTransitionTime,
+		})
 	}
+	r.statusChanged(projectKind, projectLockKey(parentOrgName, parentFolderName, displayName),
+		configRef(projectKind, configProject.Name, configProject.UID), eventType, string(status), msg, observed)
 	// Verify if the status is set as expected.
-	verifyProjectStatus(client, displayName, hashName, parentOrgName, parentFolderName, status)
+	return verifyProjectStatus(client, displayName, hashName, parentOrgName, parentFolderName, status)
 }
 
 func verifyProjectStatus(client *nexus_client.Clientset, displayName, hashName string,
 	parentOrgName, parentFolderName string,
 	status projectv1.TenancyRequestStatus,
-) {
+) error {
 	updatedProject, err := getConfigProject(client, parentOrgName, parentFolderName, displayName)
 	if err != nil {
 		if !errors.Is(err, ErrNotFound) {
-			log.Panic().Msgf("Failed to get config project %s object to add status: %v", displayName, err)
+			return fmt.Errorf("unable to get config Project %s (hashName: %s) to verify its status: %w",
+				displayName, hashName, err)
 		}
-		return
+		return nil
 	}
 	if status != updatedProject.Status.ProjectStatus.StatusIndicator {
 		log.Error().Msgf("Expected Status: %v. Actual status of Project %s (hashName: %s): %v, Timestamp in Object: %v",
 			status, displayName, hashName, updatedProject.Status.ProjectStatus.StatusIndicator,
 			updatedProject.Status.ProjectStatus.TimeStamp)
 	}
-}
-
-func (r *Reconciler) StartOrgCreateAcknowledgementTimer(timeoutInterval time.Duration, expectedOrgWatchers map[string]struct{},
-	displayName, hashName string, runtimeOrg *nexus_client.RuntimeorgRuntimeOrg,
-) {
-	timeout := time.After(timeoutInterval)
-	ticker := time.NewTicker(pollInterval)
-	defer ticker.Stop()
-
-	log.Debug().Msgf("Timer started for org %s (hashName: %s) create", displayName, hashName)
-	defer log.Debug().Msgf("Timer ended for org %s (hashName: %s) create", displayName, hashName)
-
-	for {
-		select {
-		case <-ticker.C:
-			success, err := isOrgCreationSuccessful(r.Client, displayName, runtimeOrg, expectedOrgWatchers)
-			if err != nil {
-				if !errors.Is(err, ErrNotFound) {
-					log.InfraErr(err).Msgf("Creation of org %s (hashName: %s) failed", displayName, hashName)
-					setOrgStatus(r.Client, displayName, hashName, orgsv1.StatusIndicationError,
-						fmt.Sprintf("Org creation failed with an error: %v", err),
-						Create)
-				}
-				return
-			}
-			if success {
-				setOrgStatus(r.Client, displayName, hashName, orgsv1.StatusIndicationIdle,
-					fmt.Sprintf("Org %s CREATE is complete", displayName),
-					Create)
-				log.Debug().Msgf("Creation of org %s (hashName: %s) is successful", displayName, hashName)
-				return
-			}
-		case <-timeout:
-			success, err := isOrgCreationSuccessful(r.Client, displayName, runtimeOrg, expectedOrgWatchers)
-			if err != nil {
-				if !errors.Is(err, ErrNotFound) {
-					log.InfraErr(err).Msgf(`Creation of org %s (hashName: %s) failed`, displayName, hashName)
-					setOrgStatus(r.Client, displayName, hashName, orgsv1.StatusIndicationError,
-						fmt.Sprintf("Org creation failed with an error: %v", err),
-						Create)
-				}
-				return
-			}
-			if !success {
-				// If there are unacknowledged watchers or any active watcher is not IDLE, set error state.
-				log.Debug().Msgf("Timeout, active watchers %v haven't acknowledged org %s (hashName: %s), marking as 'ERROR'",
-					getMapKeys(expectedOrgWatchers), displayName, hashName)
-				setOrgStatus(r.Client, displayName, hashName, orgsv1.StatusIndicationError,
-					fmt.Sprintf("Timeout, active watchers %v haven't acknowledged this org", getMapKeys(expectedOrgWatchers)),
-					Create)
-			}
-			return
-		}
-	}
-}
-
-func (r *Reconciler) StartOrgDeleteAcknowledgementTimer(timeoutInterval time.Duration, expectedOrgWatchers map[string]struct{},
-	displayName, hashName string, runtimeOrg *nexus_client.RuntimeorgRuntimeOrg,
-) {
-	timeout := time.After(timeoutInterval)
-	ticker := time.NewTicker(pollInterval)
-	defer ticker.Stop()
-
-	log.Debug().Msgf("Timer started for org %s (hashName: %s) delete", displayName, hashName)
-	defer log.Debug().Msgf("Timer ended for org %s (hashName: %s) delete", displayName, hashName)
-
-	for {
-		select {
-		case <-ticker.C:
-			success, _, err := isOrgDeletionSuccessful(r.Client, displayName, runtimeOrg, expectedOrgWatchers)
-			if err != nil {
-				if !errors.Is(err, ErrNotFound) {
-					log.InfraErr(err).Msgf("Deletion of org %s (hashName: %s) failed", displayName, hashName)
-					setOrgStatus(r.Client, displayName, hashName,
-						orgsv1.StatusIndicationError,
-						fmt.Sprintf("Org deletion failed with an error: %v", err),
-						Delete)
-				}
-				return
-			}
-			if success {
-				log.Debug().Msgf("Deletion of org %s (hashName: %s) is successful", displayName, hashName)
-				return
-			}
-		case <-timeout:
-			success, currentActiveWatchers, err := isOrgDeletionSuccessful(r.Client, displayName, runtimeOrg,
-				expectedOrgWatchers)
-			if err != nil {
-				if !errors.Is(err, ErrNotFound) {
-					log.InfraErr(err).Msgf("Deletion of org %s (hashName: %s) failed", displayName, hashName)
-					setOrgStatus(r.Client, displayName, hashName,
-						orgsv1.StatusIndicationError,
-						fmt.Sprintf("Org deletion failed with an error: %v", err),
-						Delete)
-				}
-				return
-			}
-			if !success {
-				// If at least one active watcher is found in expectedOrgWatchers, then mark org as error state.
-				log.Debug().Msgf("Timeout, cannot be deleted as active watchers %v have acknowledged org %s (hashName: %s), "+
-					"marking as 'ERROR'", getMapKeys(currentActiveWatchers), displayName, hashName)
-				setOrgStatus(r.Client, displayName, hashName,
-					orgsv1.StatusIndicationError,
-					fmt.Sprintf("Timeout, cannot be deleted as active watchers %v have acknowledged this org",
-						getMapKeys(currentActiveWatchers)),
-					Delete)
-			}
-			return
-		}
-	}
-}
-
-func (r *Reconciler) StartProjectCreateAcknowledgementTimer(timeoutInterval time.Duration,
-	expectedProjectWatchers map[string]struct{},
-	displayName, hashName, orgName, folderName string,
-	runtimeProject *nexus_client.RuntimeprojectRuntimeProject,
-) {
-	timeout := time.After(timeoutInterval)
-	ticker := time.NewTicker(pollInterval)
-	defer ticker.Stop()
-
-	log.Debug().Msgf("Timer started for project %s (hashName: %s) create", displayName, hashName)
-	defer log.Debug().Msgf("Timer ended for project %s (hashName: %s) create", displayName, hashName)
-
-	for {
-		select {
-		case <-ticker.C:
-			success, err := isProjectCreationSuccessful(r.Client,
-				displayName, orgName, folderName, runtimeProject,
-				expectedProjectWatchers)
-			if err != nil {
-				if !errors.Is(err, ErrNotFound) {
-					log.InfraErr(err).Msgf("Creation of project %s (hashName: %s) failed", displayName, hashName)
-					setProjectStatus(r.Client, displayName, hashName, orgName, folderName,
-						projectv1.StatusIndicationError,
-						fmt.Sprintf("Project creation failed with an error: %v", err),
-						Create)
-				}
-				// If config object not found, do nothing, simply return.
-				return
-			}
-			if success {
-				setProjectStatus(r.Client, displayName, hashName, orgName, folderName,
-					projectv1.StatusIndicationIdle,
-					fmt.Sprintf("Project %s CREATE is complete", displayName),
-					Create)
-				log.Debug().Msgf("Creation of project %s (hashName: %s) is successful", displayName, hashName)
-				return
-			}
-		case <-timeout:
-			success, err := isProjectCreationSuccessful(r.Client,
-				displayName, orgName, folderName,
-				runtimeProject, expectedProjectWatchers)
-			if err != nil {
-				if !errors.Is(err, ErrNotFound) {
-					log.InfraErr(err).Msgf(`Creation of project %s (hashName: %s) failed`, displayName, hashName)
-					setProjectStatus(r.Client, displayName, hashName, orgName, folderName,
-						projectv1.StatusIndicationError,
-						fmt.Sprintf("Project creation failed with an error: %v", err),
-						Create)
-				}
-				return
-			}
-			if !success {
-				// If there are unacknowledged watchers or any active watcher is not IDLE, set error state.
-				log.Debug().Msgf("Timeout, active watchers %v haven't acknowledged project %s (hashName: %s), marking as 'ERROR'",
-					getMapKeys(expectedProjectWatchers), displayName, hashName)
-				setProjectStatus(r.Client, displayName, hashName, orgName, folderName,
-					projectv1.StatusIndicationError,
-					fmt.Sprintf("Timeout, active watchers %v haven't acknowledged this project",
-						getMapKeys(expectedProjectWatchers)),
-					Create)
-			}
-			return
-		}
-	}
-}
-
-func (r *Reconciler) StartProjectDeleteAcknowledgementTimer(timeoutInterval time.Duration,
-	expectedProjectWatchers map[string]struct{},
-	displayName, hashName, orgName,
-	folderName string, runtimeProject *nexus_client.RuntimeprojectRuntimeProject,
-) {
-	timeout := time.After(timeoutInterval)
-	ticker := time.NewTicker(pollInterval)
-	defer ticker.Stop()
-
-	log.Debug().Msgf("Timer started for project %s (hashName: %s) delete", displayName, hashName)
-	defer log.Debug().Msgf("Timer ended for project %s (hashName: %s) delete", displayName, hashName)
-
-	for {
-		select {
-		case <-ticker.C:
-			success, _, err := isProjectDeletionSuccessful(r.Client,
-				displayName, orgName, folderName, runtimeProject, expectedProjectWatchers)
-			if err != nil {
-				if !errors.Is(err, ErrNotFound) {
-					log.InfraErr(err).Msgf("Deletion of project %s (hashName: %s) failed", displayName, hashName)
-					setProjectStatus(r.Client, displayName, hashName, orgName, folderName,
-						projectv1.StatusIndicationError,
-						fmt.Sprintf("Project deletion failed with an error: %v", err),
-						Delete)
-				}
-				return
-			}
-			if success {
-				log.Debug().Msgf("Deletion of project %s (hashName: %s) is successful", displayName, hashName)
-				return
-			}
-		case <-timeout:
-			success, currentActiveWatchers, err := isProjectDeletionSuccessful(r.Client,
-				displayName, orgName, folderName, runtimeProject, expectedProjectWatchers)
-			if err != nil {
-				if !errors.Is(err, ErrNotFound) {
-					log.InfraErr(err).Msgf(`Deletion of project %s (hashName: %s) failed`, displayName, hashName)
-					setProjectStatus(r.Client, displayName, hashName, orgName, folderName,
-						projectv1.StatusIndicationError,
-						fmt.Sprintf("Project deletion failed with an error: %v", err),
-						Delete)
-				}
-				return
-			}
-			if !success {
-				// If at least one active watcher is found in expectedProjectWatchers, then mark project as error state.
-				log.Debug().Msgf("Timeout, cannot be deleted as active watchers %v have acknowledged project %s "+
-					"(hashName: %s), marking as 'ERROR'", getMapKeys(currentActiveWatchers), displayName, hashName)
-				setProjectStatus(r.Client, displayName, hashName, orgName, folderName,
-					projectv1.StatusIndicationError,
-					fmt.Sprintf("Timeout, cannot be deleted as active watchers %v have acknowledged this project",
-						getMapKeys(currentActiveWatchers)),
-					Delete)
-			}
-			return
-		}
-	}
+	return nil
 }
 
 func isOrgCreationSuccessful(client *nexus_client.Clientset,
@@ -604,6 +413,45 @@ func getConfigOrg(client *nexus_client.Clientset, name string) (*nexus_client.Or
 	return configOrg, nil
 }
 
+/*
+withCurrentOrg returns the run of a task that processes the config Org of org as it is when the task runs,
+under its lock: the org may have changed or been deleted since the event that queued the task, or since
+its previous attempt. Nothing is done once the org is gone.
+*/
+func (r *Reconciler) withCurrentOrg(org *nexus_client.OrgOrg,
+	process func(*nexus_client.OrgOrg) error,
+) func() error {
+	return func() error {
+		current, err := r.Client.Org().GetOrgByName(context.Background(), org.Name)
+		if nexus_client.IsNotFound(err) {
+			log.Debug().Msgf("Org %s (hashName: %s) no longer exists, skip processing", org.DisplayName(), org.Name)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to get config Org: %w", err)
+		}
+		return process(current)
+	}
+}
+
+// withCurrentProject is withCurrentOrg for the config Project of project.
+func (r *Reconciler) withCurrentProject(project *nexus_client.ProjectProject,
+	process func(*nexus_client.ProjectProject) error,
+) func() error {
+	return func() error {
+		current, err := r.Client.Project().GetProjectByName(context.Background(), project.Name)
+		if nexus_client.IsNotFound(err) {
+			log.Debug().Msgf("Project %s (hashName: %s) no longer exists, skip processing",
+				project.DisplayName(), project.Name)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to get config Project: %w", err)
+		}
+		return process(current)
+	}
+}
+
 func getConfigProject(client *nexus_client.Clientset, orgName, folderName, projectName string,
 ) (*nexus_client.ProjectProject, error) {
 	configOrg, err := getConfigOrg(client, orgName)
@@ -614,14 +462,58 @@ func getConfigProject(client *nexus_client.Clientset, orgName, folderName, proje
 		Folders(folderName).GetProjects(context.Background(), projectName)
 	if err != nil {
 		if nexus_client.IsNotFound(err) {
-			// simply return, do nothing.
-			return nil, ErrNotFound
+			return getMovedConfigProject(configOrg, folderName, projectName)
 		}
 		return nil, err
 	}
 	return configProject, nil
 }
 
+// getMovedConfigProject returns the config Project of projectName moved out of folderName, the runtime Folder
+// of its runtime Project.
+func getMovedConfigProject(configOrg *nexus_client.OrgOrg, folderName, projectName string,
+) (*nexus_client.ProjectProject, error) {
+	folders, err := configOrg.GetAllFolders(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	for _, folder := range folders {
+		if folder.DisplayName() == folderName {
+			continue
+		}
+		configProject, err := folder.GetProjects(context.Background(), projectName)
+		if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if RuntimeFolder(configProject) == folderName {
+			return configProject, nil
+		}
+	}
+	// simply return, do nothing.
+	return nil, ErrNotFound
+}
+
+// ensureRuntimeFolder adds the runtime Folder of folderName, if missing. The runtime Folders of the Folders
+// created after their Org are added with their first Project.
+func ensureRuntimeFolder(ctx context.Context, client *nexus_client.Clientset, orgName, folderName string) error {
+	runtimeOrg, err := client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, orgName)
+	if err != nil {
+		return err
+	}
+	_, err = runtimeOrg.GetFolders(ctx, folderName)
+	if !nexus_client.IsNotFound(err) && !nexus_client.IsChildNotFound(err) {
+		return err
+	}
+	_, err = runtimeOrg.AddFolders(ctx, &runtimefoldersv1.RuntimeFolder{ObjectMeta: metav1.ObjectMeta{Name: folderName}})
+	if err != nil && !nexus_client.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
 func getMapKeys(m map[string]struct{}) []string {
 	keys := make([]string, 0, len(m))
 	for k := range m {
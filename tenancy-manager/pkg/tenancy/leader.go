@@ -0,0 +1,79 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Standby makes r drop every event until Lead is called. A standby replica keeps its caches warm
+// while another replica, the leader, processes the events.
+func (r *Reconciler) Standby() {
+	r.standby.Store(true)
+}
+
+// Leading reports whether r processes events.
+func (r *Reconciler) Leading() bool {
+	return !r.standby.Load()
+}
+
+// Lead makes r process events after it was on standby. The events dropped on standby are replayed from the caches,
+// as on a fresh start, then Start resumes the in-flight operations and blocks until ctx is done.
+func (r *Reconciler) Lead(ctx context.Context) {
+	log.Info().Msg("Leading, resyncing the orgs and projects")
+	r.standby.Store(false)
+	r.resync(ctx)
+	r.Start(ctx)
+}
+
+// resync replays the add events of the config Orgs and Projects and of their active watchers.
+// Orgs and Projects that are IDLE are skipped by their handlers.
+func (r *Reconciler) resync(ctx context.Context) {
+	orgs, err := r.Client.Org().ListOrgs(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.InfraErr(err).Msg("Unable to list config Orgs to resync")
+	}
+	for _, org := range orgs {
+		r.ProcessOrgsAdd(org)
+	}
+	projects, err := r.Client.Project().ListProjects(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.InfraErr(err).Msg("Unable to list config Projects to resync")
+	}
+	for _, project := range projects {
+		r.ProcessProjectsAdd(project)
+	}
+
+	orgWatchers, err := r.Client.Orgactivewatcher().ListOrgActiveWatchers(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.InfraErr(err).Msg("Unable to list OrgActiveWatchers to resync")
+	}
+	for _, w := range orgWatchers {
+		r.ProcessOrgActiveWatcherAdd(w)
+	}
+	projectWatchers, err := r.Client.Projectactivewatcher().ListProjectActiveWatchers(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.InfraErr(err).Msg("Unable to list ProjectActiveWatchers to resync")
+	}
+	for _, w := range projectWatchers {
+		r.ProcessProjectActiveWatcherAdd(w)
+	}
+}
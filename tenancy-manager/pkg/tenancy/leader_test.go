@@ -0,0 +1,73 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package tenancy_test
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	orgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/config"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = ginkgo.Describe("Leader election", func() {
+	var (
+		reconciler *tenancy.Reconciler
+		registry   *prometheus.Registry
+	)
+	org := &nexus_client.OrgOrg{Org: &orgsv1.Org{ObjectMeta: metav1.ObjectMeta{Name: "acme"}}}
+
+	gauge := func(name, queue string) float64 {
+		families, err := registry.Gather()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		for _, family := range families {
+			if family.GetName() != name {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				if queue == "" || metric.GetLabel()[0].GetValue() == queue {
+					return metric.GetGauge().GetValue()
+				}
+			}
+		}
+		return -1
+	}
+
+	ginkgo.BeforeEach(func() {
+		reconciler = tenancy.NewReconciler(nexus_client.NewFakeClient(), &config.Config{})
+		registry = prometheus.NewRegistry()
+		gomega.Expect(reconciler.RegisterMetrics(registry)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("should queue events when leader election is disabled", func() {
+		gomega.Expect(reconciler.Leading()).To(gomega.BeTrue())
+		reconciler.ProcessOrgRetry(org)
+		gomega.Expect(gauge("tenancy_manager_queue_depth", "orgs")).To(gomega.Equal(1.0))
+	})
+
+	ginkgo.It("should drop events on standby", func() {
+		reconciler.Standby()
+		gomega.Expect(reconciler.Leading()).To(gomega.BeFalse())
+		reconciler.ProcessOrgRetry(org)
+		gomega.Expect(gauge("tenancy_manager_queue_depth", "orgs")).To(gomega.Equal(0.0))
+		gomega.Expect(gauge("tenancy_manager_leader", "")).To(gomega.Equal(0.0))
+	})
+})
@@ -0,0 +1,172 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// orgSpecChanged reports whether the fields of a config Org copied to its runtime Org changed.
+func orgSpecChanged(old, updated *nexus_client.OrgOrg) bool {
+	return old.Spec.Suspended != updated.Spec.Suspended || !maps.Equal(old.Spec.Labels, updated.Spec.Labels) ||
+		!slices.Equal(old.Spec.Owners, updated.Spec.Owners)
+}
+
+// projectSpecChanged reports whether the fields of a config Project copied to its runtime Project changed.
+func projectSpecChanged(old, updated *nexus_client.ProjectProject) bool {
+	return old.Spec.Archived != updated.Spec.Archived || !maps.Equal(old.Spec.Labels, updated.Spec.Labels) ||
+		!slices.Equal(old.Spec.Owners, updated.Spec.Owners)
+}
+
+// syncRuntimeOrg queues the propagation of the suspension, the labels and the owners of the org of displayName
+// to its runtime Org.
+func (r *Reconciler) syncRuntimeOrg(displayName string) {
+	r.orgs.enqueue(workKey{event: eventOrgSync, hashName: displayName}, task{
+		lock: orgLockKey(displayName),
+		run:  func() error { return r.processRuntimeOrgSync(displayName) },
+		fail: func(err error) {
+			log.InfraErr(err).Msgf("Unable to propagate the spec of org %s", displayName)
+		},
+	})
+}
+
+// processRuntimeOrgSync copies the suspension, the labels and the owners of the config Org of displayName to its
+// runtime Org, for the watchers to react to them. It returns an error only for transient failures that should
+// be retried.
+func (r *Reconciler) processRuntimeOrgSync(displayName string) error {
+	ctx := context.Background()
+	org, err := getConfigOrg(r.Client, displayName)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !org.DeletionTimestamp.IsZero() && !Testing {
+		return nil
+	}
+	runtimeOrg, err := r.Client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, displayName)
+	if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+		// The runtime Org is created with the spec of the config Org.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Org: %w", err)
+	}
+	suspended := runtimeOrg.Spec.Suspended != org.Spec.Suspended
+	if runtimeOrg.Spec.Deleted || (!suspended && maps.Equal(runtimeOrg.Spec.Labels, org.Spec.Labels) &&
+		slices.Equal(runtimeOrg.Spec.Owners, org.Spec.Owners)) {
+		return nil
+	}
+	runtimeOrg.Spec.Suspended = org.Spec.Suspended
+	runtimeOrg.Spec.Labels, runtimeOrg.Spec.Owners = org.Spec.Labels, org.Spec.Owners
+	if err := runtimeOrg.Update(ctx); err != nil {
+		return fmt.Errorf("unable to update runtime Org: %w", err)
+	}
+	if suspended {
+		r.lifecycleChanged(configRef(orgKind, org.Name, org.UID), orgKind, displayName, org.Spec.Suspended)
+	}
+	return nil
+}
+
+// syncRuntimeProject queues the propagation of the archival, the labels, the owners and the folder of a project
+// to its runtime Project.
+func (r *Reconciler) syncRuntimeProject(orgName, folderName, displayName string) {
+	r.projects.enqueue(workKey{event: eventProjectSync, hashName: projectLockKey(orgName, folderName, displayName)}, task{
+		lock: projectLockKey(orgName, folderName, displayName),
+		run:  func() error { return r.processRuntimeProjectSync(orgName, folderName, displayName) },
+		fail: func(err error) {
+			log.InfraErr(err).Msgf("Unable to propagate the spec of project %s", displayName)
+		},
+	})
+}
+
+// processRuntimeProjectSync copies the archival, the labels, the owners and the folder of a config Project to its
+// runtime Project, for the watchers to react to them. It returns an error only for transient failures that should
+// be retried.
+func (r *Reconciler) processRuntimeProjectSync(orgName, folderName, displayName string) error {
+	ctx := context.Background()
+	project, err := getConfigProject(r.Client, orgName, folderName, displayName)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !project.DeletionTimestamp.IsZero() && !Testing {
+		return nil
+	}
+	runtimeProject, err := r.Client.TenancyMultiTenancy().Runtime().Orgs(orgName).Folders(folderName).
+		GetProjects(ctx, displayName)
+	if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+		// The runtime Project is created with the spec of the config Project.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Project: %w", err)
+	}
+	archived := runtimeProject.Spec.Archived != project.Spec.Archived
+	folder := project.GetLabels()["folders.folder.edge-orchestrator.intel.com"]
+	if runtimeProject.Spec.Deleted || (!archived && maps.Equal(runtimeProject.Spec.Labels, project.Spec.Labels) &&
+		slices.Equal(runtimeProject.Spec.Owners, project.Spec.Owners) && runtimeProject.Spec.Folder == folder) {
+		return nil
+	}
+	runtimeProject.Spec.Archived = project.Spec.Archived
+	runtimeProject.Spec.Labels, runtimeProject.Spec.Owners = project.Spec.Labels, project.Spec.Owners
+	runtimeProject.Spec.Folder = folder
+	if err := runtimeProject.Update(ctx); err != nil {
+		return fmt.Errorf("unable to update runtime Project: %w", err)
+	}
+	if archived {
+		r.lifecycleChanged(configRef(projectKind, project.Name, project.UID), projectKind, displayName,
+			project.Spec.Archived)
+	}
+	return nil
+}
+
+// lifecycleReason returns the reason and the message of the Kubernetes Event of the suspension of an org,
+// or the archival of a project, being set or cleared.
+func lifecycleReason(kind, displayName string, set bool) (string, string) {
+	switch {
+	case kind == projectKind && set:
+		return reasonArchived, fmt.Sprintf("Project %s archived", displayName)
+	case kind == projectKind:
+		return reasonUnarchived, fmt.Sprintf("Project %s unarchived", displayName)
+	case set:
+		return reasonSuspended, fmt.Sprintf("Org %s suspended", displayName)
+	default:
+		return reasonResumed, fmt.Sprintf("Org %s resumed", displayName)
+	}
+}
+
+// lifecycleChanged emits the Kubernetes Event of a suspension or an archival on a config Org or Project.
+func (r *Reconciler) lifecycleChanged(ref *corev1.ObjectReference, kind, displayName string, set bool) {
+	reason, msg := lifecycleReason(kind, displayName, set)
+	log.Debug().Msg(msg)
+	if r.Recorder != nil {
+		r.Recorder.Event(ref, corev1.EventTypeNormal, reason, msg)
+	}
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package tenancy_test
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+)
+
+var _ = ginkgo.Describe("Suspension and archival", func() {
+	ginkgo.It("should report the suspension and the resumption of an org", func() {
+		reason, msg := tenancy.LifecycleReason(tenancy.OrgKind, "coke", true)
+		gomega.Expect(reason).To(gomega.Equal("Suspended"))
+		gomega.Expect(msg).To(gomega.Equal("Org coke suspended"))
+
+		reason, msg = tenancy.LifecycleReason(tenancy.OrgKind, "coke", false)
+		gomega.Expect(reason).To(gomega.Equal("Resumed"))
+		gomega.Expect(msg).To(gomega.Equal("Org coke resumed"))
+	})
+
+	ginkgo.It("should report the archival and the unarchival of a project", func() {
+		reason, msg := tenancy.LifecycleReason(tenancy.ProjectKind, "foo", true)
+		gomega.Expect(reason).To(gomega.Equal("Archived"))
+		gomega.Expect(msg).To(gomega.Equal("Project foo archived"))
+
+		reason, msg = tenancy.LifecycleReason(tenancy.ProjectKind, "foo", false)
+		gomega.Expect(reason).To(gomega.Equal("Unarchived"))
+		gomega.Expect(msg).To(gomega.Equal("Project foo unarchived"))
+	})
+})
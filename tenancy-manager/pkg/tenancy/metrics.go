@@ -0,0 +1,248 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	orgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	orgactivewatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgactivewatcher.edge-orchestrator.intel.com/v1"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "tenancy_manager"
+
+	resultSuccess = "success"
+	resultError   = "error"
+)
+
+// operationBuckets cover watchers acknowledging in seconds up to the default timeouts of several minutes.
+var operationBuckets = []float64{1, 2, 5, 10, 20, 30, 60, 120, 300, 600, 1200}
+
+// lifecycleMetrics are the Prometheus metrics of the creates and deletes of Orgs and Projects.
+type lifecycleMetrics struct {
+	durations  *prometheus.HistogramVec
+	inProgress *prometheus.GaugeVec
+	inError    *prometheus.GaugeVec
+	ackLatency *prometheus.HistogramVec
+	timeouts   *prometheus.CounterVec
+	drift      *prometheus.CounterVec
+	driftRun   prometheus.Gauge
+
+	mu sync.Mutex
+	// tenants holds the Orgs and Projects in progress or in error, by lock key.
+	tenants map[string]tenantState
+}
+
+// tenantState is the last status set on an Org or Project, and when its current operation started.
+type tenantState struct {
+	kind    string
+	event   Event
+	status  string
+	started time.Time
+}
+
+func newLifecycleMetrics() *lifecycleMetrics {
+	return &lifecycleMetrics{
+		durations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of the creates and deletes of orgs and projects, from the request to its outcome.",
+			Buckets:   operationBuckets,
+		}, []string{"kind", "event", "result"}),
+		inProgress: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "operations_in_progress",
+			Help:      "Number of orgs and projects whose create or delete is in progress.",
+		}, []string{"kind", "event"}),
+		inError: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "operations_in_error",
+			Help:      "Number of orgs and projects whose last create or delete failed.",
+		}, []string{"kind", "event"}),
+		ackLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "watcher_ack_latency_seconds",
+			Help:      "Time for a watcher to acknowledge the create of an org or project.",
+			Buckets:   operationBuckets,
+		}, []string{"kind", "watcher"}),
+		timeouts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "watcher_timeouts_total",
+			Help:      "Number of times a watcher did not complete a create or delete within its timeout.",
+		}, []string{"kind", "event", "watcher"}),
+		drift: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "drift_repaired_total",
+			Help:      "Number of drifts between the config and runtime trees that were repaired.",
+		}, []string{"kind", "drift"}),
+		driftRun: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "drift_check_timestamp_seconds",
+			Help:      "Unix time of the last check of the config and runtime trees for drift.",
+		}),
+		tenants: map[string]tenantState{},
+	}
+}
+
+// RegisterMetrics registers the metrics of r, including the depth of its work queues, with registerer.
+func (r *Reconciler) RegisterMetrics(registerer prometheus.Registerer) error {
+	queueDepth := func(queue string, length func() int) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace:   metricsNamespace,
+			Name:        "queue_depth",
+			Help:        "Number of events waiting in a work queue.",
+			ConstLabels: prometheus.Labels{"queue": queue},
+		}, func() float64 { return float64(length()) })
+	}
+	collectors := []prometheus.Collector{
+		r.metrics.durations, r.metrics.inProgress, r.metrics.inError, r.metrics.ackLatency, r.metrics.timeouts,
+		r.metrics.drift, r.metrics.driftRun,
+		queueDepth("orgs", r.orgs.queue.Len),
+		queueDepth("projects", r.projects.queue.Len),
+		queueDepth("acknowledgements", r.acks.queue.Len),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "leader",
+			Help:      "Whether this replica processes events (1) or stands by (0).",
+		}, func() float64 {
+			if r.Leading() {
+				return 1
+			}
+			return 0
+		}),
+	}
+	for _, collector := range collectors {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+observeStatus records a status set on the Org or Project of key. The operation is timed from its first IN_PROGRESS
+status to its IDLE or ERROR one, and on a completed create the watchers are timed from the same start to the
+transition of their condition to IDLE. It returns whether the status or the operation changed.
+*/
+func (m *lifecycleMetrics) observeStatus(kind, key string, event Event, status string,
+	conditions []watcherCondition, now time.Time,
+) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	last, known := m.tenants[key]
+	changed := !known || last.status != status || last.event != event
+	switch status {
+	case string(orgsv1.StatusIndicationInProgress):
+		if changed {
+			m.tenants[key] = tenantState{kind: kind, event: event, status: status, started: now}
+		}
+	case string(orgsv1.StatusIndicationIdle):
+		if known && !last.started.IsZero() {
+			m.durations.WithLabelValues(kind, eventLabel(event), resultSuccess).Observe(now.Sub(last.started).Seconds())
+			for _, c := range conditions {
+				at := time.Unix(int64(c.lastTransitionTime), 0) //nolint:gosec // Unix timestamps fit in int64.
+				idle := c.state == string(orgactivewatcherv1.StatusIndicationIdle)
+				if idle && !at.Before(last.started.Truncate(time.Second)) {
+					m.ackLatency.WithLabelValues(kind, c.watcher).Observe(at.Sub(last.started).Seconds())
+				}
+			}
+		}
+		delete(m.tenants, key)
+	case string(orgsv1.StatusIndicationError):
+		if changed && known && !last.started.IsZero() && last.status != status {
+			m.durations.WithLabelValues(kind, eventLabel(event), resultError).Observe(now.Sub(last.started).Seconds())
+		}
+		m.tenants[key] = tenantState{kind: kind, event: event, status: status}
+	}
+	m.updateGauges()
+	return changed
+}
+
+// observeDeleted records the completed delete of the Org or Project of key.
+func (m *lifecycleMetrics) observeDeleted(kind, key string, now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if last, known := m.tenants[key]; known && !last.started.IsZero() {
+		m.durations.WithLabelValues(kind, eventLabel(Delete), resultSuccess).Observe(now.Sub(last.started).Seconds())
+	}
+	delete(m.tenants, key)
+	m.updateGauges()
+}
+
+// restore tracks an Org or Project found in progress or in error on startup. Its operation is not timed,
+// as it started before.
+func (m *lifecycleMetrics) restore(kind, key string, event Event, status string) {
+	if status != string(orgsv1.StatusIndicationInProgress) && status != string(orgsv1.StatusIndicationError) {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tenants[key] = tenantState{kind: kind, event: event, status: status}
+	m.updateGauges()
+}
+
+// observeTimeouts counts the watchers of verdict that timed out. While waiting, only the watchers retried are
+// counted, as the others are evaluated again on the next poll; once the operation ends all of them are.
+func (m *lifecycleMetrics) observeTimeouts(kind string, event Event, verdict watcherVerdict, ended bool) {
+	retried := map[string]struct{}{}
+	for _, name := range verdict.retry {
+		retried[name] = struct{}{}
+	}
+	for _, name := range verdict.timedOut {
+		if _, ok := retried[name]; ok || ended {
+			m.timeouts.WithLabelValues(kind, eventLabel(event), name).Inc()
+		}
+	}
+}
+
+// eventLabel is the label value of event, as in "create" or "delete".
+func eventLabel(event Event) string {
+	return strings.ToLower(string(event))
+}
+
+// updateGauges sets the in progress and in error gauges from the tracked Orgs and Projects.
+func (m *lifecycleMetrics) updateGauges() {
+	m.inProgress.Reset()
+	m.inError.Reset()
+	for _, kind := range []string{orgKind, projectKind} {
+		for _, event := range []Event{Create, Delete} {
+			m.inProgress.WithLabelValues(kind, eventLabel(event)).Set(0)
+			m.inError.WithLabelValues(kind, eventLabel(event)).Set(0)
+		}
+	}
+	for _, t := range m.tenants {
+		switch t.status {
+		case string(orgsv1.StatusIndicationInProgress):
+			m.inProgress.WithLabelValues(t.kind, eventLabel(t.event)).Inc()
+		case string(orgsv1.StatusIndicationError):
+			m.inError.WithLabelValues(t.kind, eventLabel(t.event)).Inc()
+		}
+	}
+}
+
+// driftChecked records the end of a check of the config and runtime trees for drift.
+func (m *lifecycleMetrics) driftChecked(now time.Time) {
+	m.driftRun.Set(float64(now.Unix()))
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package tenancy_test
+
+import (
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	orgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/config"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = ginkgo.Describe("Lifecycle metrics and events", func() {
+	var (
+		reconciler *tenancy.Reconciler
+		registry   *prometheus.Registry
+		recorder   *record.FakeRecorder
+	)
+	started := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	gather := func(name string) []*dto.Metric {
+		families, err := registry.Gather()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		for _, family := range families {
+			if family.GetName() == name {
+				return family.GetMetric()
+			}
+		}
+		return nil
+	}
+	value := func(name, kind, event string) float64 {
+		for _, metric := range gather(name) {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["kind"] == kind && labels["event"] == event {
+				if metric.GetHistogram() != nil {
+					return float64(metric.GetHistogram().GetSampleCount())
+				}
+				return metric.GetGauge().GetValue()
+			}
+		}
+		return -1
+	}
+
+	ginkgo.BeforeEach(func() {
+		reconciler = tenancy.NewReconciler(nexus_client.NewFakeClient(), &config.Config{})
+		registry = prometheus.NewRegistry()
+		gomega.Expect(reconciler.RegisterMetrics(registry)).To(gomega.Succeed())
+		recorder = record.NewFakeRecorder(10)
+		reconciler.Recorder = recorder
+	})
+
+	ginkgo.It("should time a create from IN_PROGRESS to IDLE", func() {
+		reconciler.ObserveStatus("acme", tenancy.Create, string(orgsv1.StatusIndicationInProgress), started)
+		gomega.Expect(value("tenancy_manager_operations_in_progress", "org", "create")).To(gomega.Equal(1.0))
+
+		reconciler.ObserveStatus("acme", tenancy.Create, string(orgsv1.StatusIndicationIdle), started.Add(time.Minute))
+		gomega.Expect(value("tenancy_manager_operations_in_progress", "org", "create")).To(gomega.Equal(0.0))
+		gomega.Expect(value("tenancy_manager_operation_duration_seconds", "org", "create")).To(gomega.Equal(1.0))
+
+		gomega.Expect(recorder.Events).To(gomega.Receive(gomega.HavePrefix("Normal Creating")))
+		gomega.Expect(recorder.Events).To(gomega.Receive(gomega.HavePrefix("Normal Created")))
+	})
+
+	ginkgo.It("should count a failed delete as in error", func() {
+		reconciler.ObserveStatus("acme", tenancy.Delete, string(orgsv1.StatusIndicationInProgress), started)
+		reconciler.ObserveStatus("acme", tenancy.Delete, string(orgsv1.StatusIndicationError), started.Add(time.Minute))
+		gomega.Expect(value("tenancy_manager_operations_in_error", "org", "delete")).To(gomega.Equal(1.0))
+		gomega.Expect(value("tenancy_manager_operations_in_progress", "org", "delete")).To(gomega.Equal(0.0))
+
+		gomega.Expect(recorder.Events).To(gomega.Receive(gomega.HavePrefix("Normal Deleting")))
+		gomega.Expect(recorder.Events).To(gomega.Receive(gomega.HavePrefix("Warning DeleteFailed")))
+	})
+
+	ginkgo.It("should expose the depth of the work queues", func() {
+		gomega.Expect(gather("tenancy_manager_queue_depth")).To(gomega.HaveLen(3))
+	})
+})
@@ -0,0 +1,189 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"context"
+	"fmt"
+
+	networkv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/network.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// networkTypes are the types of Network tenancy-manager sets up.
+var networkTypes = map[networkv1.NetworkType]struct{}{
+	networkv1.AppplicationMesh: {},
+}
+
+// networkState returns the state of a Network of networkType, and the message explaining it, once its Project
+// is in projectStatus. A Network is Ready once its Project is IDLE.
+func networkState(networkType networkv1.NetworkType, projectStatus projectv1.TenancyRequestStatus) (string, string) {
+	if _, ok := networkTypes[networkType]; !ok {
+		return networkv1.NetworkStateError, fmt.Sprintf("Unsupported network type %q", networkType)
+	}
+	if projectStatus != projectv1.StatusIndicationIdle {
+		return networkv1.NetworkStatePending, "Waiting for the project to be ready"
+	}
+	return networkv1.NetworkStateReady, ""
+}
+
+// inProject reports whether the labels of a config Network are those of a child of the config Project
+// of displayName.
+func inProject(network, project metav1.Object, displayName string) bool {
+	for _, parent := range []string{"orgs.org.edge-orchestrator.intel.com", "folders.folder.edge-orchestrator.intel.com"} {
+		if network.GetLabels()[parent] != project.GetLabels()[parent] {
+			return false
+		}
+	}
+	return network.GetLabels()["projects.project.edge-orchestrator.intel.com"] == displayName
+}
+
+// getProjectNetworks returns the config Networks of a config Project, including those being deleted.
+func getProjectNetworks(ctx context.Context, client *nexus_client.Clientset,
+	project *nexus_client.ProjectProject,
+) ([]*nexus_client.NetworkNetwork, error) {
+	networks, err := client.Network().ListNetworks(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list config Networks: %w", err)
+	}
+	var result []*nexus_client.NetworkNetwork
+	for _, network := range networks {
+		if inProject(network, project, project.DisplayName()) {
+			result = append(result, network)
+		}
+	}
+	return result, nil
+}
+
+// ProcessNetworksAdd is the callback function to be invoked when a Network is added.
+func (r *Reconciler) ProcessNetworksAdd(network *nexus_client.NetworkNetwork) {
+	r.ProcessNetworksChange(network)
+	r.syncNetwork(network)
+}
+
+// ProcessNetworksUpdate is the callback function to be invoked when a Network is updated.
+func (r *Reconciler) ProcessNetworksUpdate(old, updated *nexus_client.NetworkNetwork) {
+	if old.Spec == updated.Spec {
+		return
+	}
+	r.syncNetwork(updated)
+}
+
+// ProcessNetworksRemove is the callback function to be invoked once a deleted Network is removed.
+// The delete of its Project, waiting for its Networks to be removed, is queued again.
+func (r *Reconciler) ProcessNetworksRemove(network *nexus_client.NetworkNetwork) {
+	r.ProcessNetworksChange(network)
+	project, err := network.GetParent(context.Background())
+	if err != nil {
+		return
+	}
+	if !project.DeletionTimestamp.IsZero() {
+		r.ProcessProjectsDelete(project)
+	}
+}
+
+// syncNetworks queues the reconciliation of the config Networks of a config Project.
+func (r *Reconciler) syncNetworks(project *nexus_client.ProjectProject) {
+	networks, err := getProjectNetworks(context.Background(), r.Client, project)
+	if err != nil {
+		log.InfraErr(err).Msgf("Unable to reconcile the networks of project %s", project.DisplayName())
+		return
+	}
+	for _, network := range networks {
+		r.syncNetwork(network)
+	}
+}
+
+// syncNetwork queues the reconciliation of a config Network.
+func (r *Reconciler) syncNetwork(network *nexus_client.NetworkNetwork) {
+	r.projects.enqueue(workKey{event: eventNetworkSync, hashName: network.Name}, task{
+		lock: networkLockKey(network.GetLabels()),
+		run:  func() error { return r.processNetwork(network.Name) },
+		fail: func(err error) {
+			log.InfraErr(err).Msgf("Unable to reconcile network %s", network.DisplayName())
+		},
+	})
+}
+
+/*
+processNetwork validates the type of a config Network and reports its state, Pending until its Project is ready.
+It returns an error only for transient failures that should be retried.
+*/
+func (r *Reconciler) processNetwork(hashName string) error {
+	ctx := context.Background()
+	network, err := r.Client.Network().GetNetworkByName(ctx, hashName)
+	if nexus_client.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get config Network: %w", err)
+	}
+	if !network.DeletionTimestamp.IsZero() {
+		// The delete of a Network is not deferred: it waits only for the finalizers of others.
+		return nil
+	}
+	project, err := network.GetParent(ctx)
+	if nexus_client.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get config Project: %w", err)
+	}
+	if !project.DeletionTimestamp.IsZero() {
+		// The Network is deleted with its Project.
+		return nil
+	}
+
+	state, message := networkState(network.Spec.Type, project.Status.ProjectStatus.StatusIndicator)
+	if network.Status.Status.CurrentState == state && network.Status.Status.Message == message {
+		return nil
+	}
+	if err := network.SetStatus(ctx, &networkv1.NetworkStatus{CurrentState: state, Message: message}); err != nil {
+		return fmt.Errorf("unable to set the status of config Network: %w", err)
+	}
+	log.Info().Msgf("Network %s of project %s is %s %s", network.DisplayName(), project.DisplayName(), state, message)
+	return nil
+}
+
+// deleteProjectNetworks deletes the config Networks of a deleted config Project, and returns those that are
+// still held by finalizers.
+func deleteProjectNetworks(ctx context.Context, client *nexus_client.Clientset,
+	project *nexus_client.ProjectProject,
+) ([]string, error) {
+	networks, err := getProjectNetworks(ctx, client, project)
+	if err != nil {
+		return nil, err
+	}
+	pending := make([]string, 0, len(networks))
+	for _, network := range networks {
+		displayName, hashName := network.DisplayName(), network.Name
+		if network.DeletionTimestamp.IsZero() {
+			if err := network.Delete(ctx); err != nil && !nexus_client.IsNotFound(err) {
+				return nil, fmt.Errorf("unable to delete config Network %s: %w", displayName, err)
+			}
+			if _, err := client.Network().GetNetworkByName(ctx, hashName); nexus_client.IsNotFound(err) {
+				continue
+			}
+		}
+		pending = append(pending, displayName)
+	}
+	return pending, nil
+}
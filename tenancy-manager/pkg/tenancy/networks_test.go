@@ -0,0 +1,62 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package tenancy_test
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	networkv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/network.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = ginkgo.Describe("Networks", func() {
+	ginkgo.It("should be Ready once their project is IDLE", func() {
+		state, msg := tenancy.NetworkState(networkv1.AppplicationMesh, projectv1.StatusIndicationInProgress)
+		gomega.Expect(state).To(gomega.Equal(networkv1.NetworkStatePending))
+		gomega.Expect(msg).NotTo(gomega.BeEmpty())
+
+		state, msg = tenancy.NetworkState(networkv1.AppplicationMesh, projectv1.StatusIndicationIdle)
+		gomega.Expect(state).To(gomega.Equal(networkv1.NetworkStateReady))
+		gomega.Expect(msg).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should be in Error for an unsupported type", func() {
+		state, msg := tenancy.NetworkState("vlan", projectv1.StatusIndicationIdle)
+		gomega.Expect(state).To(gomega.Equal(networkv1.NetworkStateError))
+		gomega.Expect(msg).To(gomega.ContainSubstring(`"vlan"`))
+	})
+
+	ginkgo.It("should belong to the project of their labels only", func() {
+		project := &metav1.ObjectMeta{Labels: map[string]string{
+			"orgs.org.edge-orchestrator.intel.com":       "coke",
+			"folders.folder.edge-orchestrator.intel.com": "default",
+		}}
+		network := &metav1.ObjectMeta{Labels: map[string]string{
+			"orgs.org.edge-orchestrator.intel.com":         "coke",
+			"folders.folder.edge-orchestrator.intel.com":   "default",
+			"projects.project.edge-orchestrator.intel.com": "foo",
+		}}
+		gomega.Expect(tenancy.InProject(network, project, "foo")).To(gomega.BeTrue())
+		gomega.Expect(tenancy.InProject(network, project, "bar")).To(gomega.BeFalse())
+
+		project.Labels["folders.folder.edge-orchestrator.intel.com"] = "team-a"
+		gomega.Expect(tenancy.InProject(network, project, "foo")).To(gomega.BeFalse())
+	})
+})
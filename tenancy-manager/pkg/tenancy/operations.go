@@ -0,0 +1,499 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	networkv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/network.edge-orchestrator.intel.com/v1"
+	orgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Operator requests are annotations on a config Org or Project, set by nexus-api-gw or by hand.
+const (
+	// RetryRequestedAnnotation asks to re-run the failed create or delete of an Org or Project in ERROR,
+	// for the watchers that did not complete it. Its value is the time of the request.
+	RetryRequestedAnnotation = "tenancy-manager.edge-orchestrator.intel.com/retry-requested"
+	// ForceDeleteAnnotation asks to delete an Org or Project without waiting for its watchers.
+	// It takes effect once the object is deleted, and only if its value is the name of the object, as confirmation.
+	ForceDeleteAnnotation = "tenancy-manager.edge-orchestrator.intel.com/force-delete"
+	// MoveRequestedAnnotation asks to move an IDLE Project to the Folder it names, in the same Org.
+	// The Project keeps its runtime Project, and so its UID and the state of its watchers.
+	MoveRequestedAnnotation = "tenancy-manager.edge-orchestrator.intel.com/move-to"
+	// RequestedByAnnotation names the user who made the request, for the audit log.
+	RequestedByAnnotation = "tenancy-manager.edge-orchestrator.intel.com/requested-by"
+
+	// movedToAnnotation marks the former config Project of a moved Project, whose delete leaves the runtime
+	// Project alone. Its value is the Folder the Project was moved to.
+	movedToAnnotation = "tenancy-manager.edge-orchestrator.intel.com/moved-to"
+
+	operationRetry       = "retry"
+	operationForceDelete = "force-delete"
+	operationMove        = "move"
+)
+
+// audit returns the logger of the audit events of an operator request on obj.
+func audit(operation, target string, obj metav1.Object) *logging.InfraLogger {
+	return log.InfraAuditEvent().InfraAuditOperation(operation).InfraAuditPath(target).
+		InfraAuditUsr(obj.GetAnnotations()[RequestedByAnnotation])
+}
+
+// retryRequested reports whether updated carries a retry request that old did not.
+func retryRequested(old, updated metav1.Object) bool {
+	request := updated.GetAnnotations()[RetryRequestedAnnotation]
+	return request != "" && (old == nil || old.GetAnnotations()[RetryRequestedAnnotation] != request)
+}
+
+// moveRequested reports whether updated, not marked deleted, carries a move request that old did not.
+func moveRequested(old, updated metav1.Object) bool {
+	request := updated.GetAnnotations()[MoveRequestedAnnotation]
+	return request != "" && updated.GetDeletionTimestamp().IsZero() &&
+		(old == nil || old.GetAnnotations()[MoveRequestedAnnotation] != request)
+}
+
+// forceDeleteConfirmed reports whether obj, marked deleted, is to be deleted without waiting for its watchers.
+func forceDeleteConfirmed(obj metav1.Object, displayName string) bool {
+	return !obj.GetDeletionTimestamp().IsZero() && obj.GetAnnotations()[ForceDeleteAnnotation] == displayName
+}
+
+// restartWatchers starts a new wait for event on obj, in which the failed watchers are asked to process it again.
+func restartWatchers(obj metav1.Object, event Event, failed []string, now time.Time) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[startedAnnotation(event)] = now.UTC().Format(time.RFC3339)
+	if _, ok := annotations[ReadyWatchersAnnotation]; !ok && event == Create {
+		annotations[ReadyWatchersAnnotation] = ""
+	}
+	obj.SetAnnotations(annotations)
+	for _, watcher := range failed {
+		requestRetry(obj, watcher, now)
+	}
+}
+
+// clearRequest removes the operator request annotation from the config object.
+func clearRequest(ctx context.Context, obj runtimeObject, annotation string) error {
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations[annotation]; !ok {
+		return nil
+	}
+	delete(annotations, annotation)
+	obj.SetAnnotations(annotations)
+	if err := obj.Update(ctx); err != nil && !nexus_client.IsNotFound(err) {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to clear the request: %w", err)
+		}
+		log.InfraErr(err).Msgf("Unable to clear the request %s", annotation)
+	}
+	return nil
+}
+
+// processOrgRequests queues the operator requests carried by an update of a config Org,
+// and reports whether the update was handled.
+func (r *Reconciler) processOrgRequests(old, updated *nexus_client.OrgOrg) bool {
+	switch {
+	case forceDeleteConfirmed(updated, updated.DisplayName()):
+		r.orgs.enqueue(workKey{event: eventOrgForceDelete, hashName: updated.Name}, task{
+			lock: orgLockKey(updated.DisplayName()),
+			run:  r.withCurrentOrg(updated, r.processOrgForceDelete),
+		})
+		return true
+	case retryRequested(old, updated):
+		r.ProcessOrgRetry(updated)
+		return true
+	}
+	return false
+}
+
+// ProcessOrgRetry queues the retry of an Org in ERROR.
+func (r *Reconciler) ProcessOrgRetry(org *nexus_client.OrgOrg) {
+	r.orgs.enqueue(workKey{event: eventOrgRetry, hashName: org.Name}, task{
+		lock: orgLockKey(org.DisplayName()),
+		run:  r.withCurrentOrg(org, r.processOrgRetry),
+	})
+}
+
+/*
+processOrgRetry re-runs the create or delete of an Org in ERROR. Only the watchers that did not complete it are
+asked to process it again, each with a new timeout. If the runtime Org is gone, the whole flow is re-run.
+*/
+func (r *Reconciler) processOrgRetry(org *nexus_client.OrgOrg) error {
+	ctx := context.Background()
+	target := "org/" + org.DisplayName()
+	if org.Status.OrgStatus.StatusIndicator != orgsv1.StatusIndicationError {
+		audit(operationRetry, target, org).Info().Msgf("Ignoring the retry of org %s, it is %s",
+			org.DisplayName(), org.Status.OrgStatus.StatusIndicator)
+		return clearRequest(ctx, org, RetryRequestedAnnotation)
+	}
+	event := Create
+	if !org.DeletionTimestamp.IsZero() {
+		event = Delete
+	}
+
+	runtimeOrg, err := r.Client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, org.DisplayName())
+	if err != nil && !nexus_client.IsNotFound(err) {
+		return fmt.Errorf("unable to get runtime Org: %w", err)
+	}
+	if err != nil {
+		audit(operationRetry, target, org).Info().Msgf("Retrying the %s of org %s from the start", event, org.DisplayName())
+		if event == Delete {
+			err = r.processOrgsDelete(org)
+		} else {
+			err = r.processOrgsAdd(org)
+		}
+		if err != nil {
+			return err
+		}
+		return clearRequest(ctx, org, RetryRequestedAnnotation)
+	}
+
+	plan, err := getOrgWatcherPlan(r.Client)
+	if err != nil {
+		if isRetryable(err) {
+			return err
+		}
+		if err := r.setOrgStatus(org.DisplayName(), org.Name, orgsv1.StatusIndicationError,
+			fmt.Sprintf("Org retry failed: unable to fetch expectedOrgWatchers, error: %v", err), event); err != nil {
+			return err
+		}
+		return clearRequest(ctx, org, RetryRequestedAnnotation)
+	}
+	failed := plan.unfinished(event, orgWatcherStates(ctx, runtimeOrg))
+	audit(operationRetry, target, org).Info().Msgf("Retrying the %s of org %s for watchers %v",
+		event, org.DisplayName(), failed)
+
+	restartWatchers(runtimeOrg, event, failed, time.Now())
+	if event == Delete {
+		if err := runtimeOrg.Update(ctx); err != nil {
+			return fmt.Errorf("unable to restart the watchers of runtime Org: %w", err)
+		}
+		if err := r.processOrgsDelete(org); err != nil {
+			return err
+		}
+		return clearRequest(ctx, org, RetryRequestedAnnotation)
+	}
+
+	if _, err := markReadyOrgWatchers(ctx, r.Client, runtimeOrg, Create, nil); err != nil {
+		log.InfraErr(err).Msgf("Unable to order the watchers of org %s, releasing all of them", org.DisplayName())
+		clearReadyWatchers(runtimeOrg.GetAnnotations())
+	}
+	if err := runtimeOrg.Update(ctx); err != nil {
+		return fmt.Errorf("unable to restart the watchers of runtime Org: %w", err)
+	}
+	if err := r.setOrgStatus(org.DisplayName(), org.Name, orgsv1.StatusIndicationInProgress,
+		fmt.Sprintf("Retrying watchers %v of org %s", failed, org.DisplayName()), Create); err != nil {
+		return err
+	}
+	r.enqueueAck(ackRequest{kind: orgKind, event: Create, displayName: org.DisplayName()})
+	return clearRequest(ctx, org, RetryRequestedAnnotation)
+}
+
+// processOrgForceDelete deletes the active watchers and the runtime Org without waiting for the watchers,
+// then releases the config Org.
+func (r *Reconciler) processOrgForceDelete(org *nexus_client.OrgOrg) error {
+	ctx := context.Background()
+	target := "org/" + org.DisplayName()
+	audit(operationForceDelete, target, org).Info().Msgf("Force-deleting org %s", org.DisplayName())
+
+	runtimeOrg, err := r.Client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, org.DisplayName())
+	switch {
+	case err == nil:
+		for name := range orgWatcherStates(ctx, runtimeOrg) {
+			if err := runtimeOrg.DeleteActiveWatchers(ctx, name); err != nil && !nexus_client.IsNotFound(err) {
+				return fmt.Errorf("unable to delete active watcher %s: %w", name, err)
+			}
+			audit(operationForceDelete, target, org).Info().Msgf("Deleted active watcher %s of org %s",
+				name, org.DisplayName())
+		}
+		if err := runtimeOrg.Delete(ctx); err != nil && !nexus_client.IsNotFound(err) {
+			return fmt.Errorf("unable to delete runtime Org: %w", err)
+		}
+	case !nexus_client.IsNotFound(err):
+		return fmt.Errorf("unable to get runtime Org: %w", err)
+	}
+
+	org.SetFinalizers([]string{})
+	if err := org.Update(ctx); err != nil && !nexus_client.IsNotFound(err) {
+		return fmt.Errorf("unable to remove the finalizers of config Org: %w", err)
+	}
+	audit(operationForceDelete, target, org).Info().Msgf("Force-deleted org %s", org.DisplayName())
+	r.orgDeleted(org)
+	return nil
+}
+
+// processProjectRequests queues the operator requests carried by an update of a config Project,
+// and reports whether the update was handled.
+func (r *Reconciler) processProjectRequests(old, updated *nexus_client.ProjectProject) bool {
+	switch {
+	case forceDeleteConfirmed(updated, updated.DisplayName()):
+		r.projects.enqueue(workKey{event: eventProjectForceDelete, hashName: updated.Name}, task{
+			lock: configProjectLockKey(updated),
+			run:  r.withCurrentProject(updated, r.processProjectForceDelete),
+		})
+		return true
+	case retryRequested(old, updated):
+		r.ProcessProjectRetry(updated)
+		return true
+	case moveRequested(old, updated):
+		r.projects.enqueue(workKey{event: eventProjectMove, hashName: updated.Name}, task{
+			lock: configProjectLockKey(updated),
+			run:  func() error { return r.processProjectMove(updated) },
+		})
+		return true
+	}
+	return false
+}
+
+// ProcessProjectRetry queues the retry of a Project in ERROR.
+func (r *Reconciler) ProcessProjectRetry(project *nexus_client.ProjectProject) {
+	r.projects.enqueue(workKey{event: eventProjectRetry, hashName: project.Name}, task{
+		lock: configProjectLockKey(project),
+		run:  r.withCurrentProject(project, r.processProjectRetry),
+	})
+}
+
+/*
+processProjectRetry re-runs the create or delete of a Project in ERROR. Only the watchers that did not complete it
+are asked to process it again, each with a new timeout. If the runtime Project is gone, the whole flow is re-run.
+*/
+func (r *Reconciler) processProjectRetry(project *nexus_client.ProjectProject) error {
+	ctx := context.Background()
+	parentOrgName := project.GetLabels()["orgs.org.edge-orchestrator.intel.com"]
+	parentFolderName := RuntimeFolder(project)
+	target := "project/" + parentOrgName + "/" + project.DisplayName()
+	if project.Status.ProjectStatus.StatusIndicator != projectv1.StatusIndicationError {
+		audit(operationRetry, target, project).Info().Msgf("Ignoring the retry of project %s, it is %s",
+			project.DisplayName(), project.Status.ProjectStatus.StatusIndicator)
+		return clearRequest(ctx, project, RetryRequestedAnnotation)
+	}
+	event := Create
+	if !project.DeletionTimestamp.IsZero() {
+		event = Delete
+	}
+
+	runtimeProject, err := r.Client.TenancyMultiTenancy().Runtime().Orgs(parentOrgName).Folders(parentFolderName).
+		GetProjects(ctx, project.DisplayName())
+	if err != nil && !nexus_client.IsNotFound(err) {
+		return fmt.Errorf("unable to get runtime Project: %w", err)
+	}
+	if err != nil {
+		audit(operationRetry, target, project).Info().Msgf("Retrying the %s of project %s from the start",
+			event, project.DisplayName())
+		if event == Delete {
+			err = r.processProjectsDelete(project)
+		} else {
+			err = r.processProjectsAdd(project)
+		}
+		if err != nil {
+			return err
+		}
+		return clearRequest(ctx, project, RetryRequestedAnnotation)
+	}
+
+	plan, err := getProjectWatcherPlan(r.Client)
+	if err != nil {
+		if isRetryable(err) {
+			return err
+		}
+		if err := r.setProjectStatus(project.DisplayName(), project.Name, parentOrgName, parentFolderName,
+			projectv1.StatusIndicationError,
+			fmt.Sprintf("Project retry failed: unable to fetch expectedProjectWatchers, error: %v", err), event); err != nil {
+			return err
+		}
+		return clearRequest(ctx, project, RetryRequestedAnnotation)
+	}
+	failed := plan.unfinished(event, projectWatcherStates(ctx, runtimeProject))
+	audit(operationRetry, target, project).Info().Msgf("Retrying the %s of project %s for watchers %v",
+		event, project.DisplayName(), failed)
+
+	restartWatchers(runtimeProject, event, failed, time.Now())
+	if event == Delete {
+		if err := runtimeProject.Update(ctx); err != nil {
+			return fmt.Errorf("unable to restart the watchers of runtime Project: %w", err)
+		}
+		if err := r.processProjectsDelete(project); err != nil {
+			return err
+		}
+		return clearRequest(ctx, project, RetryRequestedAnnotation)
+	}
+
+	if _, err := markReadyProjectWatchers(ctx, r.Client, runtimeProject, Create, nil); err != nil {
+		log.InfraErr(err).Msgf("Unable to order the watchers of project %s, releasing all of them",
+			project.DisplayName())
+		clearReadyWatchers(runtimeProject.GetAnnotations())
+	}
+	if err := runtimeProject.Update(ctx); err != nil {
+		return fmt.Errorf("unable to restart the watchers of runtime Project: %w", err)
+	}
+	if err := r.setProjectStatus(project.DisplayName(), project.Name, parentOrgName, parentFolderName,
+		projectv1.StatusIndicationInProgress,
+		fmt.Sprintf("Retrying watchers %v of project %s", failed, project.DisplayName()), Create); err != nil {
+		return err
+	}
+	r.enqueueAck(projectAck(project, Create))
+	return clearRequest(ctx, project, RetryRequestedAnnotation)
+}
+
+// processProjectForceDelete deletes the active watchers and the runtime Project without waiting for the watchers,
+// then releases the config Project.
+func (r *Reconciler) processProjectForceDelete(project *nexus_client.ProjectProject) error {
+	ctx := context.Background()
+	parentOrgName := project.GetLabels()["orgs.org.edge-orchestrator.intel.com"]
+	parentFolderName := RuntimeFolder(project)
+	target := "project/" + parentOrgName + "/" + project.DisplayName()
+	audit(operationForceDelete, target, project).Info().Msgf("Force-deleting project %s", project.DisplayName())
+
+	runtimeProject, err := r.Client.TenancyMultiTenancy().Runtime().Orgs(parentOrgName).Folders(parentFolderName).
+		GetProjects(ctx, project.DisplayName())
+	switch {
+	case err == nil:
+		for name := range projectWatcherStates(ctx, runtimeProject) {
+			if err := runtimeProject.DeleteActiveWatchers(ctx, name); err != nil && !nexus_client.IsNotFound(err) {
+				return fmt.Errorf("unable to delete active watcher %s: %w", name, err)
+			}
+			audit(operationForceDelete, target, project).Info().Msgf("Deleted active watcher %s of project %s",
+				name, project.DisplayName())
+		}
+		if err := runtimeProject.Delete(ctx); err != nil && !nexus_client.IsNotFound(err) {
+			return fmt.Errorf("unable to delete runtime Project: %w", err)
+		}
+	case !nexus_client.IsNotFound(err):
+		return fmt.Errorf("unable to get runtime Project: %w", err)
+	}
+
+	project.SetFinalizers([]string{})
+	if err := project.Update(ctx); err != nil && !nexus_client.IsNotFound(err) {
+		return fmt.Errorf("unable to remove the finalizers of config Project: %w", err)
+	}
+	audit(operationForceDelete, target, project).Info().Msgf("Force-deleted project %s", project.DisplayName())
+	r.projectDeleted(project)
+	return nil
+}
+
+/*
+processProjectMove moves an IDLE Project to the Folder of its move request. As the name of a config Project is
+derived from its Folder, the config Project is recreated in the target Folder, with its spec, its status and its
+Networks, and the former one is deleted. The runtime Project stays where it is, with its UID and active watchers;
+only its folder is updated, for the watchers to react to it.
+*/
+func (r *Reconciler) processProjectMove(project *nexus_client.ProjectProject) error {
+	ctx := context.Background()
+	parentOrgName := project.GetLabels()["orgs.org.edge-orchestrator.intel.com"]
+	currentFolderName := project.GetLabels()["folders.folder.edge-orchestrator.intel.com"]
+	targetFolderName := project.GetAnnotations()[MoveRequestedAnnotation]
+	target := "project/" + parentOrgName + "/" + project.DisplayName()
+
+	project, err := r.Client.Project().GetProjectByName(ctx, project.Name)
+	if nexus_client.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get config Project: %w", err)
+	}
+	if project.Status.ProjectStatus.StatusIndicator != projectv1.StatusIndicationIdle ||
+		!project.DeletionTimestamp.IsZero() || targetFolderName == currentFolderName {
+		audit(operationMove, target, project).Info().Msgf("Ignoring the move of project %s to folder %s, it is %s in %s",
+			project.DisplayName(), targetFolderName, project.Status.ProjectStatus.StatusIndicator, currentFolderName)
+		return clearRequest(ctx, project, MoveRequestedAnnotation)
+	}
+	targetFolder, err := r.Client.TenancyMultiTenancy().Config().Orgs(parentOrgName).GetFolders(ctx, targetFolderName)
+	if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+		audit(operationMove, target, project).Info().Msgf("Ignoring the move of project %s to missing folder %s",
+			project.DisplayName(), targetFolderName)
+		return clearRequest(ctx, project, MoveRequestedAnnotation)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get config Folder: %w", err)
+	}
+	audit(operationMove, target, project).Info().Msgf("Moving project %s from folder %s to folder %s",
+		project.DisplayName(), currentFolderName, targetFolderName)
+
+	moved, err := targetFolder.AddProjects(ctx, &projectv1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        project.DisplayName(),
+			Labels:      maps.Clone(project.Spec.Labels),
+			Annotations: map[string]string{runtimeFolderAnnotation: RuntimeFolder(project)},
+		},
+		Spec: project.Spec,
+	})
+	if nexus_client.IsAlreadyExists(err) {
+		moved, err = targetFolder.GetProjects(ctx, project.DisplayName())
+	}
+	if err != nil {
+		return fmt.Errorf("unable to add config Project to folder %s: %w", targetFolderName, err)
+	}
+	status := project.Status.ProjectStatus
+	if err := moved.SetProjectStatus(ctx, &status); err != nil {
+		return fmt.Errorf("unable to set the status of moved config Project: %w", err)
+	}
+	if err := moveNetworks(ctx, project, moved); err != nil {
+		return err
+	}
+
+	annotations := project.GetAnnotations()
+	delete(annotations, MoveRequestedAnnotation)
+	annotations[movedToAnnotation] = targetFolderName
+	project.SetAnnotations(annotations)
+	if err := project.Update(ctx); err != nil {
+		return fmt.Errorf("unable to mark config Project moved: %w", err)
+	}
+	if err := project.Delete(ctx); err != nil && !nexus_client.IsNotFound(err) {
+		return fmt.Errorf("unable to delete former config Project: %w", err)
+	}
+	audit(operationMove, target, project).Info().Msgf("Moved project %s from folder %s to folder %s",
+		moved.DisplayName(), currentFolderName, targetFolderName)
+	return r.processRuntimeProjectSync(parentOrgName, RuntimeFolder(moved), moved.DisplayName())
+}
+
+// moveNetworks adds the Networks of the former config Project of a moved Project to the new one.
+func moveNetworks(ctx context.Context, from, to *nexus_client.ProjectProject) error {
+	networks, err := from.GetAllNetworks(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list the Networks of config Project: %w", err)
+	}
+	for _, network := range networks {
+		_, err := to.AddNetworks(ctx, &networkv1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: network.DisplayName()},
+			Spec:       network.Spec,
+		})
+		if err != nil && !nexus_client.IsAlreadyExists(err) {
+			return fmt.Errorf("unable to add Network %s to moved config Project: %w", network.DisplayName(), err)
+		}
+	}
+	return nil
+}
+
+// releaseMovedProject releases the former config Project of a moved Project, leaving its runtime Project alone.
+func releaseMovedProject(ctx context.Context, project *nexus_client.ProjectProject) error {
+	project.SetFinalizers([]string{})
+	if err := project.Update(ctx); err != nil && !nexus_client.IsNotFound(err) {
+		return fmt.Errorf("unable to remove the finalizers of former config Project: %w", err)
+	}
+	log.Debug().Msgf("Released project %s (hashName: %s), moved to folder %s",
+		project.DisplayName(), project.Name, project.GetAnnotations()[movedToAnnotation])
+	return nil
+}
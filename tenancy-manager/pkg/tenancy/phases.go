@@ -0,0 +1,406 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	orgactivewatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgactivewatcher.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReadyWatchersAnnotation lists, on a runtime Org or Project, the watchers that may act on it now.
+// While the runtime object is being created they are the watchers whose phase and dependencies are IDLE;
+// once it is marked deleted they are the watchers that no remaining active watcher depends on.
+const ReadyWatchersAnnotation = "tenancy-manager.edge-orchestrator.intel.com/ready-watchers"
+
+// WatcherReady reports whether watcher may act on the runtime Org or Project obj.
+// Runtime objects without the annotation predate watcher ordering, so every watcher is ready.
+func WatcherReady(obj metav1.Object, watcher string) bool {
+	ready, ok := obj.GetAnnotations()[ReadyWatchersAnnotation]
+	if !ok {
+		return true
+	}
+	return slices.Contains(strings.Split(ready, ","), watcher)
+}
+
+// ReadyAtAnnotationPrefix, followed by a watcher name, records on a runtime Org or Project when the watcher
+// became ready for the current create or delete, in RFC3339. Its timeout counts from then.
+const ReadyAtAnnotationPrefix = "tenancy-manager.edge-orchestrator.intel.com/ready-at."
+
+// readySince returns when watcher became ready on obj, and whether it is ready.
+// The time is zero when it was not recorded, as for the watchers ready before the annotation existed.
+func readySince(annotations map[string]string, watcher string) (time.Time, bool) {
+	ready, ok := annotations[ReadyWatchersAnnotation]
+	if ok && !slices.Contains(strings.Split(ready, ","), watcher) {
+		return time.Time{}, false
+	}
+	at, err := time.Parse(time.RFC3339, annotations[ReadyAtAnnotationPrefix+watcher])
+	if err != nil {
+		return time.Time{}, true
+	}
+	return at, true
+}
+
+// clearReadyWatchers removes the watchers ready for the previous operation, and when they became ready,
+// from annotations.
+func clearReadyWatchers(annotations map[string]string) {
+	delete(annotations, ReadyWatchersAnnotation)
+	for key := range annotations {
+		if strings.HasPrefix(key, ReadyAtAnnotationPrefix) {
+			delete(annotations, key)
+		}
+	}
+}
+
+// watcherRegistration is the ordering and failure handling declared by an OrgWatcher or ProjectWatcher.
+type watcherRegistration struct {
+	phase     int32
+	dependsOn []string
+	// createTimeout and deleteTimeout are zero when the watcher uses the configured defaults.
+	createTimeout time.Duration
+	deleteTimeout time.Duration
+	required      bool
+	failurePolicy string
+	retries       int
+}
+
+// watcherPlan holds the registration of every registered watcher, by display name.
+type watcherPlan map[string]watcherRegistration
+
+func getOrgWatcherPlan(client *nexus_client.Clientset) (watcherPlan, error) {
+	cfg, err := client.TenancyMultiTenancy().GetConfig(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("fetching expectedOrgWatchers: failed to get config object with an error: %w", err)
+	}
+
+	orgWatchersIter := cfg.GetAllOrgWatchersIter(context.Background())
+	c := context.Background()
+	plan := watcherPlan{}
+
+	for {
+		watcher, err := orgWatchersIter.Next(c)
+		if err != nil {
+			fmt.Printf("Error retrieving next watcher: %v", err)
+			break
+		}
+		if watcher == nil {
+			break
+		}
+		spec := watcher.Spec
+		plan[watcher.DisplayName()] = watcherRegistration{
+			phase:         spec.Phase,
+			dependsOn:     spec.DependsOn,
+			createTimeout: time.Duration(spec.CreateTimeoutInSecs) * time.Second,
+			deleteTimeout: time.Duration(spec.DeleteTimeoutInSecs) * time.Second,
+			required:      spec.Required == nil || *spec.Required,
+			failurePolicy: string(spec.FailurePolicy),
+			retries:       int(spec.Retries),
+		}
+	}
+	return plan, nil
+}
+
+func getProjectWatcherPlan(client *nexus_client.Clientset) (watcherPlan, error) {
+	cfg, err := client.TenancyMultiTenancy().GetConfig(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("fetching expectedProjectWatchers: failed to get config object with an error: %w", err)
+	}
+
+	projectWatchersIter := cfg.GetAllProjectWatchersIter(context.Background())
+	c := context.Background()
+	plan := watcherPlan{}
+
+	for {
+		watcher, err := projectWatchersIter.Next(c)
+		if err != nil {
+			fmt.Printf("Error retrieving next watcher: %v", err)
+			break
+		}
+		if watcher == nil {
+			break
+		}
+		spec := watcher.Spec
+		plan[watcher.DisplayName()] = watcherRegistration{
+			phase:         spec.Phase,
+			dependsOn:     spec.DependsOn,
+			createTimeout: time.Duration(spec.CreateTimeoutInSecs) * time.Second,
+			deleteTimeout: time.Duration(spec.DeleteTimeoutInSecs) * time.Second,
+			required:      spec.Required == nil || *spec.Required,
+			failurePolicy: string(spec.FailurePolicy),
+			retries:       int(spec.Retries),
+		}
+	}
+	return plan, nil
+}
+
+// watchers returns the names of all registered watchers.
+func (p watcherPlan) watchers() map[string]struct{} {
+	watchers := make(map[string]struct{}, len(p))
+	for name := range p {
+		watchers[name] = struct{}{}
+	}
+	return watchers
+}
+
+// prerequisites returns the watchers that must be IDLE before name is ready on create:
+// every watcher of a lower phase and every registered watcher name depends on.
+// Dependencies on watchers that are not registered are ignored, as watchers can come and go.
+func (p watcherPlan) prerequisites(name string) []string {
+	registration := p[name]
+	var prerequisites []string
+	for other, otherRegistration := range p {
+		if otherRegistration.phase < registration.phase {
+			prerequisites = append(prerequisites, other)
+		}
+	}
+	for _, dep := range registration.dependsOn {
+		if _, ok := p[dep]; ok && dep != name && !slices.Contains(prerequisites, dep) {
+			prerequisites = append(prerequisites, dep)
+		}
+	}
+	return prerequisites
+}
+
+// validate returns an error if the watchers depend on each other in a cycle, as none of them would ever be ready.
+func (p watcherPlan) validate() error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(p))
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("watchers depend on each other in a cycle: %s", strings.Join(append(path, name), " -> "))
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		path = append(slices.Clone(path), name)
+		for _, prerequisite := range p.prerequisites(name) {
+			if err := visit(prerequisite, path); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+
+	names := getMapKeys(p.watchers())
+	slices.Sort(names)
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ready returns, in name order, the watchers that may act on event.
+// On create a watcher is ready once all its prerequisites are IDLE or given up on. On delete it is ready once
+// no watcher that still has an active watcher has it as a prerequisite, so deletion runs in reverse order.
+func (p watcherPlan) ready(event Event, states watcherStates, givenUp map[string]struct{}) []string {
+	ready := []string{}
+	for name := range p {
+		isReady := true
+		if event == Delete {
+			for other := range states {
+				if _, registered := p[other]; !registered || other == name {
+					continue
+				}
+				if _, ok := givenUp[other]; ok {
+					continue
+				}
+				if slices.Contains(p.prerequisites(other), name) {
+					isReady = false
+					break
+				}
+			}
+		} else {
+			for _, prerequisite := range p.prerequisites(name) {
+				_, skipped := givenUp[prerequisite]
+				if !states.idle(prerequisite) && !skipped {
+					isReady = false
+					break
+				}
+			}
+		}
+		if isReady {
+			ready = append(ready, name)
+		}
+	}
+	slices.Sort(ready)
+	return ready
+}
+
+// setReadyWatchers adds ready to the watchers recorded on obj, records now as the time the new ones became ready,
+// and reports whether the annotation changed.
+// A watcher stays ready for the rest of the operation, even if one of its prerequisites goes back to IN_PROGRESS.
+func setReadyWatchers(obj metav1.Object, ready []string, now time.Time) bool {
+	annotations := obj.GetAnnotations()
+	current, ok := annotations[ReadyWatchersAnnotation]
+	var merged []string
+	if current != "" {
+		merged = strings.Split(current, ",")
+	}
+	var added []string
+	for _, name := range ready {
+		if !slices.Contains(merged, name) {
+			merged = append(merged, name)
+			added = append(added, name)
+		}
+	}
+	slices.Sort(merged)
+	value := strings.Join(merged, ",")
+	if ok && current == value {
+		return false
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ReadyWatchersAnnotation] = value
+	for _, name := range added {
+		annotations[ReadyAtAnnotationPrefix+name] = now.UTC().Format(time.RFC3339)
+	}
+	obj.SetAnnotations(annotations)
+	return true
+}
+
+// watcherState is what a watcher reported in its active watcher.
+type watcherState struct {
+	status    string
+	message   string
+	timeStamp uint64
+}
+
+// watcherStates holds the state of every watcher with an active watcher, by display name.
+type watcherStates map[string]watcherState
+
+func (s watcherStates) idle(name string) bool {
+	state, ok := s[name]
+	return ok && state.status == string(orgactivewatcherv1.StatusIndicationIdle)
+}
+
+func orgWatcherStates(ctx context.Context, runtimeOrg *nexus_client.RuntimeorgRuntimeOrg) watcherStates {
+	states := watcherStates{}
+	activeWatchersIter := runtimeOrg.GetAllActiveWatchersIter(ctx)
+	for {
+		watcher, err := activeWatchersIter.Next(ctx)
+		if err != nil {
+			log.InfraErr(err).Msgf("Error retrieving next watcher for runtimeOrg %s", runtimeOrg.DisplayName())
+			break
+		}
+		if watcher == nil {
+			break
+		}
+		states[watcher.DisplayName()] = watcherState{
+			status:    string(watcher.Spec.StatusIndicator),
+			message:   watcher.Spec.Message,
+			timeStamp: watcher.Spec.TimeStamp,
+		}
+	}
+	return states
+}
+
+func projectWatcherStates(ctx context.Context, runtimeProject *nexus_client.RuntimeprojectRuntimeProject,
+) watcherStates {
+	states := watcherStates{}
+	activeWatchersIter := runtimeProject.GetAllActiveWatchersIter(ctx)
+	for {
+		watcher, err := activeWatchersIter.Next(ctx)
+		if err != nil {
+			log.InfraErr(err).Msgf("Error retrieving next watcher for runtimeProject %s", runtimeProject.DisplayName())
+			break
+		}
+		if watcher == nil {
+			break
+		}
+		states[watcher.DisplayName()] = watcherState{
+			status:    string(watcher.Spec.StatusIndicator),
+			message:   watcher.Spec.Message,
+			timeStamp: watcher.Spec.TimeStamp,
+		}
+	}
+	return states
+}
+
+// markReadyOrgWatchers sets the watchers that may act on event on runtimeOrg, without writing it,
+// and reports whether the annotation changed. Watchers in givenUp no longer hold the others back.
+func markReadyOrgWatchers(ctx context.Context, client *nexus_client.Clientset,
+	runtimeOrg *nexus_client.RuntimeorgRuntimeOrg, event Event, givenUp map[string]struct{},
+) (bool, error) {
+	plan, err := getOrgWatcherPlan(client)
+	if err != nil {
+		return false, err
+	}
+	if err := plan.validate(); err != nil {
+		return false, err
+	}
+	return setReadyWatchers(runtimeOrg, plan.ready(event, orgWatcherStates(ctx, runtimeOrg), givenUp), time.Now()), nil
+}
+
+// markReadyProjectWatchers sets the watchers that may act on event on runtimeProject, without writing it,
+// and reports whether the annotation changed. Watchers in givenUp no longer hold the others back.
+func markReadyProjectWatchers(ctx context.Context, client *nexus_client.Clientset,
+	runtimeProject *nexus_client.RuntimeprojectRuntimeProject, event Event, givenUp map[string]struct{},
+) (bool, error) {
+	plan, err := getProjectWatcherPlan(client)
+	if err != nil {
+		return false, err
+	}
+	if err := plan.validate(); err != nil {
+		return false, err
+	}
+	return setReadyWatchers(runtimeProject,
+		plan.ready(event, projectWatcherStates(ctx, runtimeProject), givenUp), time.Now()), nil
+}
+
+// signalOrgWatchers updates the watchers that may act on event on runtimeOrg.
+func signalOrgWatchers(ctx context.Context, client *nexus_client.Clientset,
+	runtimeOrg *nexus_client.RuntimeorgRuntimeOrg, event Event, givenUp map[string]struct{},
+) error {
+	changed, err := markReadyOrgWatchers(ctx, client, runtimeOrg, event, givenUp)
+	if err != nil || !changed {
+		return err
+	}
+	log.Debug().Msgf("Watchers ready for %v of runtime org %s: %s", event, runtimeOrg.DisplayName(),
+		runtimeOrg.GetAnnotations()[ReadyWatchersAnnotation])
+	return runtimeOrg.Update(ctx)
+}
+
+// signalProjectWatchers updates the watchers that may act on event on runtimeProject.
+func signalProjectWatchers(ctx context.Context, client *nexus_client.Clientset,
+	runtimeProject *nexus_client.RuntimeprojectRuntimeProject, event Event, givenUp map[string]struct{},
+) error {
+	changed, err := markReadyProjectWatchers(ctx, client, runtimeProject, event, givenUp)
+	if err != nil || !changed {
+		return err
+	}
+	log.Debug().Msgf("Watchers ready for %v of runtime project %s: %s", event, runtimeProject.DisplayName(),
+		runtimeProject.GetAnnotations()[ReadyWatchersAnnotation])
+	return runtimeProject.Update(ctx)
+}
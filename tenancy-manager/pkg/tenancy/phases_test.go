@@ -0,0 +1,119 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package tenancy_test
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = ginkgo.Describe("Watcher ordering", func() {
+	orders := map[string]tenancy.WatcherOrder{
+		"keycloak-tenant-controller": {},
+		"cluster-orchestrator":       {},
+		"app-orchestrator":           {DependsOn: []string{"keycloak-tenant-controller"}},
+		"observability":              {Phase: 1},
+	}
+
+	ginkgo.When("an org is created", func() {
+		ginkgo.It("should signal only the watchers without pending prerequisites", func() {
+			ready, err := tenancy.ReadyWatchers(orders, tenancy.Create, nil, nil)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ready).To(gomega.Equal([]string{"cluster-orchestrator", "keycloak-tenant-controller"}))
+		})
+
+		ginkgo.It("should signal the dependents once their dependencies are IDLE", func() {
+			ready, err := tenancy.ReadyWatchers(orders, tenancy.Create,
+				[]string{"keycloak-tenant-controller", "cluster-orchestrator"}, []string{"keycloak-tenant-controller"})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ready).To(gomega.Equal([]string{
+				"app-orchestrator", "cluster-orchestrator", "keycloak-tenant-controller",
+			}))
+		})
+
+		ginkgo.It("should signal the next phase once the previous phase is IDLE", func() {
+			idle := []string{"app-orchestrator", "cluster-orchestrator", "keycloak-tenant-controller"}
+			ready, err := tenancy.ReadyWatchers(orders, tenancy.Create, idle, idle)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ready).To(gomega.ContainElement("observability"))
+		})
+
+		ginkgo.It("should ignore dependencies on watchers that are not registered", func() {
+			ready, err := tenancy.ReadyWatchers(map[string]tenancy.WatcherOrder{
+				"app-orchestrator": {DependsOn: []string{"unregistered"}},
+			}, tenancy.Create, nil, nil)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ready).To(gomega.Equal([]string{"app-orchestrator"}))
+		})
+	})
+
+	ginkgo.When("an org is deleted", func() {
+		ginkgo.It("should release the watchers in reverse order", func() {
+			active := []string{"app-orchestrator", "cluster-orchestrator", "keycloak-tenant-controller", "observability"}
+			ready, err := tenancy.ReadyWatchers(orders, tenancy.Delete, active, active)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ready).To(gomega.Equal([]string{"observability"}))
+
+			active = []string{"app-orchestrator", "cluster-orchestrator", "keycloak-tenant-controller"}
+			ready, err = tenancy.ReadyWatchers(orders, tenancy.Delete, active, active)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ready).To(gomega.Equal([]string{"app-orchestrator", "cluster-orchestrator", "observability"}))
+
+			active = []string{"cluster-orchestrator", "keycloak-tenant-controller"}
+			ready, err = tenancy.ReadyWatchers(orders, tenancy.Delete, active, active)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ready).To(gomega.ContainElement("keycloak-tenant-controller"))
+		})
+	})
+
+	ginkgo.When("the watchers depend on each other", func() {
+		ginkgo.It("should report the cycle", func() {
+			_, err := tenancy.ReadyWatchers(map[string]tenancy.WatcherOrder{
+				"a": {DependsOn: []string{"b"}},
+				"b": {DependsOn: []string{"a"}},
+			}, tenancy.Create, nil, nil)
+			gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("a -> b -> a")))
+		})
+
+		ginkgo.It("should report a dependency on a later phase", func() {
+			_, err := tenancy.ReadyWatchers(map[string]tenancy.WatcherOrder{
+				"a": {DependsOn: []string{"b"}},
+				"b": {Phase: 1},
+			}, tenancy.Create, nil, nil)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+		})
+	})
+
+	ginkgo.When("a watcher checks a runtime object", func() {
+		ginkgo.It("should be ready only if listed, or if the object is not ordered", func() {
+			obj := &metav1.ObjectMeta{}
+			gomega.Expect(tenancy.WatcherReady(obj, "app-orchestrator")).To(gomega.BeTrue())
+
+			obj.SetAnnotations(map[string]string{tenancy.ReadyWatchersAnnotation: ""})
+			gomega.Expect(tenancy.WatcherReady(obj, "app-orchestrator")).To(gomega.BeFalse())
+
+			obj.SetAnnotations(map[string]string{
+				tenancy.ReadyWatchersAnnotation: "cluster-orchestrator,keycloak-tenant-controller",
+			})
+			gomega.Expect(tenancy.WatcherReady(obj, "app-orchestrator")).To(gomega.BeFalse())
+			gomega.Expect(tenancy.WatcherReady(obj, "keycloak-tenant-controller")).To(gomega.BeTrue())
+		})
+	})
+})
@@ -0,0 +1,204 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	orgactivewatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgactivewatcher.edge-orchestrator.intel.com/v1"
+	orgwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgwatcher.edge-orchestrator.intel.com/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RetryAnnotationPrefix, followed by a watcher name, counts on a runtime Org or Project how many times
+// the watcher was asked to process the current create or delete again, and when: "<count>,<RFC3339 time>".
+// Each retry updates the runtime object, which notifies the watcher.
+const RetryAnnotationPrefix = "tenancy-manager.edge-orchestrator.intel.com/retry."
+
+// WatcherRetries returns how many times watcher was asked to process the current operation on obj again.
+func WatcherRetries(obj metav1.Object, watcher string) int {
+	count, _ := parseRetry(obj.GetAnnotations()[RetryAnnotationPrefix+watcher])
+	return count
+}
+
+func parseRetry(value string) (int, time.Time) {
+	countStr, atStr, found := strings.Cut(value, ",")
+	if !found {
+		return 0, time.Time{}
+	}
+	count, err := strconv.Atoi(countStr)
+	if err != nil {
+		return 0, time.Time{}
+	}
+	at, err := time.Parse(time.RFC3339, atStr)
+	if err != nil {
+		return 0, time.Time{}
+	}
+	return count, at
+}
+
+// requestRetry records one more retry of watcher on obj, without writing it.
+func requestRetry(obj metav1.Object, watcher string, now time.Time) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	count, _ := parseRetry(annotations[RetryAnnotationPrefix+watcher])
+	annotations[RetryAnnotationPrefix+watcher] = fmt.Sprintf("%d,%s", count+1, now.UTC().Format(time.RFC3339))
+	obj.SetAnnotations(annotations)
+}
+
+// clearRetries removes the retries of the previous operation from annotations.
+func clearRetries(annotations map[string]string) {
+	for key := range annotations {
+		if strings.HasPrefix(key, RetryAnnotationPrefix) {
+			delete(annotations, key)
+		}
+	}
+}
+
+// watcherVerdict is the outcome of a create or delete across the registered watchers.
+type watcherVerdict struct {
+	// pending watchers are still within their timeout.
+	pending []string
+	// retry watchers failed and are to be notified again.
+	retry []string
+	// failures and warnings describe the watchers that failed, depending on whether they are required.
+	failures []string
+	warnings []string
+	// givenUp holds the watchers that failed with a warning, so they do not hold the others back.
+	givenUp map[string]struct{}
+	// timedOut holds the watchers that failed because their timeout expired.
+	timedOut []string
+	// next is the time until the earliest deadline of the pending watchers.
+	next time.Duration
+}
+
+// done reports whether no watcher is left to wait for.
+func (v watcherVerdict) done() bool {
+	return len(v.pending) == 0 && len(v.retry) == 0
+}
+
+// timeout returns the time the watcher has to acknowledge event, or defaultTimeout if it did not set one.
+func (w watcherRegistration) timeout(event Event, defaultTimeout time.Duration) time.Duration {
+	timeout := w.createTimeout
+	if event == Delete {
+		timeout = w.deleteTimeout
+	}
+	if timeout <= 0 {
+		return defaultTimeout
+	}
+	return timeout
+}
+
+/*
+evaluate applies the timeouts and failure policies of the watchers to their states. On create a watcher is done once
+it is IDLE, on delete once its active watcher is gone. It fails when it reports an error, or when it is not done
+within its timeout counted from started, or from its last retry. An error reported before the last retry is stale.
+*/
+func (p watcherPlan) evaluate(event Event, states watcherStates, annotations map[string]string,
+	started time.Time, defaultTimeout time.Duration, now time.Time,
+) watcherVerdict {
+	verdict := watcherVerdict{givenUp: map[string]struct{}{}}
+	names := getMapKeys(p.watchers())
+	slices.Sort(names)
+	for _, name := range names {
+		registration := p[name]
+		state, active := states[name]
+		if event == Create && states.idle(name) || event == Delete && !active {
+			continue
+		}
+
+		// The timeout of a watcher counts from when its phase is ready, so a slow earlier phase does not use it up.
+		// A watcher that is not ready yet waits for its prerequisites, which have their own deadlines.
+		retries, retriedAt := parseRetry(annotations[RetryAnnotationPrefix+name])
+		readyAt, ready := readySince(annotations, name)
+		start := started
+		for _, at := range []time.Time{readyAt, retriedAt} {
+			if at.After(start) {
+				start = at
+			}
+		}
+		timeout := registration.timeout(event, defaultTimeout)
+		deadline := start.Add(timeout)
+
+		var reason string
+		switch {
+		case active && state.status == string(orgactivewatcherv1.StatusIndicationError) &&
+			int64(state.timeStamp) >= start.Unix(): //nolint:gosec // Unix timestamps fit in int64.
+			reason = fmt.Sprintf("reported an error: %s", state.message)
+		case !ready:
+		case !now.Before(deadline) && event == Create:
+			reason = fmt.Sprintf("did not acknowledge within %v", timeout)
+			verdict.timedOut = append(verdict.timedOut, name)
+		case !now.Before(deadline):
+			reason = fmt.Sprintf("did not remove its active watcher within %v", timeout)
+			verdict.timedOut = append(verdict.timedOut, name)
+		}
+		if reason == "" {
+			verdict.pending = append(verdict.pending, name)
+			if ready && (verdict.next == 0 || deadline.Sub(now) < verdict.next) {
+				verdict.next = deadline.Sub(now)
+			}
+			continue
+		}
+
+		if registration.failurePolicy == string(orgwatcherv1.FailurePolicyRetry) && retries < registration.retries {
+			verdict.retry = append(verdict.retry, name)
+			continue
+		}
+		if retries > 0 {
+			reason = fmt.Sprintf("%s after %d retries", reason, retries)
+		}
+		reason = fmt.Sprintf("watcher %s %s", name, reason)
+		if registration.required && registration.failurePolicy != string(orgwatcherv1.FailurePolicyIgnore) {
+			verdict.failures = append(verdict.failures, reason)
+		} else {
+			verdict.warnings = append(verdict.warnings, reason)
+			verdict.givenUp[name] = struct{}{}
+		}
+	}
+	return verdict
+}
+
+// unfinished returns the watchers that did not complete event: on create those that are not IDLE,
+// on delete those whose active watcher is still there.
+func (p watcherPlan) unfinished(event Event, states watcherStates) []string {
+	var names []string
+	for name := range p {
+		_, active := states[name]
+		if event == Create && !states.idle(name) || event == Delete && active {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// withWarnings appends the warnings of the watchers to a status message.
+func withWarnings(msg string, warnings []string) string {
+	if len(warnings) == 0 {
+		return msg
+	}
+	return fmt.Sprintf("%s, with warnings: %s", msg, strings.Join(warnings, "; "))
+}
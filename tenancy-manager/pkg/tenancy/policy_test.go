@@ -0,0 +1,213 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package tenancy_test
+
+import (
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	orgactivewatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgactivewatcher.edge-orchestrator.intel.com/v1"
+	orgwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgwatcher.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = ginkgo.Describe("Watcher failure policies", func() {
+	started := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	idle := tenancy.WatcherReport{Status: string(orgactivewatcherv1.StatusIndicationIdle)}
+	failed := tenancy.WatcherReport{
+		Status:    string(orgactivewatcherv1.StatusIndicationError),
+		Message:   "quota exceeded",
+		TimeStamp: started.Add(time.Second),
+	}
+
+	ginkgo.It("should wait for a watcher within its own timeout", func() {
+		verdict := tenancy.Evaluate(map[string]tenancy.WatcherPolicy{
+			"slow": {Timeout: 10 * time.Minute},
+		}, tenancy.Create, nil, &metav1.ObjectMeta{}, started, started.Add(5*time.Minute))
+		gomega.Expect(verdict.Done).To(gomega.BeFalse())
+		gomega.Expect(verdict.Pending).To(gomega.Equal([]string{"slow"}))
+	})
+
+	ginkgo.It("should fail when a required watcher times out", func() {
+		verdict := tenancy.Evaluate(map[string]tenancy.WatcherPolicy{
+			"fast": {Timeout: time.Minute},
+			"done": {},
+		}, tenancy.Create, map[string]tenancy.WatcherReport{"done": idle},
+			&metav1.ObjectMeta{}, started, started.Add(2*time.Minute))
+		gomega.Expect(verdict.Failures).To(gomega.ConsistOf(gomega.ContainSubstring("watcher fast did not acknowledge")))
+	})
+
+	ginkgo.It("should only warn when an optional or ignored watcher fails", func() {
+		verdict := tenancy.Evaluate(map[string]tenancy.WatcherPolicy{
+			"optional": {Optional: true},
+			"ignored":  {FailurePolicy: string(orgwatcherv1.FailurePolicyIgnore)},
+		}, tenancy.Create, map[string]tenancy.WatcherReport{"optional": failed},
+			&metav1.ObjectMeta{}, started, started.Add(2*time.Minute))
+		gomega.Expect(verdict.Done).To(gomega.BeTrue())
+		gomega.Expect(verdict.Failures).To(gomega.BeEmpty())
+		gomega.Expect(verdict.Warnings).To(gomega.ConsistOf(
+			gomega.ContainSubstring("watcher ignored did not acknowledge"),
+			gomega.ContainSubstring("watcher optional reported an error: quota exceeded"),
+		))
+	})
+
+	ginkgo.It("should retry a watcher up to its retries", func() {
+		policies := map[string]tenancy.WatcherPolicy{
+			"flaky": {FailurePolicy: string(orgwatcherv1.FailurePolicyRetry), Retries: 1},
+		}
+		reports := map[string]tenancy.WatcherReport{"flaky": failed}
+		obj := &metav1.ObjectMeta{}
+
+		verdict := tenancy.Evaluate(policies, tenancy.Create, reports, obj, started, started.Add(10*time.Second))
+		gomega.Expect(verdict.Retry).To(gomega.Equal([]string{"flaky"}))
+
+		retriedAt := started.Add(10 * time.Second)
+		tenancy.RequestRetry(obj, "flaky", retriedAt)
+		gomega.Expect(tenancy.WatcherRetries(obj, "flaky")).To(gomega.Equal(1))
+
+		// The error reported before the retry is stale.
+		verdict = tenancy.Evaluate(policies, tenancy.Create, reports, obj, started, retriedAt.Add(time.Second))
+		gomega.Expect(verdict.Pending).To(gomega.Equal([]string{"flaky"}))
+
+		reports["flaky"] = tenancy.WatcherReport{
+			Status:    failed.Status,
+			Message:   failed.Message,
+			TimeStamp: retriedAt.Add(time.Second),
+		}
+		verdict = tenancy.Evaluate(policies, tenancy.Create, reports, obj, started, retriedAt.Add(2*time.Second))
+		gomega.Expect(verdict.Retry).To(gomega.BeEmpty())
+		gomega.Expect(verdict.Failures).To(gomega.ConsistOf(gomega.ContainSubstring("after 1 retries")))
+	})
+
+	ginkgo.It("should give up on a delete only after the timeout", func() {
+		policies := map[string]tenancy.WatcherPolicy{"gone": {}, "stuck": {Optional: true}}
+		reports := map[string]tenancy.WatcherReport{"stuck": idle}
+
+		verdict := tenancy.Evaluate(policies, tenancy.Delete, reports, &metav1.ObjectMeta{}, started,
+			started.Add(30*time.Second))
+		gomega.Expect(verdict.Pending).To(gomega.Equal([]string{"stuck"}))
+
+		verdict = tenancy.Evaluate(policies, tenancy.Delete, reports, &metav1.ObjectMeta{}, started,
+			started.Add(2*time.Minute))
+		gomega.Expect(verdict.Done).To(gomega.BeTrue())
+		gomega.Expect(verdict.Warnings).To(gomega.ConsistOf(gomega.ContainSubstring("did not remove its active watcher")))
+	})
+
+	ginkgo.It("should count the timeout of a watcher from when its phase is ready", func() {
+		policies := map[string]tenancy.WatcherPolicy{
+			"first":  {Timeout: 10 * time.Minute},
+			"second": {Timeout: time.Minute},
+		}
+		obj := &metav1.ObjectMeta{}
+		tenancy.MarkReady(obj, []string{"first"}, started)
+
+		// The first phase takes longer than the timeout of the second one.
+		verdict := tenancy.Evaluate(policies, tenancy.Create, nil, obj, started, started.Add(5*time.Minute))
+		gomega.Expect(verdict.Failures).To(gomega.BeEmpty())
+		gomega.Expect(verdict.Pending).To(gomega.Equal([]string{"first", "second"}))
+
+		readyAt := started.Add(5 * time.Minute)
+		tenancy.MarkReady(obj, []string{"second"}, readyAt)
+		reports := map[string]tenancy.WatcherReport{"first": idle}
+		verdict = tenancy.Evaluate(policies, tenancy.Create, reports, obj, started, readyAt.Add(30*time.Second))
+		gomega.Expect(verdict.Failures).To(gomega.BeEmpty())
+		gomega.Expect(verdict.Pending).To(gomega.Equal([]string{"second"}))
+
+		verdict = tenancy.Evaluate(policies, tenancy.Create, reports, obj, started, readyAt.Add(time.Minute))
+		gomega.Expect(verdict.Failures).To(gomega.ConsistOf(gomega.ContainSubstring("watcher second did not acknowledge")))
+	})
+})
+
+var _ = ginkgo.Describe("Operator requests", func() {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ginkgo.It("should retry only the watchers that did not complete the operation", func() {
+		registered := []string{"a", "b", "c"}
+		gomega.Expect(tenancy.UnfinishedWatchers(registered, tenancy.Create, []string{"b"}, []string{"a"})).
+			To(gomega.Equal([]string{"b", "c"}))
+		gomega.Expect(tenancy.UnfinishedWatchers(registered, tenancy.Delete, []string{"b"}, []string{"a"})).
+			To(gomega.Equal([]string{"a", "b"}))
+	})
+
+	ginkgo.It("should restart the wait and notify the failed watchers", func() {
+		obj := &metav1.ObjectMeta{}
+		tenancy.RequestRetry(obj, "b", now.Add(-time.Hour))
+		tenancy.RestartWatchers(obj, tenancy.Create, []string{"b", "c"}, now)
+
+		gomega.Expect(tenancy.WatcherRetries(obj, "a")).To(gomega.Equal(0))
+		gomega.Expect(tenancy.WatcherRetries(obj, "b")).To(gomega.Equal(2))
+		gomega.Expect(tenancy.WatcherRetries(obj, "c")).To(gomega.Equal(1))
+		gomega.Expect(obj.Annotations).To(gomega.HaveKeyWithValue(
+			"tenancy-manager.edge-orchestrator.intel.com/create-started-at", now.Format(time.RFC3339)))
+		gomega.Expect(obj.Annotations).To(gomega.HaveKey(tenancy.ReadyWatchersAnnotation))
+	})
+
+	ginkgo.It("should act on a retry request once", func() {
+		old := &metav1.ObjectMeta{}
+		updated := &metav1.ObjectMeta{Annotations: map[string]string{
+			tenancy.RetryRequestedAnnotation: now.Format(time.RFC3339),
+		}}
+		gomega.Expect(tenancy.RetryRequested(old, updated)).To(gomega.BeTrue())
+		gomega.Expect(tenancy.RetryRequested(updated, updated)).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("should force-delete only a deleted object confirmed by name", func() {
+		obj := &metav1.ObjectMeta{Annotations: map[string]string{tenancy.ForceDeleteAnnotation: "acme"}}
+		gomega.Expect(tenancy.ForceDeleteConfirmed(obj, "acme")).To(gomega.BeFalse())
+
+		obj.DeletionTimestamp = &metav1.Time{Time: now}
+		gomega.Expect(tenancy.ForceDeleteConfirmed(obj, "other")).To(gomega.BeFalse())
+		gomega.Expect(tenancy.ForceDeleteConfirmed(obj, "acme")).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("should act on a move request once, unless the object is deleted", func() {
+		old := &metav1.ObjectMeta{}
+		updated := &metav1.ObjectMeta{Annotations: map[string]string{tenancy.MoveRequestedAnnotation: "team-a"}}
+		gomega.Expect(tenancy.MoveRequested(old, updated)).To(gomega.BeTrue())
+		gomega.Expect(tenancy.MoveRequested(updated, updated)).To(gomega.BeFalse())
+
+		updated.DeletionTimestamp = &metav1.Time{Time: now}
+		gomega.Expect(tenancy.MoveRequested(old, updated)).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("should keep a moved project in the runtime folder it was created in", func() {
+		project := &metav1.ObjectMeta{Labels: map[string]string{"folders.folder.edge-orchestrator.intel.com": "team-a"}}
+		gomega.Expect(tenancy.ProjectFolder(project)).To(gomega.Equal("team-a"))
+
+		project.Labels["folders.folder.edge-orchestrator.intel.com"] = "team-b"
+		project.Annotations = map[string]string{tenancy.RuntimeFolderAnnotation: "team-a"}
+		gomega.Expect(tenancy.ProjectFolder(project)).To(gomega.Equal("team-a"))
+	})
+
+	ginkgo.It("should lock a project on its runtime folder, in its tasks and its acknowledgements", func() {
+		project := &projectv1.Project{ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"orgs.org.edge-orchestrator.intel.com":       "acme",
+				"folders.folder.edge-orchestrator.intel.com": "team-b",
+				"nexus/display_name":                         "web",
+			},
+			Annotations: map[string]string{tenancy.RuntimeFolderAnnotation: "team-a"},
+		}}
+		taskKey, ackKey := tenancy.ProjectLockKeys(project)
+		gomega.Expect(taskKey).To(gomega.Equal("project/acme/team-a/web"))
+		gomega.Expect(ackKey).To(gomega.Equal(taskKey))
+	})
+})
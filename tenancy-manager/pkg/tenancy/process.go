@@ -21,7 +21,7 @@ package tenancy
 import (
 	"context"
 	"fmt"
-	"sync"
+	"sync/atomic"
 	"time"
 
 	foldersv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/folder.edge-orchestrator.intel.com/v1"
@@ -35,85 +35,88 @@ import (
 	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
 	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/config"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 )
 
-var (
-	Testing bool
-
-	orgMutex     sync.Mutex
-	projectMutex sync.Mutex
-)
+var Testing bool
 
 // Reconciler handles the reconciliation logic for the tenancy-datamodel API.
 type Reconciler struct {
 	Client *nexus_client.Clientset
 	Config *config.Config
+
+	// Events are processed from one queue for Orgs and one for Projects,
+	// serialized per object through locks and retried with backoff on transient errors.
+	locks    *keyedMutex
+	orgs     *keyedQueue
+	projects *keyedQueue
+	acks     *ackTracker
+
+	// Recorder emits Kubernetes Events on the config Orgs and Projects, if set.
+	Recorder record.EventRecorder
+	metrics  *lifecycleMetrics
+	// standby is set while another replica leads.
+	standby atomic.Bool
 }
 
 // NewReconciler creates a new instance of Reconciler to manage the tenancy-datamodel API reconciliation.
 func NewReconciler(client *nexus_client.Clientset, cfg *config.Config) *Reconciler {
-	return &Reconciler{
-		Client: client,
-		Config: cfg,
-	}
+	if cfg == nil {
+		cfg = config.GetDefaultConfig()
+	}
+	cfg.ApplyDefaults()
+
+	baseDelay := time.Duration(cfg.RetryBaseDelayInMsecs) * time.Millisecond
+	maxDelay := time.Duration(cfg.RetryMaxDelayInSecs) * time.Second
+	locks := newKeyedMutex()
+	r := &Reconciler{
+		Client:  client,
+		Config:  cfg,
+		locks:   locks,
+		acks:    newAckTracker(),
+		metrics: newLifecycleMetrics(),
+	}
+	r.orgs = newKeyedQueue("tenancy-orgs", locks, &r.standby, baseDelay, maxDelay, int(cfg.MaxRetries))
+	r.projects = newKeyedQueue("tenancy-projects", locks, &r.standby, baseDelay, maxDelay, int(cfg.MaxRetries))
+	return r
 }
 
 // GetExpectedOrgWatchers gets the list of Org watchers that need to be notified.
 func GetExpectedOrgWatchers(client *nexus_client.Clientset) (map[string]struct{}, error) {
-	cfg, err := client.TenancyMultiTenancy().GetConfig(context.Background())
+	plan, err := getOrgWatcherPlan(client)
 	if err != nil {
-		return nil, fmt.Errorf("fetching expectedOrgWatchers: failed to get config object with an error: %w", err)
-	}
-
-	// Build a map of watchers that need to be notified.
-	orgWatchersIter := cfg.GetAllOrgWatchersIter(context.Background())
-	c := context.Background()
-	expectedWatchers := make(map[string]struct{})
-
-	for {
-		watcher, err := orgWatchersIter.Next(c)
-		if err != nil {
-			fmt.Printf("Error retrieving next watcher: %v", err)
-			break
-		}
-		if watcher == nil {
-			break
-		}
-		expectedWatchers[watcher.DisplayName()] = struct{}{}
+		return nil, err
 	}
-	return expectedWatchers, nil
+	return plan.watchers(), nil
 }
 
 // GetExpectedProjectWatchers gets the list of Project watchers that need to be notified.
 func GetExpectedProjectWatchers(client *nexus_client.Clientset) (map[string]struct{}, error) {
-	cfg, err := client.TenancyMultiTenancy().GetConfig(context.Background())
+	plan, err := getProjectWatcherPlan(client)
 	if err != nil {
-		return nil, fmt.Errorf("fetching expectedProjectWatchers: failed to get config object with an error: %w", err)
-	}
-
-	// Build a map of watchers that need to be notified.
-	projectWatchersIter := cfg.GetAllProjectWatchersIter(context.Background())
-	c := context.Background()
-	expectedWatchers := make(map[string]struct{})
-
-	for {
-		watcher, err := projectWatchersIter.Next(c)
-		if err != nil {
-			fmt.Printf("Error retrieving next watcher: %v", err)
-			break
-		}
-		if watcher == nil {
-			break
-		}
-		expectedWatchers[watcher.DisplayName()] = struct{}{}
+		return nil, err
 	}
-	return expectedWatchers, nil
+	return plan.watchers(), nil
 }
 
 // ProcessOrgsAdd is the callback function to be invoked when Org is added.
 func (r *Reconciler) ProcessOrgsAdd(org *nexus_client.OrgOrg) {
 	log.Debug().Msgf("Org %s (hashName: %s) created", org.DisplayName(), org.Name)
 
+	r.orgs.enqueue(workKey{event: eventOrgAdd, hashName: org.Name}, task{
+		lock: orgLockKey(org.DisplayName()),
+		run:  r.withCurrentOrg(org, r.processOrgsAdd),
+		fail: func(err error) {
+			if err := r.setOrgStatus(org.DisplayName(), org.Name, orgsv1.StatusIndicationError,
+				fmt.Sprintf("Org creation failed with an error: %v", err), Create); err != nil {
+				log.InfraErr(err).Msgf("Unable to set the status of org %s", org.DisplayName())
+			}
+		},
+	})
+}
+
+// processOrgsAdd creates the runtime Org. It returns an error only for transient failures that should be retried.
+func (r *Reconciler) processOrgsAdd(org *nexus_client.OrgOrg) error {
 	/* The 'Testing' boolean is a temporary workaround to bypass the check below in unit tests.
 	In unit tests, the object is created with a deletion timestamp.*/
 	if !Testing {
@@ -122,8 +125,7 @@ func (r *Reconciler) ProcessOrgsAdd(org *nexus_client.OrgOrg) {
 		if !org.DeletionTimestamp.IsZero() {
 			log.Debug().Msgf("Org %v (hashName: %s) is marked for delete, processing delete",
 				org.DisplayName(), org.Name)
-			r.ProcessOrgsDelete(org)
-			return
+			return r.processOrgsDelete(org)
 		}
 	}
 
@@ -131,11 +133,9 @@ func (r *Reconciler) ProcessOrgsAdd(org *nexus_client.OrgOrg) {
 		// Org create already processed. Return.
 		log.Debug().Msgf("Processing OrgAdd: Skip org creation of %v (hashName: %s) as it is already created",
 			org.DisplayName(), org.Name)
-		return
+		return nil
 	}
 
-	displayName, hashName := org.DisplayName(), org.Name
-
 	// Create default Folder in the config tree of the datamodel.
 	_, err := org.AddFolders(context.Background(), &foldersv1.Folder{
 		ObjectMeta: metav1.ObjectMeta{
@@ -144,41 +144,57 @@ func (r *Reconciler) ProcessOrgsAdd(org *nexus_client.OrgOrg) {
 		Spec: foldersv1.FolderSpec{},
 	})
 	if err != nil && !nexus_client.IsAlreadyExists(err) {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to add config default Folder: %w", err)
+		}
 		log.InfraErr(err).Msgf(`Creation of org %s (hashName: %s) failed,unable to add config default Folder`,
 			org.DisplayName(), org.Name)
-		setOrgStatus(r.Client, org.DisplayName(),
+		return r.setOrgStatus(org.DisplayName(),
 			org.Name,
 			orgsv1.StatusIndicationError,
 			fmt.Sprintf("Org creation failed: unable to add config default Folder, error: %v", err),
 			Create)
-		return
 	}
 
 	// Create an Org in the Runtime tree of the datamodel.
+	// If it already exists, the recorded start of the create wait is kept.
+	// No watcher is ready until the watcher ordering is signalled below.
 	runtimeOrg, err := r.Client.TenancyMultiTenancy().Runtime().
 		AddOrgs(context.Background(), &runtimeorgsv1.RuntimeOrg{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: org.DisplayName(),
+				Annotations: map[string]string{
+					createStartedAnnotation: time.Now().UTC().Format(time.RFC3339),
+					ReadyWatchersAnnotation: "",
+				},
+			},
+			Spec: runtimeorgsv1.RuntimeOrgSpec{
+				Suspended: org.Spec.Suspended,
+				Labels:    org.Spec.Labels,
+				Owners:    org.Spec.Owners,
 			},
-			Spec: runtimeorgsv1.RuntimeOrgSpec{},
 		})
 	if err != nil && !nexus_client.IsAlreadyExists(err) {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to add runtime Org: %w", err)
+		}
 		log.InfraErr(err).Msgf(`Creation of org %s (hashName: %s) failed, unable to add runtime Org`, org.DisplayName(), org.Name)
-		setOrgStatus(r.Client, org.DisplayName(),
+		return r.setOrgStatus(org.DisplayName(),
 			org.Name,
 			orgsv1.StatusIndicationError,
 			fmt.Sprintf("Org creation failed: unable to add runtime Org, error: %v", err),
 			Create)
-		return
 	}
 
 	if org.Status.OrgStatus.StatusIndicator == "" {
 		// Set the Org status to InProgress and continue creation.
-		setOrgStatus(r.Client, org.DisplayName(), org.Name,
+		if err := r.setOrgStatus(org.DisplayName(), org.Name,
 			orgsv1.StatusIndicationInProgress,
 			fmt.Sprintf("Org %v CREATE initiated", org.DisplayName()),
 			Create,
-		)
+		); err != nil {
+			return err
+		}
 	}
 
 	// Create a default Folder in the Runtime tree of the datamodel.
@@ -189,26 +205,30 @@ func (r *Reconciler) ProcessOrgsAdd(org *nexus_client.OrgOrg) {
 		Spec: runtimefoldersv1.RuntimeFolderSpec{},
 	})
 	if err != nil && !nexus_client.IsAlreadyExists(err) {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to add runtime default Folder: %w", err)
+		}
 		log.InfraErr(err).Msgf(`Creation of org %s (hashName: %s) failed, unable to add runtime default Folder`,
 			org.DisplayName(), org.Name)
-		setOrgStatus(r.Client, org.DisplayName(),
+		return r.setOrgStatus(org.DisplayName(),
 			org.Name,
 			orgsv1.StatusIndicationError,
 			fmt.Sprintf("Org creation failed: unable to add runtime default Folder, error: %v", err),
 			Create)
-		return
 	}
 
 	expectedOrgWatchers, err := GetExpectedOrgWatchers(r.Client)
 	if err != nil {
+		if isRetryable(err) {
+			return err
+		}
 		log.InfraErr(err).Msgf(`Creation of org %s (hashName: %s) failed, unable to fetch expectedOrgWatchers`,
 			org.DisplayName(), org.Name)
-		setOrgStatus(r.Client, org.DisplayName(),
+		return r.setOrgStatus(org.DisplayName(),
 			org.Name,
 			orgsv1.StatusIndicationError,
 			fmt.Sprintf("Org creation failed: unable to fetch expectedOrgWatchers, error: %v", err),
 			Create)
-		return
 	}
 	log.Debug().Msgf("Processing OrgAdd: Expected OrgWatchers: %#v", expectedOrgWatchers)
 
@@ -216,31 +236,59 @@ func (r *Reconciler) ProcessOrgsAdd(org *nexus_client.OrgOrg) {
 	if len(expectedOrgWatchers) == 0 {
 		log.Debug().Msgf("Processing OrgAdd: Creation of org %s (hashName: %s) is successful, marking it as 'IDLE'",
 			org.DisplayName(), org.Name)
-		setOrgStatus(r.Client, org.DisplayName(),
+		if err := r.setOrgStatus(org.DisplayName(),
 			org.Name,
 			orgsv1.StatusIndicationIdle,
 			fmt.Sprintf("Org %v CREATE is complete", org.DisplayName()),
+			Create); err != nil {
+			return err
+		}
+		clearAckStarted(context.Background(), ackRequest{kind: orgKind, event: Create, displayName: org.DisplayName()},
+			runtimeOrg)
+		return nil
+	}
+
+	// Let the watchers of the first phase act on the org.
+	if err := signalOrgWatchers(context.Background(), r.Client, runtimeOrg, Create, nil); err != nil {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to signal ready watchers: %w", err)
+		}
+		log.InfraErr(err).Msgf(`Creation of org %s (hashName: %s) failed, unable to signal ready watchers`,
+			org.DisplayName(), org.Name)
+		return r.setOrgStatus(org.DisplayName(),
+			org.Name,
+			orgsv1.StatusIndicationError,
+			fmt.Sprintf("Org creation failed: unable to signal ready watchers, error: %v", err),
 			Create)
-		return
 	}
 
 	// Otherwise, set it to Inprogress and wait for watchers to acknowledge this org.
 	log.Debug().Msgf("Processing OrgAdd: Waiting for watchers %v to acknowledge the org %s (hashName: %s)",
 		getMapKeys(expectedOrgWatchers), org.DisplayName(), org.Name)
-	setOrgStatus(r.Client, org.DisplayName(), org.Name,
+	if err := r.setOrgStatus(org.DisplayName(), org.Name,
 		orgsv1.StatusIndicationInProgress,
 		fmt.Sprintf("Waiting for watchers %v to acknowledge this org",
 			getMapKeys(expectedOrgWatchers)),
-		Create)
+		Create); err != nil {
+		return err
+	}
 
-	go r.StartOrgCreateAcknowledgementTimer(time.Duration(r.Config.OrgCreateTimeoutInSecs)*time.Second,
-		expectedOrgWatchers, displayName, hashName, runtimeOrg)
+	r.enqueueAck(ackRequest{kind: orgKind, event: Create, displayName: org.DisplayName()})
+	return nil
 }
 
 // ProcessOrgsUpdate is the callback function to be invoked when Org is updated.
 func (r *Reconciler) ProcessOrgsUpdate(old, updated *nexus_client.OrgOrg) {
-	// Skip those events that aren't delete events.
+	// Operator requests take precedence over the delete they may come with.
+	if r.processOrgRequests(old, updated) {
+		return
+	}
+
+	// Skip those events that aren't delete events, once a change of the spec is propagated.
 	if updated.DeletionTimestamp.IsZero() {
+		if orgSpecChanged(old, updated) {
+			r.syncRuntimeOrg(updated.DisplayName())
+		}
 		return
 	}
 
@@ -266,8 +314,20 @@ func (r *Reconciler) ProcessOrgsUpdate(old, updated *nexus_client.OrgOrg) {
 
 // ProcessOrgsDelete is the function invoked when Org is deleted.
 func (r *Reconciler) ProcessOrgsDelete(obj *nexus_client.OrgOrg) {
-	displayName, hashName := obj.DisplayName(), obj.Name
+	r.orgs.enqueue(workKey{event: eventOrgDelete, hashName: obj.Name}, task{
+		lock: orgLockKey(obj.DisplayName()),
+		run:  r.withCurrentOrg(obj, r.processOrgsDelete),
+		fail: func(err error) {
+			if err := r.setOrgStatus(obj.DisplayName(), obj.Name, orgsv1.StatusIndicationError,
+				fmt.Sprintf("Org deletion failed with an error: %v", err), Delete); err != nil {
+				log.InfraErr(err).Msgf("Unable to set the status of org %s", obj.DisplayName())
+			}
+		},
+	})
+}
 
+// processOrgsDelete deletes the runtime Org. It returns an error only for transient failures that should be retried.
+func (r *Reconciler) processOrgsDelete(obj *nexus_client.OrgOrg) error {
 	// Update the runtime org object to deleting.
 	runtimeOrg, err := r.Client.TenancyMultiTenancy().Runtime().
 		GetOrgs(context.Background(), obj.DisplayName())
@@ -276,39 +336,68 @@ func (r *Reconciler) ProcessOrgsDelete(obj *nexus_client.OrgOrg) {
 			// Runtime object does not exist for this Org. Just delete it.
 			err := obj.Delete(context.Background())
 			if err != nil && !nexus_client.IsNotFound(err) {
+				if isRetryable(err) {
+					return fmt.Errorf("unable to delete config Org: %w", err)
+				}
 				log.InfraErr(err).Msgf("Failed to delete runtime Org object %s (hashName: %s)",
 					obj.DisplayName(), obj.Name)
-				return
+				return nil
 			}
 		} else {
+			if isRetryable(err) {
+				return fmt.Errorf("unable to get runtime Org: %w", err)
+			}
 			errMsg := fmt.Sprintf("Org %s (hashName: %s) deletion failed, unable to get runtime Org, error: %v",
 				obj.DisplayName(), obj.Name, err)
-			setOrgStatus(r.Client, obj.DisplayName(), obj.Name,
+			if err := r.setOrgStatus(obj.DisplayName(), obj.Name,
 				orgsv1.StatusIndicationError,
-				errMsg, Delete)
+				errMsg, Delete); err != nil {
+				return err
+			}
 			log.Error().Msg(errMsg)
-			return
+			return nil
 		}
-		return
+		return nil
 	}
 
 	runtimeOrg.Spec.Deleted = true
+	markAckStarted(runtimeOrg, Delete)
+	// Watchers are released in the reverse of the create order, replacing the watchers ready for the create.
+	clearReadyWatchers(runtimeOrg.GetAnnotations())
+	if _, err := markReadyOrgWatchers(context.Background(), r.Client, runtimeOrg, Delete, nil); err != nil {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to get ready watchers: %w", err)
+		}
+		log.InfraErr(err).Msgf("Unable to order the watchers of org %s (hashName: %s), releasing all of them",
+			obj.DisplayName(), obj.Name)
+	}
 	defaultErr := runtimeOrg.Update(context.Background())
 	if defaultErr != nil && !Testing {
-		// SAFETY: 'Testing' bool prevents panic in UTs. In tests, handle errors gracefully without crashing.
-		// In normal runtime, a panic is raised on update failure as it's critical.
-		log.Panic().Msgf("failed update to runtime Org %s (hashName %s), with error: %v",
-			obj.DisplayName(), obj.Name, defaultErr)
+		// SAFETY: 'Testing' bool lets UTs continue the flow when the update fails.
+		if isRetryable(defaultErr) {
+			return fmt.Errorf("unable to mark runtime Org deleted: %w", defaultErr)
+		}
+		errMsg := fmt.Sprintf("Org deletion failed: unable to mark runtime Org deleted, error: %v", defaultErr)
+		if err := r.setOrgStatus(obj.DisplayName(), obj.Name, orgsv1.StatusIndicationError, errMsg, Delete); err != nil {
+			return err
+		}
+		log.InfraErr(defaultErr).Msgf("Failed to update runtime Org %s (hashName %s)", obj.DisplayName(), obj.Name)
+		return nil
 	}
 
 	expectedOrgWatchers, err := GetExpectedOrgWatchers(r.Client)
 	if err != nil {
+		if isRetryable(err) {
+			return err
+		}
 		errMsg := fmt.Sprintf("Org deletion failed: unable to fetch expectedOrgWatchers, error: %v", err)
-		setOrgStatus(r.Client, obj.DisplayName(), obj.Name,
-			orgsv1.StatusIndicationError, errMsg, Delete)
+		if err := r.setOrgStatus(obj.DisplayName(), obj.Name,
+			orgsv1.StatusIndicationError, errMsg, Delete); err != nil {
+			return err
+		}
 		log.InfraErr(err).Msgf("Failed to delete runtime Org object %s (hashName: %s), unable to fetch expectedOrgWatchers",
 			obj.DisplayName(), obj.Name)
-		return
+		return nil
 	}
 	log.Debug().Msgf("Processing OrgDelete: Expected OrgWatchers of Org %s (hashName: %s): %#v",
 		obj.DisplayName(), obj.Name, expectedOrgWatchers)
@@ -345,10 +434,14 @@ func (r *Reconciler) ProcessOrgsDelete(obj *nexus_client.OrgOrg) {
 		obj.SetFinalizers([]string{})
 		err = obj.Update(context.Background())
 		if err != nil && !nexus_client.IsNotFound(err) {
+			if isRetryable(err) {
+				return fmt.Errorf("unable to remove the finalizers of config Org: %w", err)
+			}
 			log.InfraErr(err).Msgf("Failed to remove the finalizers from config Org %s (hashName: %s)",
 				obj.DisplayName(), obj.Name)
 		}
-		return
+		r.orgDeleted(obj)
+		return nil
 	}
 
 	// If there is at least one active watcher, don't mark for deletion.
@@ -357,21 +450,32 @@ func (r *Reconciler) ProcessOrgsDelete(obj *nexus_client.OrgOrg) {
 
 	// Set the Org status to InProgress and continue deletion.
 	msg := fmt.Sprintf("Waiting for watchers %v to be deleted", getMapKeys(currentActiveWatchers))
-	setOrgStatus(r.Client, obj.DisplayName(), obj.Name, orgsv1.StatusIndicationInProgress, msg, Delete)
+	if err := r.setOrgStatus(obj.DisplayName(), obj.Name, orgsv1.StatusIndicationInProgress, msg, Delete); err != nil {
+		return err
+	}
 
-	go r.StartOrgDeleteAcknowledgementTimer(time.Duration(r.Config.OrgCreateTimeoutInSecs)*time.Second,
-		expectedOrgWatchers,
-		displayName, hashName, runtimeOrg)
+	r.enqueueAck(ackRequest{kind: orgKind, event: Delete, displayName: obj.DisplayName()})
+	return nil
 }
 
 // ProcessOrgActiveWatcherAdd is the callback function to be invoked when active watcher has acknowledged an org creation request.
 func (r *Reconciler) ProcessOrgActiveWatcherAdd(w *nexus_client.OrgactivewatcherOrgActiveWatcher) {
 	log.Debug().Msgf("Orgs active watcher %v (hashName: %s) created", w.DisplayName(), w.Name)
 
-	if err := processOrgActiveWatcher(r.Client, w); err != nil {
-		log.InfraErr(err).Msgf("Processing OrgActiveWatcherAdd %s (hashName: %s) failed with an error: %v",
-			w.DisplayName(), w.Name, err)
-	}
+	r.orgs.enqueue(workKey{event: eventOrgActiveWatcherAdd, hashName: w.Name}, task{
+		lock: orgLockKey(w.GetLabels()["runtimeorgs.runtimeorg.edge-orchestrator.intel.com"]),
+		run: func() error {
+			err := r.processOrgActiveWatcher(w)
+			if isRetryable(err) {
+				return err
+			}
+			if err != nil {
+				log.InfraErr(err).Msgf("Processing OrgActiveWatcherAdd %s (hashName: %s) failed with an error: %v",
+					w.DisplayName(), w.Name, err)
+			}
+			return nil
+		},
+	})
 }
 
 // ProcessOrgActiveWatcherUpdate is the callback function to be invoked when active watcher is updated.
@@ -391,46 +495,72 @@ func (r *Reconciler) ProcessOrgActiveWatcherUpdate(old, updated *nexus_client.Or
 		return
 	}
 
-	if err := processOrgActiveWatcher(r.Client, updated); err != nil {
-		log.InfraErr(err).Msgf("Processing OrgActiveWatcherUpdate %s (hashName: %s) failed with an error: %v",
-			updated.DisplayName(), updated.Name, err)
-	}
+	r.orgs.enqueue(workKey{event: eventOrgActiveWatcherUpdate, hashName: updated.Name}, task{
+		lock: orgLockKey(updated.GetLabels()["runtimeorgs.runtimeorg.edge-orchestrator.intel.com"]),
+		run: func() error {
+			err := r.processOrgActiveWatcher(updated)
+			if isRetryable(err) {
+				return err
+			}
+			if err != nil {
+				log.InfraErr(err).Msgf("Processing OrgActiveWatcherUpdate %s (hashName: %s) failed with an error: %v",
+					updated.DisplayName(), updated.Name, err)
+			}
+			return nil
+		},
+	})
 }
 
 // ProcessOrgActiveWatcherDelete is the callback function to be invoked when active watcher has stopped watching an org.
 func (r *Reconciler) ProcessOrgActiveWatcherDelete(w *nexus_client.OrgactivewatcherOrgActiveWatcher) {
 	log.Debug().Msgf("Orgs active watcher %s (hashName: %s) deleted", w.DisplayName(), w.Name)
 
+	r.orgs.enqueue(workKey{event: eventOrgActiveWatcherDelete, hashName: w.Name}, task{
+		lock: orgLockKey(w.GetLabels()["runtimeorgs.runtimeorg.edge-orchestrator.intel.com"]),
+		run:  func() error { return r.processOrgActiveWatcherDelete(w) },
+	})
+}
+
+// processOrgActiveWatcherDelete completes a pending Org delete once its last active watcher is gone.
+func (r *Reconciler) processOrgActiveWatcherDelete(w *nexus_client.OrgactivewatcherOrgActiveWatcher) error {
 	// Get the runtime obj associated with this active org watcher.
 	runtimeOrg, err := w.GetParent(context.Background())
 	if err != nil {
+		if isRetryable(err) {
+			return err
+		}
 		log.InfraErr(err).Msgf("Processing OrgActiveWatcherDelete of %s (hashName: %s): failed to get runtime Org",
 			w.DisplayName(), w.Name)
-		return
+		return nil
 	}
 
 	if !runtimeOrg.Spec.Deleted {
 		// A watcher got removed but the runtime is not marked for deletion. So dont have to react, as watchers can come and go.
-		return
+		return nil
 	}
 
 	configOrg, err := r.Client.TenancyMultiTenancy().Config().
 		GetOrgs(context.Background(), runtimeOrg.DisplayName())
 	if err != nil {
+		if isRetryable(err) {
+			return err
+		}
 		log.InfraErr(err).Msgf("Processing OrgActiveWatcherDelete of %s (hashName: %s): failed to get config Org",
 			w.DisplayName(), w.Name)
-		return
+		return nil
 	}
 
 	// Get all watchers registered to be notified.
 	expectedOrgWatchers, err := GetExpectedOrgWatchers(r.Client)
 	if err != nil {
-		setOrgStatus(r.Client, configOrg.DisplayName(), configOrg.Name,
+		if isRetryable(err) {
+			return err
+		}
+		return r.setOrgStatus(configOrg.DisplayName(), configOrg.Name,
 			orgsv1.StatusIndicationError,
 			fmt.Sprintf("Failed to process OrgActiveWatcher delete, unable to fetch expectedOrgWatchers, error: %v",
 				err),
 			Delete)
-		return
 	}
 
 	// Get the list of watchers that have acknowledged the Org.
@@ -456,10 +586,19 @@ func (r *Reconciler) ProcessOrgActiveWatcherDelete(w *nexus_client.Orgactivewatc
 
 	// If there is at least one active watcher, the org is not ready to be deleted.
 	if foundActiveWatcher {
+		if err := signalOrgWatchers(context.Background(), r.Client, runtimeOrg, Delete, nil); err != nil {
+			if isRetryable(err) {
+				return fmt.Errorf("unable to signal ready watchers: %w", err)
+			}
+			log.InfraErr(err).Msgf("Unable to signal the ready watchers of org %s", configOrg.DisplayName())
+		}
 		msg := fmt.Sprintf("Waiting for watchers %v to be deleted", getMapKeys(currentActiveWatchers))
-		setOrgStatus(r.Client, configOrg.DisplayName(), configOrg.Name, orgsv1.StatusIndicationInProgress, msg, Delete)
+		if err := r.setOrgStatus(configOrg.DisplayName(), configOrg.Name, orgsv1.StatusIndicationInProgress,
+			msg, Delete); err != nil {
+			return err
+		}
 		log.Debug().Msgf("Processing OrgActiveWatcher delete: %v", msg)
-		return
+		return nil
 	}
 
 	// There are no active watchers.
@@ -467,20 +606,46 @@ func (r *Reconciler) ProcessOrgActiveWatcherDelete(w *nexus_client.Orgactivewatc
 	configOrg.SetFinalizers([]string{})
 	err = configOrg.Update(context.Background())
 	if err != nil && !nexus_client.IsNotFound(err) {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to remove the finalizers of config Org: %w", err)
+		}
 		log.InfraErr(err).Msgf("Failed to remove the finalizers of config Org %s (hashName %s)",
 			configOrg.DisplayName(), configOrg.Name)
 	}
 	err = runtimeOrg.Delete(context.Background())
 	if err != nil && !nexus_client.IsNotFound(err) {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to delete runtime Org: %w", err)
+		}
 		log.InfraErr(err).Msgf("Failed to delete runtime Org %s (hashName %s)",
 			runtimeOrg.DisplayName(), runtimeOrg.Name)
 	}
+	r.orgDeleted(configOrg)
+	return nil
 }
 
 // ProcessProjectsAdd is callback function to be invoked when Project is added.
 func (r *Reconciler) ProcessProjectsAdd(project *nexus_client.ProjectProject) {
 	log.Debug().Msgf("Project %s (hashName: %s) created", project.DisplayName(), project.Name)
 
+	parentOrgName := project.GetLabels()["orgs.org.edge-orchestrator.intel.com"]
+	parentFolderName := RuntimeFolder(project)
+	r.projects.enqueue(workKey{event: eventProjectAdd, hashName: project.Name}, task{
+		lock: configProjectLockKey(project),
+		run:  r.withCurrentProject(project, r.processProjectsAdd),
+		fail: func(err error) {
+			if err := r.setProjectStatus(project.DisplayName(), project.Name, parentOrgName, parentFolderName,
+				projectv1.StatusIndicationError,
+				fmt.Sprintf("Project creation failed with an error: %v", err), Create); err != nil {
+				log.InfraErr(err).Msgf("Unable to set the status of project %s", project.DisplayName())
+			}
+		},
+	})
+	r.updateUsage(parentOrgName)
+}
+
+// processProjectsAdd creates the runtime Project. It returns an error only for transient failures that should be retried.
+func (r *Reconciler) processProjectsAdd(project *nexus_client.ProjectProject) error {
 	/* The 'Testing' boolean is a temporary workaround to bypass the check below in unit tests.
 	In unit tests, the object is created with a deletion timestamp.*/
 	if !Testing {
@@ -489,8 +654,18 @@ func (r *Reconciler) ProcessProjectsAdd(project *nexus_client.ProjectProject) {
 		if !project.DeletionTimestamp.IsZero() {
 			log.Debug().Msgf("Project %s (hashName: %s) is marked for delete, processing delete",
 				project.DisplayName(), project.Name)
-			r.ProcessProjectsDelete(project)
-			return
+			return r.processProjectsDelete(project)
+		}
+	}
+
+	if project.GetAnnotations()[runtimeFolderAnnotation] != "" && project.Status.ProjectStatus.StatusIndicator == "" {
+		// A moved Project is added before the status of its former config Project is copied to it.
+		current, err := r.Client.Project().GetProjectByName(context.Background(), project.Name)
+		if err != nil && !nexus_client.IsNotFound(err) {
+			return fmt.Errorf("unable to get moved config Project: %w", err)
+		}
+		if err == nil {
+			project = current
 		}
 	}
 
@@ -498,86 +673,159 @@ func (r *Reconciler) ProcessProjectsAdd(project *nexus_client.ProjectProject) {
 		// Project create already processed. Return.
 		log.Debug().Msgf("Skip project creation of %s (hashName: %s) as it is already created",
 			project.DisplayName(), project.Name)
-		return
+		return nil
 	}
 
-	displayName, hashName := project.DisplayName(), project.Name
-
 	// Derive Org and Folder name from labels.
 	parentOrgName := project.GetLabels()["orgs.org.edge-orchestrator.intel.com"]
-	parentFolderName := project.GetLabels()["folders.folder.edge-orchestrator.intel.com"]
+	parentFolderName := RuntimeFolder(project)
+
+	if err := ensureRuntimeFolder(context.Background(), r.Client, parentOrgName, parentFolderName); err != nil {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to add runtime Folder: %w", err)
+		}
+		log.InfraErr(err).Msgf("Project creation for config Project %s (hashName: %s) failed: "+
+			"unable to add runtime Folder", project.DisplayName(), project.Name)
+		return r.setProjectStatus(project.DisplayName(),
+			project.Name, parentOrgName, parentFolderName,
+			projectv1.StatusIndicationError,
+			fmt.Sprintf("Project creation failed: unable to add runtime Folder, error: %v", err),
+			Create)
+	}
 
+	expanded, err := expandTemplate(context.Background(), r.Client, project)
+	if err != nil {
+		if isRetryable(err) {
+			return err
+		}
+		log.InfraErr(err).Msgf("Project creation for config Project %s (hashName: %s) failed: "+
+			"unable to expand its template", project.DisplayName(), project.Name)
+		return r.setProjectStatus(project.DisplayName(),
+			project.Name, parentOrgName, parentFolderName,
+			projectv1.StatusIndicationError,
+			fmt.Sprintf("Project creation failed: unable to expand its template, error: %v", err),
+			Create)
+	}
+	project = expanded
+
+	// No watcher is ready until the watcher ordering is signalled below.
+	annotations := templateParameters(project)
+	annotations[createStartedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	annotations[ReadyWatchersAnnotation] = ""
 	runtimeProject, err := r.Client.TenancyMultiTenancy().Runtime().
 		Orgs(parentOrgName).Folders(parentFolderName).
 		AddProjects(context.Background(), &runtimeprojectsv1.RuntimeProject{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: project.DisplayName(),
+				Name:        project.DisplayName(),
+				Annotations: annotations,
+			},
+			Spec: runtimeprojectsv1.RuntimeProjectSpec{
+				Archived: project.Spec.Archived,
+				Labels:   project.Spec.Labels,
+				Owners:   project.Spec.Owners,
+				Folder:   project.GetLabels()["folders.folder.edge-orchestrator.intel.com"],
 			},
-			Spec: runtimeprojectsv1.RuntimeProjectSpec{},
 		})
 	if err != nil && !nexus_client.IsAlreadyExists(err) {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to add runtime Project: %w", err)
+		}
 		log.InfraErr(err).Msgf("Project creation for config Project %s (hashName: %s) failed: "+
 			"unable to add runtime Project", project.DisplayName(), project.Name)
-		setProjectStatus(r.Client, project.DisplayName(),
+		return r.setProjectStatus(project.DisplayName(),
 			project.Name, parentOrgName, parentFolderName,
 			projectv1.StatusIndicationError,
 			fmt.Sprintf("Project creation failed: unable to add runtime Project, error: %v", err),
 			Create)
-		return
 	}
 
 	if project.Status.ProjectStatus.StatusIndicator == "" {
 		// Set the Project status to InProgress and continue creation.
-		setProjectStatus(r.Client, project.DisplayName(),
+		if err := r.setProjectStatus(project.DisplayName(),
 			project.Name, parentOrgName, parentFolderName,
 			projectv1.StatusIndicationInProgress,
 			fmt.Sprintf("Project %v CREATE initiated", project.DisplayName()),
-			Create)
+			Create); err != nil {
+			return err
+		}
 	}
 
 	expectedProjectWatchers, err := GetExpectedProjectWatchers(r.Client)
 	if err != nil {
+		if isRetryable(err) {
+			return err
+		}
 		log.InfraErr(err).Msgf("Project creation for config Project %s (hashName: %s) failed: "+
 			"unable to fetch expectedProjectWatchers", project.DisplayName(), project.Name)
 
-		setProjectStatus(r.Client, project.DisplayName(),
+		return r.setProjectStatus(project.DisplayName(),
 			project.Name, parentOrgName, parentFolderName,
 			projectv1.StatusIndicationError,
 			fmt.Sprintf("Project creation failed: unable to fetch expectedProjectWatchers, error: %v", err),
 			Create)
-		return
 	}
 
 	// If no watchers are registered, then mark it to idle.
 	if len(expectedProjectWatchers) == 0 {
 		log.Debug().Msgf("Creation of project %s (hashName: %s) is successful, marking it as 'IDLE'",
 			project.DisplayName(), project.Name)
-		setProjectStatus(r.Client, project.DisplayName(),
+		if err := r.setProjectStatus(project.DisplayName(),
 			project.Name, parentOrgName, parentFolderName,
 			projectv1.StatusIndicationIdle,
 			fmt.Sprintf("Project %v CREATE is complete", project.DisplayName()),
+			Create); err != nil {
+			return err
+		}
+		clearAckStarted(context.Background(), projectAck(project, Create), runtimeProject)
+		return nil
+	}
+
+	// Let the watchers of the first phase act on the project.
+	if err := signalProjectWatchers(context.Background(), r.Client, runtimeProject, Create, nil); err != nil {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to signal ready watchers: %w", err)
+		}
+		log.InfraErr(err).Msgf("Project creation for config Project %s (hashName: %s) failed: "+
+			"unable to signal ready watchers", project.DisplayName(), project.Name)
+		return r.setProjectStatus(project.DisplayName(),
+			project.Name, parentOrgName, parentFolderName,
+			projectv1.StatusIndicationError,
+			fmt.Sprintf("Project creation failed: unable to signal ready watchers, error: %v", err),
 			Create)
-		return
 	}
 
 	// Otherwise, set it to Inprogress and wait for watchers to acknowledge this project.
 	log.Debug().Msgf("Waiting for watchers %v to acknowledge the project %s (hashName: %s)",
 		getMapKeys(expectedProjectWatchers), project.DisplayName(), project.Name)
-	setProjectStatus(r.Client, project.DisplayName(), project.Name,
+	if err := r.setProjectStatus(project.DisplayName(), project.Name,
 		parentOrgName, parentFolderName, projectv1.StatusIndicationInProgress,
 		fmt.Sprintf("Waiting for watchers %v to acknowledge this project",
 			getMapKeys(expectedProjectWatchers)),
-		Create)
+		Create); err != nil {
+		return err
+	}
 
-	go r.StartProjectCreateAcknowledgementTimer(time.Duration(r.Config.ProjectCreateTimeoutInSecs)*time.Second,
-		expectedProjectWatchers,
-		displayName, hashName, parentOrgName, parentFolderName, runtimeProject)
+	r.enqueueAck(projectAck(project, Create))
+	return nil
 }
 
 // ProcessProjectsUpdate is callback function to be invoked when Project is updated.
 func (r *Reconciler) ProcessProjectsUpdate(old, updated *nexus_client.ProjectProject) {
-	// Skip those events that aren't delete events.
+	// Operator requests take precedence over the delete they may come with.
+	if r.processProjectRequests(old, updated) {
+		return
+	}
+
+	// Skip those events that aren't delete events, once a change of the spec is propagated.
 	if updated.DeletionTimestamp.IsZero() {
+		if projectSpecChanged(old, updated) {
+			r.syncRuntimeProject(updated.GetLabels()["orgs.org.edge-orchestrator.intel.com"],
+				RuntimeFolder(updated), updated.DisplayName())
+		}
+		// The state of the Networks follows the status of their Project.
+		if old.Status.ProjectStatus.StatusIndicator != updated.Status.ProjectStatus.StatusIndicator {
+			r.syncNetworks(updated)
+		}
 		return
 	}
 
@@ -603,11 +851,49 @@ func (r *Reconciler) ProcessProjectsUpdate(old, updated *nexus_client.ProjectPro
 
 // ProcessProjectsDelete is the function invoked when Project is deleted.
 func (r *Reconciler) ProcessProjectsDelete(obj *nexus_client.ProjectProject) {
-	displayName, hashName := obj.DisplayName(), obj.Name
+	parentOrgName := obj.GetLabels()["orgs.org.edge-orchestrator.intel.com"]
+	parentFolderName := RuntimeFolder(obj)
+	r.projects.enqueue(workKey{event: eventProjectDelete, hashName: obj.Name}, task{
+		lock: configProjectLockKey(obj),
+		run:  r.withCurrentProject(obj, r.processProjectsDelete),
+		fail: func(err error) {
+			if err := r.setProjectStatus(obj.DisplayName(), obj.Name, parentOrgName, parentFolderName,
+				projectv1.StatusIndicationError,
+				fmt.Sprintf("Project deletion failed with an error: %v", err), Delete); err != nil {
+				log.InfraErr(err).Msgf("Unable to set the status of project %s", obj.DisplayName())
+			}
+		},
+	})
+}
+
+// processProjectsDelete deletes the runtime Project. It returns an error only for transient failures that should be retried.
+func (r *Reconciler) processProjectsDelete(obj *nexus_client.ProjectProject) error {
+	if obj.GetAnnotations()[movedToAnnotation] != "" {
+		return releaseMovedProject(context.Background(), obj)
+	}
 
 	// Derive org and folder name from labels.
 	parentOrgName := obj.GetLabels()["orgs.org.edge-orchestrator.intel.com"]
-	parentFolderName := obj.GetLabels()["folders.folder.edge-orchestrator.intel.com"]
+	parentFolderName := RuntimeFolder(obj)
+
+	// The Networks are deleted first, the removal of those held by finalizers queues the delete again.
+	networks, err := deleteProjectNetworks(context.Background(), r.Client, obj)
+	if err != nil {
+		if isRetryable(err) {
+			return err
+		}
+		errMsg := fmt.Sprintf("Project deletion failed: unable to delete Networks, error: %v", err)
+		if err := r.setProjectStatus(obj.DisplayName(), obj.Name, parentOrgName, parentFolderName,
+			projectv1.StatusIndicationError, errMsg, Delete); err != nil {
+			return err
+		}
+		log.Error().Msg(errMsg)
+		return nil
+	}
+	if len(networks) != 0 {
+		return r.setProjectStatus(obj.DisplayName(), obj.Name, parentOrgName, parentFolderName,
+			projectv1.StatusIndicationInProgress, fmt.Sprintf("Waiting for networks %v to be deleted", networks), Delete)
+	}
 
 	// Update the runtime project object to deleting.
 	runtimeProject, err := r.Client.TenancyMultiTenancy().Runtime().
@@ -618,40 +904,71 @@ func (r *Reconciler) ProcessProjectsDelete(obj *nexus_client.ProjectProject) {
 			// Runtime object does not exist for this Project. Just delete it.
 			err = obj.Delete(context.Background())
 			if err != nil {
+				if isRetryable(err) {
+					return fmt.Errorf("unable to delete config Project: %w", err)
+				}
 				log.InfraErr(err).Msgf("Failed to delete config Project %s (hashName: %s)",
 					obj.DisplayName(), obj.Name)
-				return
+				return nil
 			}
 		} else {
+			if isRetryable(err) {
+				return fmt.Errorf("unable to get runtime Project: %w", err)
+			}
 			errMsg := fmt.Sprintf("Project deletion failed, unable to get runtime Project, error: %v", err)
-			setProjectStatus(r.Client, obj.DisplayName(),
+			if err := r.setProjectStatus(obj.DisplayName(),
 				obj.Name, parentOrgName, parentFolderName,
 				projectv1.StatusIndicationError,
 				errMsg,
-				Delete)
+				Delete); err != nil {
+				return err
+			}
 			log.Error().Msg(errMsg)
 		}
-		return
+		return nil
 	}
 
 	runtimeProject.Spec.Deleted = true
+	markAckStarted(runtimeProject, Delete)
+	// Watchers are released in the reverse of the create order, replacing the watchers ready for the create.
+	clearReadyWatchers(runtimeProject.GetAnnotations())
+	if _, err := markReadyProjectWatchers(context.Background(), r.Client, runtimeProject, Delete, nil); err != nil {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to get ready watchers: %w", err)
+		}
+		log.InfraErr(err).Msgf("Unable to order the watchers of project %s (hashName: %s), releasing all of them",
+			obj.DisplayName(), obj.Name)
+	}
 	err = runtimeProject.Update(context.Background())
 	if err != nil && !Testing {
-		// SAFETY: 'Testing' bool prevents panic in UTs. In tests, handle errors gracefully without crashing.
-		// In normal runtime, a panic is raised on update failure as it's critical.
-		log.Panic().Msgf("failed update to runtime Project %s (hashName: %s), error: %v",
-			runtimeProject.DisplayName(), runtimeProject.Name, err)
+		// SAFETY: 'Testing' bool lets UTs continue the flow when the update fails.
+		if isRetryable(err) {
+			return fmt.Errorf("unable to mark runtime Project deleted: %w", err)
+		}
+		errMsg := fmt.Sprintf("Project deletion failed: unable to mark runtime Project deleted, error: %v", err)
+		if err := r.setProjectStatus(obj.DisplayName(), obj.Name, parentOrgName, parentFolderName,
+			projectv1.StatusIndicationError, errMsg, Delete); err != nil {
+			return err
+		}
+		log.InfraErr(err).Msgf("Failed to update runtime Project %s (hashName: %s)",
+			runtimeProject.DisplayName(), runtimeProject.Name)
+		return nil
 	}
 
 	expectedProjectWatchers, err := GetExpectedProjectWatchers(r.Client)
 	if err != nil {
+		if isRetryable(err) {
+			return err
+		}
 		errMsg := fmt.Sprintf("Project deletion failed: unable to fetch expectedProjectWatchers, error: %v", err)
-		setProjectStatus(r.Client, obj.DisplayName(),
+		if err := r.setProjectStatus(obj.DisplayName(),
 			obj.Name, parentOrgName, parentFolderName,
 			projectv1.StatusIndicationError, errMsg,
-			Delete)
+			Delete); err != nil {
+			return err
+		}
 		log.Error().Msg(errMsg)
-		return
+		return nil
 	}
 
 	// Get the list of watchers that have acknowledged the Project.
@@ -687,10 +1004,14 @@ func (r *Reconciler) ProcessProjectsDelete(obj *nexus_client.ProjectProject) {
 		obj.SetFinalizers([]string{})
 		err = obj.Update(context.Background())
 		if err != nil && !nexus_client.IsNotFound(err) {
+			if isRetryable(err) {
+				return fmt.Errorf("unable to remove the finalizers of config Project: %w", err)
+			}
 			log.InfraErr(err).Msgf("Failed to remove the finalizers of config Project %s (hashName: %s)",
 				obj.DisplayName(), obj.Name)
 		}
-		return
+		r.projectDeleted(obj)
+		return nil
 	}
 
 	// If there is at least one active watcher, don't mark for deletion.
@@ -699,13 +1020,14 @@ func (r *Reconciler) ProcessProjectsDelete(obj *nexus_client.ProjectProject) {
 
 	// Set the Project status to InProgress and continue deletion.
 	msg := fmt.Sprintf("Waiting for watchers %v to be deleted", getMapKeys(currentActiveWatchers))
-	setProjectStatus(r.Client, obj.DisplayName(),
+	if err := r.setProjectStatus(obj.DisplayName(),
 		obj.Name, parentOrgName, parentFolderName,
-		projectv1.StatusIndicationInProgress, msg, Delete)
+		projectv1.StatusIndicationInProgress, msg, Delete); err != nil {
+		return err
+	}
 
-	go r.StartProjectDeleteAcknowledgementTimer(time.Duration(r.Config.ProjectDeleteTimeoutInSecs)*time.Second,
-		expectedProjectWatchers,
-		displayName, hashName, parentOrgName, parentFolderName, runtimeProject)
+	r.enqueueAck(projectAck(obj, Delete))
+	return nil
 }
 
 // ProcessProjectActiveWatcherAdd is the callback function to be invoked,
@@ -713,10 +1035,20 @@ func (r *Reconciler) ProcessProjectsDelete(obj *nexus_client.ProjectProject) {
 func (r *Reconciler) ProcessProjectActiveWatcherAdd(w *nexus_client.ProjectactivewatcherProjectActiveWatcher) {
 	log.Debug().Msgf("Projects active watcher %s (hashName: %s) created", w.DisplayName(), w.Name)
 
-	if err := processProjectActiveWatcher(r.Client, w); err != nil {
-		log.InfraErr(err).Msgf("Processing ProjectActiveWatcherAdd %s (hashName: %s) failed with an error: %v",
-			w.DisplayName(), w.Name, err)
-	}
+	r.projects.enqueue(workKey{event: eventProjectActiveWatcherAdd, hashName: w.Name}, task{
+		lock: projectWatcherLockKey(w.GetLabels()),
+		run: func() error {
+			err := r.processProjectActiveWatcher(w)
+			if isRetryable(err) {
+				return err
+			}
+			if err != nil {
+				log.InfraErr(err).Msgf("Processing ProjectActiveWatcherAdd %s (hashName: %s) failed with an error: %v",
+					w.DisplayName(), w.Name, err)
+			}
+			return nil
+		},
+	})
 }
 
 // ProcessProjectActiveWatcherUpdate is the callback function to be invoked when active watcher is updated.
@@ -734,52 +1066,76 @@ func (r *Reconciler) ProcessProjectActiveWatcherUpdate(old, updated *nexus_clien
 		return
 	}
 
-	if err := processProjectActiveWatcher(r.Client, updated); err != nil {
-		log.InfraErr(err).Msgf("Processing ProjectActiveWatcherUpdate %s (hashName: %s) failed with an error: %v",
-			updated.DisplayName(), updated.Name, err)
-	}
+	r.projects.enqueue(workKey{event: eventProjectActiveWatcherUpdate, hashName: updated.Name}, task{
+		lock: projectWatcherLockKey(updated.GetLabels()),
+		run: func() error {
+			err := r.processProjectActiveWatcher(updated)
+			if isRetryable(err) {
+				return err
+			}
+			if err != nil {
+				log.InfraErr(err).Msgf("Processing ProjectActiveWatcherUpdate %s (hashName: %s) failed with an error: %v",
+					updated.DisplayName(), updated.Name, err)
+			}
+			return nil
+		},
+	})
 }
 
 // ProcessProjectActiveWatcherDelete is the callback function to be invoked when active watcher has stopped watching a project.
 func (r *Reconciler) ProcessProjectActiveWatcherDelete(w *nexus_client.ProjectactivewatcherProjectActiveWatcher) {
 	log.Debug().Msgf("Project active watcher %s (hashName: %s) deleted", w.DisplayName(), w.Name)
 
+	r.projects.enqueue(workKey{event: eventProjectActiveWatcherDelete, hashName: w.Name}, task{
+		lock: projectWatcherLockKey(w.GetLabels()),
+		run:  func() error { return r.processProjectActiveWatcherDelete(w) },
+	})
+}
+
+// processProjectActiveWatcherDelete completes a pending Project delete once its last active watcher is gone.
+func (r *Reconciler) processProjectActiveWatcherDelete(w *nexus_client.ProjectactivewatcherProjectActiveWatcher) error {
 	// Get the runtime project associated with this active project watcher.
 	runtimeProject, err := w.GetParent(context.Background())
 	if err != nil {
+		if isRetryable(err) {
+			return err
+		}
 		log.InfraErr(err).Msgf("Processing ProjectActiveWatcherDelete of %s (hashName: %s): failed to get runtime Project",
 			w.DisplayName(), w.Name)
-		return
+		return nil
 	}
 
 	if !runtimeProject.Spec.Deleted {
 		// A watcher got removed but the runtime is not marked for deletion. So dont have to react, as watchers can come and go.
-		return
+		return nil
 	}
 
 	// Derive Org and Folder name from labels.
 	parentOrgName := runtimeProject.GetLabels()["runtimeorgs.runtimeorg.edge-orchestrator.intel.com"]
 	parentFolerName := runtimeProject.GetLabels()["runtimefolders.runtimefolder.edge-orchestrator.intel.com"]
 
-	configProject, err := r.Client.TenancyMultiTenancy().Config().
-		Orgs(parentOrgName).Folders(parentFolerName).
-		GetProjects(context.Background(), runtimeProject.DisplayName())
+	configProject, err := getConfigProject(r.Client, parentOrgName, parentFolerName, runtimeProject.DisplayName())
 	if err != nil {
+		if isRetryable(err) {
+			return err
+		}
 		log.InfraErr(err).Msgf("Processing ProjectActiveWatcherDelete of %s (hashName: %s): failed to get config Project",
 			w.DisplayName(), w.Name)
-		return
+		return nil
 	}
 
 	expectedProjectWatchers, err := GetExpectedProjectWatchers(r.Client)
 	if err != nil {
+		if isRetryable(err) {
+			return err
+		}
 		log.InfraErr(err).Msgf("Processing ProjectActiveWatcherDelete of %s (hashName: %s): "+
 			"unable to fetch expectedProjectWatchers", w.DisplayName(), w.Name)
-		setProjectStatus(r.Client, configProject.DisplayName(), configProject.Name, parentOrgName, parentFolerName,
+		return r.setProjectStatus(configProject.DisplayName(), configProject.Name, parentOrgName, parentFolerName,
 			projectv1.StatusIndicationError,
 			fmt.Sprintf("Failed to process ProjectActiveWatcher delete, unable to fetch expectedProjectWatchers, "+
 				"error: %v", err),
 			Delete)
-		return
 	}
 
 	// Get the list of watchers that have acknowledged the Project.
@@ -806,13 +1162,21 @@ func (r *Reconciler) ProcessProjectActiveWatcherDelete(w *nexus_client.Projectac
 
 	// If there is at least one active watcher, the project is not ready to be deleted.
 	if foundActiveWatcher {
+		if err := signalProjectWatchers(context.Background(), r.Client, runtimeProject, Delete, nil); err != nil {
+			if isRetryable(err) {
+				return fmt.Errorf("unable to signal ready watchers: %w", err)
+			}
+			log.InfraErr(err).Msgf("Unable to signal the ready watchers of project %s", configProject.DisplayName())
+		}
 		msg := fmt.Sprintf("Waiting for watchers %v to be deleted", getMapKeys(currentActiveWatchers))
-		setProjectStatus(r.Client, configProject.DisplayName(),
+		if err := r.setProjectStatus(configProject.DisplayName(),
 			configProject.Name, parentOrgName, parentFolerName,
 			projectv1.StatusIndicationInProgress,
-			msg, Delete)
+			msg, Delete); err != nil {
+			return err
+		}
 		log.Debug().Msg(msg)
-		return
+		return nil
 	}
 
 	// There are no active watchers.
@@ -820,18 +1184,27 @@ func (r *Reconciler) ProcessProjectActiveWatcherDelete(w *nexus_client.Projectac
 	configProject.SetFinalizers([]string{})
 	err = configProject.Update(context.Background())
 	if err != nil && !nexus_client.IsNotFound(err) {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to remove the finalizers of config Project: %w", err)
+		}
 		log.InfraErr(err).Msgf("Failed to remove finalizers of config Project %s (hashName: %s)",
 			configProject.DisplayName(), configProject.Name)
 	}
 	err = runtimeProject.Delete(context.Background())
 	if err != nil && !nexus_client.IsNotFound(err) {
+		if isRetryable(err) {
+			return fmt.Errorf("unable to delete runtime Project: %w", err)
+		}
 		log.InfraErr(err).Msgf("Failed to delete runtime Project %s (hashName: %s)",
 			runtimeProject.DisplayName(), runtimeProject.Name)
 	}
+	r.projectDeleted(configProject)
+	return nil
 }
 
 // processOrgActiveWatcher processes OrgActiveWatcher's add and update events.
-func processOrgActiveWatcher(client *nexus_client.Clientset, obj *nexus_client.OrgactivewatcherOrgActiveWatcher) error {
+func (r *Reconciler) processOrgActiveWatcher(obj *nexus_client.OrgactivewatcherOrgActiveWatcher) error {
+	client := r.Client
 	// Get the runtime Org associated with this active org watcher.
 	runtimeorg, err := obj.GetParent(context.Background())
 	if err != nil {
@@ -894,12 +1267,18 @@ func processOrgActiveWatcher(client *nexus_client.Clientset, obj *nexus_client.O
 
 	// If there is delta, then we will need to wait for additional watchers to acknowledge this org.
 	if len(expectedOrgWatchers) > 0 {
+		// The next watchers may be ready now that this one has progressed.
+		if err := signalOrgWatchers(context.Background(), client, runtimeorg, Create, nil); err != nil {
+			return fmt.Errorf("failed to signal ready watchers of org %s, %w", configOrg.DisplayName(), err)
+		}
 		msg := fmt.Sprintf("Waiting for watchers %v to acknowledge org %s",
 			getMapKeys(expectedOrgWatchers), configOrg.DisplayName())
-		setOrgStatus(client, configOrg.DisplayName(), configOrg.Name,
+		if err := r.setOrgStatus(configOrg.DisplayName(), configOrg.Name,
 			orgsv1.StatusIndicationInProgress,
 			msg,
-			Create)
+			Create); err != nil {
+			return err
+		}
 		log.Debug().Msgf("Processing OrgActiveWatcher: %v", msg)
 		return nil
 	}
@@ -908,17 +1287,15 @@ func processOrgActiveWatcher(client *nexus_client.Clientset, obj *nexus_client.O
 		configOrg.DisplayName(), configOrg.Name)
 
 	// All watchers have acknowledged. Mark the org as created.
-	setOrgStatus(client, configOrg.DisplayName(), configOrg.Name,
+	return r.setOrgStatus(configOrg.DisplayName(), configOrg.Name,
 		orgsv1.StatusIndicationIdle,
 		fmt.Sprintf("Org %v CREATE is complete", configOrg.DisplayName()),
 		Create)
-	return nil
 }
 
 // processProjectActiveWatcher processes ProjectActiveWatcher's add and update events.
-func processProjectActiveWatcher(client *nexus_client.Clientset,
-	watcher *nexus_client.ProjectactivewatcherProjectActiveWatcher,
-) error {
+func (r *Reconciler) processProjectActiveWatcher(watcher *nexus_client.ProjectactivewatcherProjectActiveWatcher) error {
+	client := r.Client
 	// Get the runtime obj associated with this active project watcher.
 	runtimeProject, err := watcher.GetParent(context.Background())
 	if err != nil {
@@ -929,9 +1306,7 @@ func processProjectActiveWatcher(client *nexus_client.Clientset,
 	parentOrgName := runtimeProject.GetLabels()["runtimeorgs.runtimeorg.edge-orchestrator.intel.com"]
 	parentFolerName := runtimeProject.GetLabels()["runtimefolders.runtimefolder.edge-orchestrator.intel.com"]
 
-	configProject, err := client.TenancyMultiTenancy().
-		Config().Orgs(parentOrgName).Folders(parentFolerName).
-		GetProjects(context.Background(), runtimeProject.DisplayName())
+	configProject, err := getConfigProject(client, parentOrgName, parentFolerName, runtimeProject.DisplayName())
 	if err != nil {
 		return fmt.Errorf("failed to get config Project with error: %w", err)
 	}
@@ -988,12 +1363,18 @@ func processProjectActiveWatcher(client *nexus_client.Clientset,
 
 	// If there is delta, then we will need to wait for additional watchers to acknowledge this project.
 	if len(expectedProjectWatchers) > 0 {
+		// The next watchers may be ready now that this one has progressed.
+		if err := signalProjectWatchers(context.Background(), client, runtimeProject, Create, nil); err != nil {
+			return fmt.Errorf("failed to signal ready watchers of project %s, %w", configProject.DisplayName(), err)
+		}
 		msg := fmt.Sprintf("Waiting for watchers %v to acknowledge project %s",
 			getMapKeys(expectedProjectWatchers), configProject.DisplayName())
-		setProjectStatus(client, configProject.DisplayName(),
+		if err := r.setProjectStatus(configProject.DisplayName(),
 			configProject.Name, parentOrgName, parentFolerName,
 			projectv1.StatusIndicationInProgress,
-			msg, Create)
+			msg, Create); err != nil {
+			return err
+		}
 		log.Debug().Msgf("Processing ProjectActiveWatcher: %v", msg)
 		return nil
 	}
@@ -1002,10 +1383,9 @@ func processProjectActiveWatcher(client *nexus_client.Clientset,
 		configProject.DisplayName(), configProject.Name)
 
 	// All watchers have acknowledged. Mark the project as created.
-	setProjectStatus(client, configProject.DisplayName(),
+	return r.setProjectStatus(configProject.DisplayName(),
 		configProject.Name, parentOrgName, parentFolerName,
 		projectv1.StatusIndicationIdle,
 		fmt.Sprintf("Project %v CREATE is complete", configProject.DisplayName()),
 		Create)
-	return nil
 }
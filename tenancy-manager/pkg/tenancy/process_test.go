@@ -138,6 +138,22 @@ var _ = ginkgo.Describe("Org/Project Create and Delete operation", ginkgo.Ordere
 						return status == string(projectv1.StatusIndicationIdle)
 					}, timeoutInterval, pollingInterval).Should(gomega.BeTrue(), "config project to be set to active")
 				})
+
+				ginkgo.It("should report the quota usage of the org", func() {
+					gomega.Eventually(func() map[string]interface{} {
+						org, err := nexusClient.DynamicClient.Resource(constructOrgGVR()).
+							Get(context.Background(), org1HashedName, metav1.GetOptions{})
+						if err != nil {
+							return nil
+						}
+						usage, _, _ := unstructured.NestedMap(org.Object, "status", "orgStatus", "usage")
+						return usage
+					}, timeoutInterval, pollingInterval).Should(gomega.And(
+						gomega.HaveKeyWithValue("folders", gomega.BeEquivalentTo(1)),
+						gomega.HaveKeyWithValue("projects", gomega.BeEquivalentTo(1)),
+						gomega.HaveKeyWithValue("networks", gomega.BeEquivalentTo(0)),
+					), "org usage to count the default folder and its project")
+				})
 			})
 
 			ginkgo.When("config org/project is deleted", func() {
@@ -266,6 +282,27 @@ var _ = ginkgo.Describe("Org/Project Create and Delete operation", ginkgo.Ordere
 					return message == "Waiting for watchers [cluster-orchestrator-2] to acknowledge org fanta"
 				}, timeoutInterval, pollingInterval).Should(gomega.BeTrue(), "config org to be set to 'InProgress'")
 
+				// Check if the config org reports the state of each watcher.
+				gomega.Eventually(func() []interface{} {
+					org, err := nexusClient.DynamicClient.Resource(constructOrgGVR()).
+						Get(context.Background(), org3HashedName, metav1.GetOptions{})
+					if err != nil {
+						return nil
+					}
+					conditions, _, _ := unstructured.NestedSlice(org.Object, "status", "orgStatus", "conditions")
+					return conditions
+				}, timeoutInterval, pollingInterval).Should(gomega.ContainElements(
+					gomega.And(
+						gomega.HaveKeyWithValue("watcher", watcher1Name),
+						gomega.HaveKeyWithValue("state", string(orgactivewatcherv1.StatusIndicationIdle)),
+					),
+					gomega.And(
+						gomega.HaveKeyWithValue("watcher", watcher2Name),
+						gomega.HaveKeyWithValue("state", string(orgactivewatcherv1.StatusIndicationInProgress)),
+						gomega.HaveKeyWithValue("attempts", gomega.BeNumerically("==", 1)),
+					),
+				), "config org to report the state of each watcher")
+
 				activeWatcher.Spec.StatusIndicator = orgactivewatcherv1.StatusIndicationIdle
 				err = activeWatcher.Update(context.Background())
 				gomega.Expect(err).NotTo(gomega.HaveOccurred())
@@ -865,9 +902,13 @@ var _ = ginkgo.Describe("Org/Project Create and Delete operation", ginkgo.Ordere
 	})
 
 	ginkgo.It("should skip processing org add if the status is already 'Idle'", func() {
+		// The org add task re-reads the org by name, which the nexus client serves from its informer cache.
+		// The client exits on a cached object without a numeric ResourceVersion: the API server always sets
+		// one, the fake client keeps the one it is given.
 		_, err := configClient.AddOrgs(context.Background(), &orgsv1.Org{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: "bar",
+				Name:            "bar",
+				ResourceVersion: "1",
 			},
 			Status: orgsv1.OrgNexusStatus{
 				OrgStatus: orgsv1.OrgStatus{
@@ -886,6 +927,11 @@ var _ = ginkgo.Describe("Org/Project Create and Delete operation", ginkgo.Ordere
 				GetOrgs(context.Background(), "bar")
 			return errors.IsNotFound(err)
 		}, timeoutInterval, pollingInterval).Should(gomega.BeTrue())
+
+		// Verify that the org read from the cache is left 'IDLE'.
+		org, err := tenancyReconciler.Client.TenancyMultiTenancy().Config().GetOrgs(context.Background(), "bar")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(org.Status.OrgStatus.StatusIndicator).To(gomega.Equal(orgsv1.StatusIndicationIdle))
 	})
 
 	ginkgo.It("should skip processing project add if the status is already 'Idle'", func() {
@@ -896,11 +942,12 @@ var _ = ginkgo.Describe("Org/Project Create and Delete operation", ginkgo.Ordere
 				},
 			})
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
-		// Create the config project.
+		// Create the config project. Like the org, it is re-read by name from the cache by the project add task.
 		_, err = tenancyReconciler.Client.TenancyMultiTenancy().Config().Orgs("bar").
 			Folders(defaultName).AddProjects(context.Background(), &projectv1.Project{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: "bar",
+				Name:            "bar",
+				ResourceVersion: "1",
 			},
 			Status: projectv1.ProjectNexusStatus{
 				ProjectStatus: projectv1.ProjectStatus{
@@ -919,5 +966,11 @@ var _ = ginkgo.Describe("Org/Project Create and Delete operation", ginkgo.Ordere
 				Folders(defaultName).GetProjects(context.Background(), "bar")
 			return errors.IsNotFound(err)
 		}, timeoutInterval, pollingInterval).Should(gomega.BeTrue())
+
+		// Verify that the project read from the cache is left 'IDLE'.
+		project, err := tenancyReconciler.Client.TenancyMultiTenancy().Config().Orgs("bar").
+			Folders(defaultName).GetProjects(context.Background(), "bar")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(project.Status.ProjectStatus.StatusIndicator).To(gomega.Equal(projectv1.StatusIndicationIdle))
 	})
 })
@@ -0,0 +1,244 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Events handled by the work queues.
+const (
+	eventOrgAdd                     = "OrgAdd"
+	eventOrgDelete                  = "OrgDelete"
+	eventOrgRetry                   = "OrgRetry"
+	eventOrgForceDelete             = "OrgForceDelete"
+	eventOrgActiveWatcherAdd        = "OrgActiveWatcherAdd"
+	eventOrgActiveWatcherUpdate     = "OrgActiveWatcherUpdate"
+	eventOrgActiveWatcherDelete     = "OrgActiveWatcherDelete"
+	eventOrgUsage                   = "OrgUsage"
+	eventOrgSync                    = "OrgSync"
+	eventProjectAdd                 = "ProjectAdd"
+	eventProjectDelete              = "ProjectDelete"
+	eventProjectRetry               = "ProjectRetry"
+	eventProjectForceDelete         = "ProjectForceDelete"
+	eventProjectMove                = "ProjectMove"
+	eventProjectActiveWatcherAdd    = "ProjectActiveWatcherAdd"
+	eventProjectActiveWatcherUpdate = "ProjectActiveWatcherUpdate"
+	eventProjectActiveWatcherDelete = "ProjectActiveWatcherDelete"
+	eventProjectSync                = "ProjectSync"
+	eventNetworkSync                = "NetworkSync"
+)
+
+// workKey identifies a queued event by the hash name of the object it was raised for.
+type workKey struct {
+	event    string
+	hashName string
+}
+
+func (k workKey) String() string {
+	return fmt.Sprintf("%s %s", k.event, k.hashName)
+}
+
+// task is the latest queued work for a workKey.
+type task struct {
+	// lock is the org or project the event belongs to. Tasks with the same lock never run concurrently.
+	lock string
+	// run returns an error when the task failed. It is retried with backoff if isRetryable(err).
+	run func() error
+	// fail, if set, is called once the task failed with an error that is not retryable, or the retries are exhausted.
+	fail func(err error)
+}
+
+// keyedQueue is a rate-limited work queue whose tasks are serialized per org or project.
+type keyedQueue struct {
+	name       string
+	queue      workqueue.TypedRateLimitingInterface[workKey]
+	tasks      sync.Map
+	locks      *keyedMutex
+	maxRetries int
+	// standby, while set, drops the tasks instead of queueing them.
+	standby *atomic.Bool
+}
+
+func newKeyedQueue(name string, locks *keyedMutex, standby *atomic.Bool,
+	baseDelay, maxDelay time.Duration, maxRetries int,
+) *keyedQueue {
+	return &keyedQueue{
+		name:    name,
+		standby: standby,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[workKey](baseDelay, maxDelay),
+			workqueue.TypedRateLimitingQueueConfig[workKey]{Name: name},
+		),
+		locks:      locks,
+		maxRetries: maxRetries,
+	}
+}
+
+// enqueue queues t under key, replacing any task for key that has not started yet.
+func (q *keyedQueue) enqueue(key workKey, t task) {
+	if q.standby.Load() {
+		return
+	}
+	q.tasks.Store(key, t)
+	q.queue.Add(key)
+}
+
+// run starts workers and blocks until ctx is done.
+func (q *keyedQueue) run(ctx context.Context, workers int) {
+	for i := 0; i < workers; i++ {
+		go func() {
+			for q.processNext() {
+			}
+		}()
+	}
+	<-ctx.Done()
+	q.queue.ShutDown()
+}
+
+func (q *keyedQueue) processNext() bool {
+	key, shutdown := q.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer q.queue.Done(key)
+
+	v, ok := q.tasks.LoadAndDelete(key)
+	if !ok {
+		q.queue.Forget(key)
+		return true
+	}
+	t, _ := v.(task)
+
+	unlock := q.locks.lock(t.lock)
+	err := t.run()
+	unlock()
+
+	if err == nil {
+		q.queue.Forget(key)
+		return true
+	}
+
+	retryable := isRetryable(err)
+	if retryable && q.queue.NumRequeues(key) < q.maxRetries {
+		log.InfraErr(err).Msgf("Processing %v in %s failed, retrying", key, q.name)
+		// A newer event for the same key takes precedence over the retry.
+		q.tasks.LoadOrStore(key, t)
+		q.queue.AddRateLimited(key)
+		return true
+	}
+
+	q.queue.Forget(key)
+	if retryable {
+		log.InfraErr(err).Msgf("Processing %v in %s failed after %d retries, giving up", key, q.name, q.maxRetries)
+	} else {
+		log.InfraErr(err).Msgf("Processing %v in %s failed, giving up", key, q.name)
+	}
+	if t.fail != nil {
+		unlock = q.locks.lock(t.lock)
+		t.fail(err)
+		unlock()
+	}
+	return true
+}
+
+// keyedMutex hands out one mutex per key, so that unrelated orgs and projects do not block each other.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	sync.Mutex
+	refs int
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: map[string]*keyLock{}}
+}
+
+// lock blocks until key is free and returns the function that releases it.
+func (k *keyedMutex) lock(key string) func() {
+	k.mu.Lock()
+	l, ok := k.locks[key]
+	if !ok {
+		l = &keyLock{}
+		k.locks[key] = l
+	}
+	l.refs++
+	k.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		k.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(k.locks, key)
+		}
+		k.mu.Unlock()
+	}
+}
+
+func orgLockKey(orgName string) string {
+	return "org/" + orgName
+}
+
+func projectLockKey(orgName, folderName, projectName string) string {
+	return "project/" + orgName + "/" + folderName + "/" + projectName
+}
+
+// networkLockKey derives the lock of a config Network from its labels.
+func networkLockKey(labels map[string]string) string {
+	return "network/" + labels["orgs.org.edge-orchestrator.intel.com"] + "/" +
+		labels["folders.folder.edge-orchestrator.intel.com"] + "/" +
+		labels["projects.project.edge-orchestrator.intel.com"] + "/" + labels["nexus/display_name"]
+}
+
+// projectWatcherLockKey derives the lock of the runtime Project a ProjectActiveWatcher belongs to from its labels.
+func projectWatcherLockKey(labels map[string]string) string {
+	return projectLockKey(labels["runtimeorgs.runtimeorg.edge-orchestrator.intel.com"],
+		labels["runtimefolders.runtimefolder.edge-orchestrator.intel.com"],
+		labels["runtimeprojects.runtimeproject.edge-orchestrator.intel.com"])
+}
+
+// isRetryable reports whether err is a transient API error that is worth retrying with backoff.
+func isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if apierrors.IsServerTimeout(err) || apierrors.IsTimeout(err) || apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) || apierrors.IsInternalError(err) || apierrors.IsConflict(err) {
+		return true
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package tenancy_test
+
+import (
+	"errors"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = ginkgo.Describe("Work queue", func() {
+	unavailable := apierrors.NewServiceUnavailable("etcd is unavailable")
+
+	ginkgo.It("should retry a task failing with a transient error until it succeeds", func() {
+		calls := 0
+		runs, err := tenancy.RunTask(func() error {
+			calls++
+			if calls < 3 {
+				return unavailable
+			}
+			return nil
+		}, 5)
+		gomega.Expect(runs).To(gomega.Equal(3))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("should give up on a task failing with a transient error once the retries are exhausted", func() {
+		runs, err := tenancy.RunTask(func() error { return unavailable }, 2)
+		gomega.Expect(runs).To(gomega.Equal(3))
+		gomega.Expect(err).To(gomega.MatchError(unavailable))
+	})
+
+	ginkgo.It("should not retry a task failing with an error that is not transient", func() {
+		forbidden := apierrors.NewForbidden(schema.GroupResource{Group: "org.edge-orchestrator.intel.com", Resource: "orgs"}, "acme", errors.New("denied"))
+		runs, err := tenancy.RunTask(func() error { return forbidden }, 5)
+		gomega.Expect(runs).To(gomega.Equal(1))
+		gomega.Expect(err).To(gomega.MatchError(forbidden))
+	})
+})
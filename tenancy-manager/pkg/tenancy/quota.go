@@ -0,0 +1,113 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	orgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+)
+
+// ProcessFoldersChange is the callback function to be invoked when a Folder is added or deleted.
+func (r *Reconciler) ProcessFoldersChange(folder *nexus_client.FolderFolder) {
+	r.updateUsage(folder.GetLabels()["orgs.org.edge-orchestrator.intel.com"])
+}
+
+// ProcessProjectsRemove is the callback function to be invoked once a deleted Project is removed.
+func (r *Reconciler) ProcessProjectsRemove(project *nexus_client.ProjectProject) {
+	r.updateUsage(project.GetLabels()["orgs.org.edge-orchestrator.intel.com"])
+}
+
+// ProcessNetworksChange is the callback function to be invoked when a Network is added or deleted.
+func (r *Reconciler) ProcessNetworksChange(network *nexus_client.NetworkNetwork) {
+	r.updateUsage(network.GetLabels()["orgs.org.edge-orchestrator.intel.com"])
+}
+
+// updateUsage queues the recount of the quota usage of the org of displayName.
+func (r *Reconciler) updateUsage(displayName string) {
+	if displayName == "" {
+		return
+	}
+	r.orgs.enqueue(workKey{event: eventOrgUsage, hashName: displayName}, task{
+		lock: orgLockKey(displayName),
+		run:  func() error { return r.processUsage(displayName) },
+		fail: func(err error) {
+			log.InfraErr(err).Msgf("Unable to update the quota usage of org %s", displayName)
+		},
+	})
+}
+
+// processUsage counts the folders, projects and networks of the org of displayName and reports them
+// in its status. It returns an error only for transient failures that should be retried.
+func (r *Reconciler) processUsage(displayName string) error {
+	ctx := context.Background()
+	org, err := getConfigOrg(r.Client, displayName)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !org.DeletionTimestamp.IsZero() && !Testing {
+		return nil
+	}
+	usage, err := countUsage(ctx, org)
+	if err != nil {
+		return err
+	}
+	status := org.Status.OrgStatus
+	if status.Usage == usage {
+		return nil
+	}
+	log.Debug().Msgf("Setting the quota usage of org %s to %d folders, %d projects and %d networks",
+		displayName, usage.Folders, usage.Projects, usage.Networks)
+	status.Usage = usage
+	if err := org.SetOrgStatus(ctx, &status); err != nil {
+		return fmt.Errorf("failed to set the quota usage of org %s: %w", displayName, err)
+	}
+	return nil
+}
+
+// countUsage counts the resources of org limited by its Quota. The default folder counts as a folder.
+func countUsage(ctx context.Context, org *nexus_client.OrgOrg) (orgsv1.QuotaUsage, error) {
+	usage := orgsv1.QuotaUsage{}
+	folders, err := org.GetAllFolders(ctx)
+	if err != nil {
+		return usage, err
+	}
+	for _, folder := range folders {
+		usage.Folders++
+		projects, err := folder.GetAllProjects(ctx)
+		if err != nil {
+			return usage, err
+		}
+		for _, project := range projects {
+			usage.Projects++
+			networks, err := project.GetAllNetworks(ctx)
+			if err != nil {
+				return usage, err
+			}
+			usage.Networks += int32(len(networks)) //nolint:gosec // Network counts fit in int32.
+		}
+	}
+	return usage, nil
+}
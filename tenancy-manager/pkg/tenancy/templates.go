@@ -0,0 +1,177 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package tenancy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	networkv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/network.edge-orchestrator.intel.com/v1"
+	projecttemplatev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projecttemplate.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TemplateAnnotation, on a config Project, names the ProjectTemplate expanded into it. A Project is expanded once,
+// so that later changes of its template never modify it, nor its import from a backup.
+const TemplateAnnotation = "tenancy-manager.edge-orchestrator.intel.com/template"
+
+// ErrQuotaExceeded is returned when expanding a ProjectTemplate would exceed the quota of the org of the Project.
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// ParametersAnnotationPrefix, followed by a watcher name, holds on a runtime Project the JSON object of the
+// parameters its template sets for the watcher.
+const ParametersAnnotationPrefix = "tenancy-manager.edge-orchestrator.intel.com/parameters."
+
+// WatcherParameters returns the parameters the template of the runtime Project obj sets for watcher, nil if none.
+func WatcherParameters(obj metav1.Object, watcher string) map[string]string {
+	value, ok := obj.GetAnnotations()[ParametersAnnotationPrefix+watcher]
+	if !ok {
+		return nil
+	}
+	var parameters map[string]string
+	if err := json.Unmarshal([]byte(value), &parameters); err != nil {
+		log.Warn().Msgf("Ignoring the invalid parameters of watcher %s on %s: %v", watcher, obj.GetName(), err)
+		return nil
+	}
+	return parameters
+}
+
+// templateParameters returns the parameters annotations of a config Project, to copy to its runtime Project.
+func templateParameters(project metav1.Object) map[string]string {
+	parameters := map[string]string{}
+	for key, value := range project.GetAnnotations() {
+		if strings.HasPrefix(key, ParametersAnnotationPrefix) {
+			parameters[key] = value
+		}
+	}
+	return parameters
+}
+
+// expandTemplate expands the ProjectTemplate named by a config Project into it, unless it was already: the Networks
+// of the template the Project does not have are added, the labels of the template are merged under those of the
+// Project, and the parameters of the watchers are recorded for the runtime Project. It returns the expanded Project,
+// or an ErrQuotaExceeded error, before any Network is added, if the Networks would exceed the quota of the org.
+func expandTemplate(ctx context.Context, client *nexus_client.Clientset,
+	project *nexus_client.ProjectProject,
+) (*nexus_client.ProjectProject, error) {
+	name := project.Spec.Template
+	annotations := project.GetAnnotations()
+	// A moved Project was expanded in its former Folder.
+	if name == "" || annotations[TemplateAnnotation] != "" || annotations[runtimeFolderAnnotation] != "" {
+		return project, nil
+	}
+	template, err := client.TenancyMultiTenancy().Config().GetProjectTemplates(ctx, name)
+	if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+		return nil, fmt.Errorf("project template %q %w", name, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to get project template %s: %w", name, err)
+	}
+
+	networks, err := getProjectNetworks(ctx, client, project)
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string]struct{}{}
+	for _, network := range networks {
+		existing[network.DisplayName()] = struct{}{}
+	}
+	var missing []projecttemplatev1.NetworkTemplate
+	for _, network := range template.Spec.Networks {
+		if _, ok := existing[network.Name]; !ok {
+			missing = append(missing, network)
+		}
+	}
+	if err := checkNetworksQuota(client, project, len(networks)+len(missing)); err != nil {
+		return nil, fmt.Errorf("unable to add the networks of project template %s: %w", name, err)
+	}
+	for _, network := range missing {
+		_, err := project.AddNetworks(ctx, &networkv1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: network.Name},
+			Spec: networkv1.NetworkSpec{
+				Type:        networkv1.NetworkType(network.Type),
+				Description: network.Description,
+			},
+		})
+		if err != nil && !nexus_client.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("unable to add network %s of project template %s: %w", network.Name, name, err)
+		}
+	}
+
+	labels, parameters, err := mergeTemplate(template.Spec, project.Spec.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("unable to expand project template %s: %w", name, err)
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	maps.Copy(annotations, parameters)
+	annotations[TemplateAnnotation] = name
+	project.SetAnnotations(annotations)
+	project.Spec.Labels = labels
+	if err := project.Update(ctx); err != nil {
+		return nil, fmt.Errorf("unable to record the expansion of project template %s: %w", name, err)
+	}
+	log.Info().Msgf("Expanded project template %s into project %s (hashName: %s)",
+		name, project.DisplayName(), project.Name)
+	return project, nil
+}
+
+// checkNetworksQuota returns an ErrQuotaExceeded error if the org of project limits its projects to fewer than
+// count networks.
+func checkNetworksQuota(client *nexus_client.Clientset, project *nexus_client.ProjectProject, count int) error {
+	orgName := project.GetLabels()["orgs.org.edge-orchestrator.intel.com"]
+	org, err := getConfigOrg(client, orgName)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get org %s: %w", orgName, err)
+	}
+	limit := org.Spec.Quota.MaxNetworksPerProject
+	if limit > 0 && count > int(limit) {
+		return fmt.Errorf("%w: project %s would have %d networks, org %s allows at most %d networks per project",
+			ErrQuotaExceeded, project.DisplayName(), count, orgName, limit)
+	}
+	return nil
+}
+
+// mergeTemplate returns the labels of a Project of labels once template is expanded into it, the labels of the
+// Project taking precedence, and the parameters annotations of its watchers.
+func mergeTemplate(template projecttemplatev1.ProjectTemplateSpec, labels map[string]string,
+) (map[string]string, map[string]string, error) {
+	merged := labels
+	if len(template.Labels) > 0 {
+		merged = maps.Clone(template.Labels)
+		maps.Copy(merged, labels)
+	}
+	parameters := map[string]string{}
+	for _, watcher := range template.WatcherParameters {
+		value, err := json.Marshal(watcher.Parameters)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to encode the parameters of watcher %s: %w", watcher.Watcher, err)
+		}
+		parameters[ParametersAnnotationPrefix+watcher.Watcher] = string(value)
+	}
+	return merged, parameters, nil
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package tenancy_test
+
+import (
+	"context"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	configv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/config.edge-orchestrator.intel.com/v1"
+	folderv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/folder.edge-orchestrator.intel.com/v1"
+	networkv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/network.edge-orchestrator.intel.com/v1"
+	orgv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
+	projecttemplatev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projecttemplate.edge-orchestrator.intel.com/v1"
+	tenancyv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/tenancy.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = ginkgo.Describe("Project templates", ginkgo.Ordered, func() {
+	ctx := context.Background()
+	var (
+		client   *nexus_client.Clientset
+		config   *nexus_client.ConfigConfig
+		folder   *nexus_client.FolderFolder
+		template *nexus_client.ProjecttemplateProjectTemplate
+	)
+
+	ginkgo.BeforeAll(func() {
+		// A client of its own keeps the reconciler of the suite off these projects.
+		client = nexus_client.NewFakeClient()
+		root, err := client.AddTenancyMultiTenancy(ctx, &tenancyv1.MultiTenancy{ObjectMeta: metav1.ObjectMeta{Name: defaultName}})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		config, err = root.AddConfig(ctx, &configv1.Config{ObjectMeta: metav1.ObjectMeta{Name: defaultName}})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		org, err := config.AddOrgs(ctx, &orgv1.Org{ObjectMeta: metav1.ObjectMeta{Name: "sprite"}})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		folder, err = org.AddFolders(ctx, &folderv1.Folder{ObjectMeta: metav1.ObjectMeta{Name: defaultName}})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		template, err = config.AddProjectTemplates(ctx, &projecttemplatev1.ProjectTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "edge"},
+			Spec: projecttemplatev1.ProjectTemplateSpec{
+				Networks: []projecttemplatev1.NetworkTemplate{{Name: "mesh", Type: string(networkv1.AppplicationMesh)}},
+				Labels:   map[string]string{"tier": "gold", "region": "eu"},
+				WatcherParameters: []projecttemplatev1.WatcherParameters{
+					{Watcher: "app-orch", Parameters: map[string]string{"registry": "internal"}},
+				},
+			},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("should expand the template into a project once", func() {
+		project, err := folder.AddProjects(ctx, &projectv1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "web"},
+			Spec:       projectv1.ProjectSpec{Template: "edge", Labels: map[string]string{"region": "us"}},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		project, err = tenancy.ExpandTemplate(ctx, client, project)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(project.Spec.Labels).To(gomega.Equal(map[string]string{"tier": "gold", "region": "us"}))
+		gomega.Expect(tenancy.TemplateParameters(project)).To(gomega.Equal(map[string]string{
+			tenancy.ParametersAnnotationPrefix + "app-orch": `{"registry":"internal"}`,
+		}))
+		gomega.Expect(tenancy.WatcherParameters(project, "app-orch")).To(gomega.Equal(map[string]string{"registry": "internal"}))
+		gomega.Expect(tenancy.WatcherParameters(project, "cluster-orchestrator")).To(gomega.BeNil())
+		network, err := project.GetNetworks(ctx, "mesh")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(network.Spec.Type).To(gomega.Equal(networkv1.AppplicationMesh))
+
+		// Changes of the template leave the projects created from it alone.
+		template.Spec.Labels = map[string]string{"tier": "silver"}
+		gomega.Expect(template.Update(ctx)).To(gomega.Succeed())
+		project, err = folder.GetProjects(ctx, "web")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		project, err = tenancy.ExpandTemplate(ctx, client, project)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(project.Spec.Labels).To(gomega.Equal(map[string]string{"tier": "gold", "region": "us"}))
+	})
+
+	ginkgo.It("should not expand a template over the network quota of the org", func() {
+		org, err := config.AddOrgs(ctx, &orgv1.Org{
+			ObjectMeta: metav1.ObjectMeta{Name: "pixie"},
+			Spec:       orgv1.OrgSpec{Quota: orgv1.Quota{MaxNetworksPerProject: 1}},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		folder, err := org.AddFolders(ctx, &folderv1.Folder{ObjectMeta: metav1.ObjectMeta{Name: defaultName}})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		project, err := folder.AddProjects(ctx, &projectv1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "ops"},
+			Spec:       projectv1.ProjectSpec{Template: "edge"},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = project.AddNetworks(ctx, &networkv1.Network{ObjectMeta: metav1.ObjectMeta{Name: "lan"}})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		_, err = tenancy.ExpandTemplate(ctx, client, project)
+		gomega.Expect(err).To(gomega.MatchError(tenancy.ErrQuotaExceeded))
+		gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("would have 2 networks")))
+		networks, err := project.GetAllNetworks(ctx)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(networks).To(gomega.HaveLen(1))
+	})
+
+	ginkgo.It("should leave a project without template alone", func() {
+		project, err := folder.AddProjects(ctx, &projectv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "api"}})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		project, err = tenancy.ExpandTemplate(ctx, client, project)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(project.Spec.Labels).To(gomega.BeEmpty())
+		gomega.Expect(tenancy.TemplateParameters(project)).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should fail for a missing template", func() {
+		project, err := folder.AddProjects(ctx, &projectv1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "db"},
+			Spec:       projectv1.ProjectSpec{Template: "core"},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = tenancy.ExpandTemplate(ctx, client, project)
+		gomega.Expect(err).To(gomega.MatchError(tenancy.ErrNotFound))
+		gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(`project template "core"`)))
+	})
+})
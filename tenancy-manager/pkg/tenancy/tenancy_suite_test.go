@@ -79,6 +79,7 @@ var _ = ginkgo.BeforeSuite(func() {
 	}
 
 	tenancyReconciler = tenancy.NewReconciler(nexusClient, conf)
+	go tenancyReconciler.Start(context.Background())
 	config := nexusClient.TenancyMultiTenancy().Config()
 
 	// Register all the Org and Project handlers to process the events.
@@ -0,0 +1,186 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tenancyctl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/yaml"
+)
+
+// Output formats.
+const (
+	FormatTable = "table"
+	FormatJSON  = "json"
+	FormatYAML  = "yaml"
+)
+
+// Print writes v, a result of this package or a slice of them, to w in format. Lists are tables of one line per
+// item, and single items are described field by field, with a table of their watchers.
+func Print(w io.Writer, format string, v any) error {
+	switch format {
+	case FormatJSON:
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", data)
+		return err
+	case FormatYAML:
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	case FormatTable:
+		return printTable(w, v)
+	}
+	return fmt.Errorf("unknown output format %q, expected %s, %s or %s", format, FormatTable, FormatJSON, FormatYAML)
+}
+
+func printTable(w io.Writer, v any) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	now := time.Now()
+	switch v := v.(type) {
+	case []Org:
+		fmt.Fprintln(tw, "NAME\tUID\tSTATUS\tWATCHERS\tAGE\tMESSAGE")
+		for _, org := range v {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", org.Name, org.UID, state(org.Status, org.Deleted),
+				acked(org.Watchers), age(org.Created, now), org.Message)
+		}
+	case Org:
+		fmt.Fprintf(tw, "Name:\t%s\n", v.Name)
+		fmt.Fprintf(tw, "UID:\t%s\n", v.UID)
+		fmt.Fprintf(tw, "Status:\t%s\n", state(v.Status, v.Deleted))
+		fmt.Fprintf(tw, "Message:\t%s\n", v.Message)
+		fmt.Fprintf(tw, "Suspended:\t%t\n", v.Suspended)
+		describeTimes(tw, v.Created, v.Updated, v.Deleted)
+		describeAcks(tw, v.Watchers)
+	case []Project:
+		fmt.Fprintln(tw, "FOLDER\tNAME\tUID\tSTATUS\tWATCHERS\tAGE\tMESSAGE")
+		for _, project := range v {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", project.Folder, project.Name, project.UID,
+				state(project.Status, project.Deleted), acked(project.Watchers), age(project.Created, now), project.Message)
+		}
+	case Project:
+		fmt.Fprintf(tw, "Name:\t%s\n", v.Name)
+		fmt.Fprintf(tw, "Org:\t%s\n", v.Org)
+		fmt.Fprintf(tw, "Folder:\t%s\n", v.Folder)
+		fmt.Fprintf(tw, "UID:\t%s\n", v.UID)
+		fmt.Fprintf(tw, "Status:\t%s\n", state(v.Status, v.Deleted))
+		fmt.Fprintf(tw, "Message:\t%s\n", v.Message)
+		fmt.Fprintf(tw, "Archived:\t%t\n", v.Archived)
+		describeTimes(tw, v.Created, v.Updated, v.Deleted)
+		describeAcks(tw, v.Watchers)
+	case []Watcher:
+		fmt.Fprintln(tw, "KIND\tNAME\tPHASE\tDEPENDS ON\tREQUIRED\tFAILURE POLICY\tRETRIES\tCREATE TIMEOUT\tDELETE TIMEOUT")
+		for _, watcher := range v {
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%t\t%s\t%d\t%s\t%s\n", watcher.Kind, watcher.Name, watcher.Phase,
+				orNone(strings.Join(watcher.DependsOn, ",")), watcher.Required, orNone(watcher.FailurePolicy),
+				watcher.Retries, seconds(watcher.CreateTimeoutInSecs), seconds(watcher.DeleteTimeoutInSecs))
+		}
+	default:
+		return fmt.Errorf("unable to print %T as a table", v)
+	}
+	return tw.Flush()
+}
+
+func describeTimes(w io.Writer, created metav1.Time, updated, deleted *metav1.Time) {
+	fmt.Fprintf(w, "Created:\t%s\n", timestamp(&created))
+	fmt.Fprintf(w, "Updated:\t%s\n", timestamp(updated))
+	if deleted != nil {
+		fmt.Fprintf(w, "Deleted:\t%s\n", timestamp(deleted))
+	}
+}
+
+func describeAcks(w io.Writer, acks []Ack) {
+	if len(acks) == 0 {
+		fmt.Fprintln(w, "Watchers:\t<none>")
+		return
+	}
+	fmt.Fprintln(w, "Watchers:")
+	fmt.Fprintln(w, "  WATCHER\tSTATUS\tTIME\tMESSAGE")
+	for _, ack := range acks {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", ack.Watcher, shortStatus(ack.Status), timestamp(ack.Time), ack.Message)
+	}
+}
+
+// state is the short status of a tenant, DELETING once it is deleted.
+func state(status string, deleted *metav1.Time) string {
+	if deleted != nil {
+		return "DELETING/" + shortStatus(status)
+	}
+	return shortStatus(status)
+}
+
+// shortStatus strips the STATUS_INDICATION_ prefix of a status indicator.
+func shortStatus(status string) string {
+	if status == "" {
+		return "<none>"
+	}
+	return strings.TrimPrefix(status, "STATUS_INDICATION_")
+}
+
+// acked counts the IDLE watchers of a tenant, out of those that acknowledged it.
+func acked(acks []Ack) string {
+	idle := 0
+	for _, ack := range acks {
+		if shortStatus(ack.Status) == "IDLE" {
+			idle++
+		}
+	}
+	return strconv.Itoa(idle) + "/" + strconv.Itoa(len(acks))
+}
+
+func age(created metav1.Time, now time.Time) string {
+	if created.IsZero() {
+		return "<unknown>"
+	}
+	return duration.HumanDuration(now.Sub(created.Time))
+}
+
+func timestamp(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return "<none>"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func seconds(secs int32) string {
+	if secs == 0 {
+		return "<default>"
+	}
+	return (time.Duration(secs) * time.Second).String()
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
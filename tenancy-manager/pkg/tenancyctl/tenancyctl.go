@@ -0,0 +1,442 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+/*
+Package tenancyctl reads the Orgs, Projects and watchers of the tenancy data model by display name, for operators,
+waits for a tenant to be IDLE and deletes tenants. It backs the tenancyctl command.
+*/
+package tenancyctl
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	orgv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// pollInterval is the interval at which WaitIdle checks the status of a tenant.
+const pollInterval = time.Second
+
+// Org is a config Org, with the acknowledgements of the watchers of its runtime Org.
+type Org struct {
+	Name      string       `json:"name"`
+	UID       string       `json:"uid,omitempty"`
+	Status    string       `json:"status"`
+	Message   string       `json:"message,omitempty"`
+	Suspended bool         `json:"suspended,omitempty"`
+	Created   metav1.Time  `json:"created"`
+	Updated   *metav1.Time `json:"updated,omitempty"`
+	Deleted   *metav1.Time `json:"deleted,omitempty"`
+	Watchers  []Ack        `json:"watchers,omitempty"`
+}
+
+// Project is a config Project, with the acknowledgements of the watchers of its runtime Project.
+type Project struct {
+	Org      string       `json:"org"`
+	Folder   string       `json:"folder"`
+	Name     string       `json:"name"`
+	UID      string       `json:"uid,omitempty"`
+	Status   string       `json:"status"`
+	Message  string       `json:"message,omitempty"`
+	Archived bool         `json:"archived,omitempty"`
+	Created  metav1.Time  `json:"created"`
+	Updated  *metav1.Time `json:"updated,omitempty"`
+	Deleted  *metav1.Time `json:"deleted,omitempty"`
+	Watchers []Ack        `json:"watchers,omitempty"`
+}
+
+// Ack is the acknowledgement of a watcher on a runtime Org or Project.
+type Ack struct {
+	Watcher string       `json:"watcher"`
+	Status  string       `json:"status"`
+	Message string       `json:"message,omitempty"`
+	Time    *metav1.Time `json:"time,omitempty"`
+}
+
+// Watcher is the registration of an org or project watcher.
+type Watcher struct {
+	Kind                string   `json:"kind"`
+	Name                string   `json:"name"`
+	Phase               int32    `json:"phase"`
+	DependsOn           []string `json:"dependsOn,omitempty"`
+	Required            bool     `json:"required"`
+	FailurePolicy       string   `json:"failurePolicy,omitempty"`
+	Retries             int32    `json:"retries,omitempty"`
+	CreateTimeoutInSecs int32    `json:"createTimeoutInSecs,omitempty"`
+	DeleteTimeoutInSecs int32    `json:"deleteTimeoutInSecs,omitempty"`
+}
+
+// Kinds of Watcher.
+const (
+	KindOrg     = "org"
+	KindProject = "project"
+)
+
+// ListOrgs returns the Orgs, sorted by name.
+func ListOrgs(ctx context.Context, client *nexus_client.Clientset) ([]Org, error) {
+	config, err := client.TenancyMultiTenancy().GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the config root: %w", err)
+	}
+	orgs, err := config.GetAllOrgs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list Orgs: %w", err)
+	}
+	result := make([]Org, 0, len(orgs))
+	for _, org := range orgs {
+		view, err := newOrg(ctx, client, org)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, view)
+	}
+	slices.SortFunc(result, func(a, b Org) int { return cmp.Compare(a.Name, b.Name) })
+	return result, nil
+}
+
+// GetOrg returns the Org of name.
+func GetOrg(ctx context.Context, client *nexus_client.Clientset, name string) (Org, error) {
+	org, err := client.TenancyMultiTenancy().Config().GetOrgs(ctx, name)
+	if err != nil {
+		return Org{}, notFound(err, "org "+name)
+	}
+	return newOrg(ctx, client, org)
+}
+
+func newOrg(ctx context.Context, client *nexus_client.Clientset, org *nexus_client.OrgOrg) (Org, error) {
+	status := org.Status.OrgStatus
+	view := Org{
+		Name:      org.DisplayName(),
+		UID:       status.UID,
+		Status:    string(status.StatusIndicator),
+		Message:   status.Message,
+		Suspended: org.Spec.Suspended,
+		Created:   org.CreationTimestamp,
+		Updated:   unixTime(status.TimeStamp),
+		Deleted:   org.DeletionTimestamp,
+	}
+	runtimeOrg, err := client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, org.DisplayName())
+	if isNotFound(err) {
+		return view, nil
+	}
+	if err != nil {
+		return Org{}, fmt.Errorf("unable to get the runtime Org of %s: %w", org.DisplayName(), err)
+	}
+	watchers, err := runtimeOrg.GetAllActiveWatchers(ctx)
+	if err != nil {
+		return Org{}, fmt.Errorf("unable to list the watchers of org %s: %w", org.DisplayName(), err)
+	}
+	for _, watcher := range watchers {
+		view.Watchers = append(view.Watchers, Ack{
+			Watcher: watcher.DisplayName(),
+			Status:  string(watcher.Spec.StatusIndicator),
+			Message: watcher.Spec.Message,
+			Time:    unixTime(watcher.Spec.TimeStamp),
+		})
+	}
+	slices.SortFunc(view.Watchers, func(a, b Ack) int { return cmp.Compare(a.Watcher, b.Watcher) })
+	return view, nil
+}
+
+// ListProjects returns the Projects of org, across its Folders, sorted by folder and name.
+func ListProjects(ctx context.Context, client *nexus_client.Clientset, org string) ([]Project, error) {
+	projects, err := getProjects(ctx, client, org)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Project, 0, len(projects))
+	for _, project := range projects {
+		view, err := newProject(ctx, client, org, project)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, view)
+	}
+	slices.SortFunc(result, func(a, b Project) int {
+		return cmp.Or(cmp.Compare(a.Folder, b.Folder), cmp.Compare(a.Name, b.Name))
+	})
+	return result, nil
+}
+
+// GetProject returns the Project of name in org, in whichever of its Folders.
+func GetProject(ctx context.Context, client *nexus_client.Clientset, org, name string) (Project, error) {
+	project, err := findProject(ctx, client, org, name)
+	if err != nil {
+		return Project{}, err
+	}
+	return newProject(ctx, client, org, project)
+}
+
+func getProjects(ctx context.Context, client *nexus_client.Clientset, org string) ([]*nexus_client.ProjectProject, error) {
+	configOrg, err := client.TenancyMultiTenancy().Config().GetOrgs(ctx, org)
+	if err != nil {
+		return nil, notFound(err, "org "+org)
+	}
+	folders, err := configOrg.GetAllFolders(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the Folders of org %s: %w", org, err)
+	}
+	var result []*nexus_client.ProjectProject
+	for _, folder := range folders {
+		projects, err := folder.GetAllProjects(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list the Projects of folder %s/%s: %w", org, folder.DisplayName(), err)
+		}
+		result = append(result, projects...)
+	}
+	return result, nil
+}
+
+// findProject returns the config Project of name in org. A Project being moved has two config Projects,
+// the former one being deleted: the other one is returned.
+func findProject(ctx context.Context, client *nexus_client.Clientset, org, name string,
+) (*nexus_client.ProjectProject, error) {
+	projects, err := getProjects(ctx, client, org)
+	if err != nil {
+		return nil, err
+	}
+	var found *nexus_client.ProjectProject
+	for _, project := range projects {
+		if project.DisplayName() != name {
+			continue
+		}
+		if found == nil || !found.DeletionTimestamp.IsZero() {
+			found = project
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("project %s/%s not found", org, name)
+	}
+	return found, nil
+}
+
+func newProject(ctx context.Context, client *nexus_client.Clientset, org string,
+	project *nexus_client.ProjectProject,
+) (Project, error) {
+	status := project.Status.ProjectStatus
+	view := Project{
+		Org:      org,
+		Folder:   project.GetLabels()["folders.folder.edge-orchestrator.intel.com"],
+		Name:     project.DisplayName(),
+		UID:      status.UID,
+		Status:   string(status.StatusIndicator),
+		Message:  status.Message,
+		Archived: project.Spec.Archived,
+		Created:  project.CreationTimestamp,
+		Updated:  unixTime(status.TimeStamp),
+		Deleted:  project.DeletionTimestamp,
+	}
+	runtimeProject, err := client.TenancyMultiTenancy().Runtime().Orgs(org).Folders(tenancy.RuntimeFolder(project)).
+		GetProjects(ctx, project.DisplayName())
+	if isNotFound(err) {
+		return view, nil
+	}
+	if err != nil {
+		return Project{}, fmt.Errorf("unable to get the runtime Project of %s/%s: %w", org, project.DisplayName(), err)
+	}
+	watchers, err := runtimeProject.GetAllActiveWatchers(ctx)
+	if err != nil {
+		return Project{}, fmt.Errorf("unable to list the watchers of project %s/%s: %w", org, project.DisplayName(), err)
+	}
+	for _, watcher := range watchers {
+		view.Watchers = append(view.Watchers, Ack{
+			Watcher: watcher.DisplayName(),
+			Status:  string(watcher.Spec.StatusIndicator),
+			Message: watcher.Spec.Message,
+			Time:    unixTime(watcher.Spec.TimeStamp),
+		})
+	}
+	slices.SortFunc(view.Watchers, func(a, b Ack) int { return cmp.Compare(a.Watcher, b.Watcher) })
+	return view, nil
+}
+
+// ListWatchers returns the registered org watchers, then the project watchers, each sorted by phase and name.
+func ListWatchers(ctx context.Context, client *nexus_client.Clientset) ([]Watcher, error) {
+	config, err := client.TenancyMultiTenancy().GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the config root: %w", err)
+	}
+	orgWatchers, err := config.GetAllOrgWatchers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list OrgWatchers: %w", err)
+	}
+	projectWatchers, err := config.GetAllProjectWatchers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list ProjectWatchers: %w", err)
+	}
+
+	orgs := make([]Watcher, 0, len(orgWatchers))
+	for _, watcher := range orgWatchers {
+		spec := watcher.Spec
+		orgs = append(orgs, Watcher{
+			Kind: KindOrg, Name: watcher.DisplayName(), Phase: spec.Phase, DependsOn: spec.DependsOn,
+			Required: spec.Required == nil || *spec.Required, FailurePolicy: string(spec.FailurePolicy),
+			Retries: spec.Retries, CreateTimeoutInSecs: spec.CreateTimeoutInSecs, DeleteTimeoutInSecs: spec.DeleteTimeoutInSecs,
+		})
+	}
+	projects := make([]Watcher, 0, len(projectWatchers))
+	for _, watcher := range projectWatchers {
+		spec := watcher.Spec
+		projects = append(projects, Watcher{
+			Kind: KindProject, Name: watcher.DisplayName(), Phase: spec.Phase, DependsOn: spec.DependsOn,
+			Required: spec.Required == nil || *spec.Required, FailurePolicy: string(spec.FailurePolicy),
+			Retries: spec.Retries, CreateTimeoutInSecs: spec.CreateTimeoutInSecs, DeleteTimeoutInSecs: spec.DeleteTimeoutInSecs,
+		})
+	}
+	byPhase := func(a, b Watcher) int { return cmp.Or(cmp.Compare(a.Phase, b.Phase), cmp.Compare(a.Name, b.Name)) }
+	slices.SortFunc(orgs, byPhase)
+	slices.SortFunc(projects, byPhase)
+	return append(orgs, projects...), nil
+}
+
+/*
+WaitIdle waits up to timeout for the Org of org to be IDLE or, if project is set, for its Project of project.
+It fails as soon as the tenant is in ERROR, with the message of its status, or once it is deleted.
+*/
+func WaitIdle(ctx context.Context, client *nexus_client.Clientset, org, project string, timeout time.Duration) error {
+	target := "org " + org
+	if project != "" {
+		target = "project " + org + "/" + project
+	}
+	status := func() (string, string, error) {
+		if project == "" {
+			view, err := GetOrg(ctx, client, org)
+			return view.Status, view.Message, err
+		}
+		configProject, err := findProject(ctx, client, org, project)
+		if err != nil {
+			return "", "", err
+		}
+		projectStatus := configProject.Status.ProjectStatus
+		return string(projectStatus.StatusIndicator), projectStatus.Message, nil
+	}
+
+	err := wait.PollUntilContextTimeout(ctx, pollInterval, timeout, true, func(context.Context) (bool, error) {
+		indicator, message, err := status()
+		if err != nil {
+			return false, err
+		}
+		switch indicator {
+		case string(orgv1.StatusIndicationIdle):
+			return true, nil
+		case string(orgv1.StatusIndicationError):
+			return false, fmt.Errorf("%s is in error: %s", target, message)
+		}
+		return false, nil
+	})
+	if wait.Interrupted(err) {
+		return fmt.Errorf("%s is not IDLE after %s", target, timeout)
+	}
+	return err
+}
+
+/*
+DeleteOrg deletes the Org of org. An org with Projects is not deleted, unless withProjects is set: its Projects
+are then deleted first, and the org once they are all gone, waiting up to timeout for them.
+*/
+func DeleteOrg(ctx context.Context, client *nexus_client.Clientset, org string, withProjects bool,
+	timeout time.Duration,
+) error {
+	projects, err := getProjects(ctx, client, org)
+	if err != nil {
+		return err
+	}
+	if len(projects) > 0 && !withProjects {
+		return fmt.Errorf("org %s has %d projects, delete them first", org, len(projects))
+	}
+	for _, project := range projects {
+		if err := project.Delete(ctx); err != nil && !isNotFound(err) {
+			return fmt.Errorf("unable to delete project %s/%s: %w", org, project.DisplayName(), err)
+		}
+	}
+	if err := waitProjectsDeleted(ctx, client, org, timeout); err != nil {
+		return err
+	}
+
+	configOrg, err := client.TenancyMultiTenancy().Config().GetOrgs(ctx, org)
+	if err != nil {
+		return notFound(err, "org "+org)
+	}
+	if err := configOrg.Delete(ctx); err != nil && !isNotFound(err) {
+		return fmt.Errorf("unable to delete org %s: %w", org, err)
+	}
+	return nil
+}
+
+// waitProjectsDeleted waits up to timeout for the Projects of org to be gone.
+func waitProjectsDeleted(ctx context.Context, client *nexus_client.Clientset, org string, timeout time.Duration) error {
+	var remaining []string
+	err := wait.PollUntilContextTimeout(ctx, pollInterval, timeout, true, func(context.Context) (bool, error) {
+		projects, err := getProjects(ctx, client, org)
+		if err != nil {
+			return false, err
+		}
+		remaining = remaining[:0]
+		for _, project := range projects {
+			remaining = append(remaining, fmt.Sprintf("%s (%s)", project.DisplayName(),
+				project.Status.ProjectStatus.Message))
+		}
+		return len(remaining) == 0, nil
+	})
+	if wait.Interrupted(err) {
+		return fmt.Errorf("projects of org %s not deleted after %s: %s", org, timeout, strings.Join(remaining, ", "))
+	}
+	return err
+}
+
+// DeleteProject deletes the Project of project in org.
+func DeleteProject(ctx context.Context, client *nexus_client.Clientset, org, project string) error {
+	configProject, err := findProject(ctx, client, org, project)
+	if err != nil {
+		return err
+	}
+	if err := configProject.Delete(ctx); err != nil && !isNotFound(err) {
+		return fmt.Errorf("unable to delete project %s/%s: %w", org, project, err)
+	}
+	return nil
+}
+
+func isNotFound(err error) bool {
+	return nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err)
+}
+
+// notFound returns a short error for a missing target, and wraps any other error.
+func notFound(err error, target string) error {
+	if isNotFound(err) {
+		return fmt.Errorf("%s not found", target)
+	}
+	return fmt.Errorf("unable to get %s: %w", target, err)
+}
+
+// unixTime converts the time of a status, in seconds since the epoch, unset if zero.
+func unixTime(seconds uint64) *metav1.Time {
+	if seconds == 0 || seconds > math.MaxInt64 {
+		return nil
+	}
+	t := metav1.NewTime(time.Unix(int64(seconds), 0).UTC())
+	return &t
+}
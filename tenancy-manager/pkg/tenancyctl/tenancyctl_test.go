@@ -0,0 +1,177 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package tenancyctl_test
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	folderv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/folder.edge-orchestrator.intel.com/v1"
+	orgv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/org.edge-orchestrator.intel.com/v1"
+	orgactivewatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgactivewatcher.edge-orchestrator.intel.com/v1"
+	orgwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgwatcher.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/project.edge-orchestrator.intel.com/v1"
+	projectwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectwatcher.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancyctl"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/watcher/watchertest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = ginkgo.Describe("Tenancyctl", ginkgo.Ordered, func() {
+	ctx := context.Background()
+	var client *nexus_client.Clientset
+
+	ginkgo.BeforeAll(func() {
+		harness, err := watchertest.NewHarness(ctx)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		client = harness.Client
+		config := client.TenancyMultiTenancy().Config()
+
+		_, err = config.AddOrgWatchers(ctx, &orgwatcherv1.OrgWatcher{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-orchestrator"},
+			Spec:       orgwatcherv1.OrgWatcherSpec{Phase: 2, DependsOn: []string{"keycloak-tenant-controller"}},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = config.AddOrgWatchers(ctx, &orgwatcherv1.OrgWatcher{
+			ObjectMeta: metav1.ObjectMeta{Name: "keycloak-tenant-controller"},
+			Spec:       orgwatcherv1.OrgWatcherSpec{Phase: 1, CreateTimeoutInSecs: 60},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = config.AddProjectWatchers(ctx, &projectwatcherv1.ProjectWatcher{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-orch"},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		org, err := config.AddOrgs(ctx, &orgv1.Org{
+			ObjectMeta: metav1.ObjectMeta{Name: "coke"},
+			Status: orgv1.OrgNexusStatus{OrgStatus: orgv1.OrgStatus{
+				StatusIndicator: orgv1.StatusIndicationIdle, Message: "Org coke CREATE is complete",
+				UID: "coke-uid", TimeStamp: 1700000000,
+			}},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		folder, err := org.AddFolders(ctx, &folderv1.Folder{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = folder.AddProjects(ctx, &projectv1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Status: projectv1.ProjectNexusStatus{ProjectStatus: projectv1.ProjectStatus{
+				StatusIndicator: projectv1.StatusIndicationError, Message: "Watcher app-orch failed",
+			}},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = config.AddOrgs(ctx, &orgv1.Org{ObjectMeta: metav1.ObjectMeta{Name: "pepsi"}})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		gomega.Expect(harness.AddOrg(ctx, "coke")).To(gomega.Succeed())
+		_, err = client.TenancyMultiTenancy().Runtime().Orgs("coke").AddActiveWatchers(ctx,
+			&orgactivewatcherv1.OrgActiveWatcher{
+				ObjectMeta: metav1.ObjectMeta{Name: "keycloak-tenant-controller", ResourceVersion: "1"},
+				Spec: orgactivewatcherv1.OrgActiveWatcherSpec{
+					StatusIndicator: orgactivewatcherv1.StatusIndicationIdle, Message: "Realm roles created",
+					TimeStamp: 1700000000,
+				},
+			})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("should list the orgs by display name, with the acknowledgements of their watchers", func() {
+		orgs, err := tenancyctl.ListOrgs(ctx, client)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(orgs).To(gomega.HaveLen(2))
+		gomega.Expect(orgs[0].Name).To(gomega.Equal("coke"))
+		gomega.Expect(orgs[0].UID).To(gomega.Equal("coke-uid"))
+		gomega.Expect(orgs[0].Updated.Unix()).To(gomega.Equal(int64(1700000000)))
+		gomega.Expect(orgs[0].Watchers).To(gomega.ConsistOf(gomega.And(
+			gomega.HaveField("Watcher", "keycloak-tenant-controller"),
+			gomega.HaveField("Status", string(orgactivewatcherv1.StatusIndicationIdle)),
+		)))
+		gomega.Expect(orgs[1].Name).To(gomega.Equal("pepsi"))
+		gomega.Expect(orgs[1].Watchers).To(gomega.BeEmpty())
+
+		var out bytes.Buffer
+		gomega.Expect(tenancyctl.Print(&out, tenancyctl.FormatTable, orgs)).To(gomega.Succeed())
+		gomega.Expect(out.String()).To(gomega.MatchRegexp(`coke\s+coke-uid\s+IDLE\s+1/1`))
+		out.Reset()
+		gomega.Expect(tenancyctl.Print(&out, tenancyctl.FormatJSON, orgs[0])).To(gomega.Succeed())
+		gomega.Expect(out.String()).To(gomega.ContainSubstring(`"uid": "coke-uid"`))
+		out.Reset()
+		gomega.Expect(tenancyctl.Print(&out, tenancyctl.FormatYAML, orgs[0])).To(gomega.Succeed())
+		gomega.Expect(out.String()).To(gomega.ContainSubstring("uid: coke-uid"))
+	})
+
+	ginkgo.It("should describe a project found in any folder of its org", func() {
+		project, err := tenancyctl.GetProject(ctx, client, "coke", "foo")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(project.Folder).To(gomega.Equal("default"))
+		gomega.Expect(project.Message).To(gomega.Equal("Watcher app-orch failed"))
+
+		var out bytes.Buffer
+		gomega.Expect(tenancyctl.Print(&out, tenancyctl.FormatTable, project)).To(gomega.Succeed())
+		gomega.Expect(out.String()).To(gomega.MatchRegexp(`Status:\s+ERROR`))
+		gomega.Expect(out.String()).To(gomega.MatchRegexp(`Watchers:\s+<none>`))
+
+		_, err = tenancyctl.GetProject(ctx, client, "coke", "bar")
+		gomega.Expect(err).To(gomega.MatchError("project coke/bar not found"))
+		_, err = tenancyctl.ListProjects(ctx, client, "fanta")
+		gomega.Expect(err).To(gomega.MatchError("org fanta not found"))
+	})
+
+	ginkgo.It("should list the watchers by kind and phase", func() {
+		watchers, err := tenancyctl.ListWatchers(ctx, client)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(watchers).To(gomega.HaveLen(3))
+		gomega.Expect(watchers[0].Name).To(gomega.Equal("keycloak-tenant-controller"))
+		gomega.Expect(watchers[1].Name).To(gomega.Equal("cluster-orchestrator"))
+		gomega.Expect(watchers[2].Kind).To(gomega.Equal(tenancyctl.KindProject))
+		gomega.Expect(watchers[2].Required).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("should wait for a tenant to be IDLE, and fail on ERROR", func() {
+		gomega.Expect(tenancyctl.WaitIdle(ctx, client, "coke", "", time.Second)).To(gomega.Succeed())
+		gomega.Expect(tenancyctl.WaitIdle(ctx, client, "coke", "foo", time.Second)).
+			To(gomega.MatchError(gomega.ContainSubstring("Watcher app-orch failed")))
+		gomega.Expect(tenancyctl.WaitIdle(ctx, client, "pepsi", "", 100*time.Millisecond)).
+			To(gomega.MatchError(gomega.ContainSubstring("is not IDLE after")))
+	})
+
+	ginkgo.It("should delete a project", func() {
+		gomega.Expect(tenancyctl.DeleteProject(ctx, client, "coke", "foo")).To(gomega.Succeed())
+		_, err := tenancyctl.GetProject(ctx, client, "coke", "foo")
+		gomega.Expect(err).To(gomega.MatchError("project coke/foo not found"))
+	})
+
+	ginkgo.It("should delete an org with projects only once they are deleted", func() {
+		folder, err := client.TenancyMultiTenancy().Config().Orgs("coke").GetFolders(ctx, "default")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = folder.AddProjects(ctx, &projectv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "bar"}})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		gomega.Expect(tenancyctl.DeleteOrg(ctx, client, "coke", false, time.Second)).
+			To(gomega.MatchError("org coke has 1 projects, delete them first"))
+		_, err = tenancyctl.GetProject(ctx, client, "coke", "bar")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		gomega.Expect(tenancyctl.DeleteOrg(ctx, client, "coke", true, time.Second)).To(gomega.Succeed())
+		_, err = tenancyctl.GetOrg(ctx, client, "coke")
+		gomega.Expect(err).To(gomega.MatchError("org coke not found"))
+	})
+})
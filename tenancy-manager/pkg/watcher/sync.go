@@ -0,0 +1,274 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	orgactivewatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgactivewatcher.edge-orchestrator.intel.com/v1"
+	projectactivewatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectactivewatcher.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	messageCreating = "Creating"
+	messageCreated  = "Created"
+)
+
+func isNotFound(err error) bool {
+	return nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err)
+}
+
+func safeUnixTime() uint64 {
+	t := time.Now().Unix()
+	if t < 0 {
+		return 0
+	}
+	return uint64(t)
+}
+
+/*
+syncOrg calls the handler for the runtime Org of k once the watcher ordering lets the service act on it.
+A create is skipped if the active watcher is IDLE, or in error and no retry was requested since.
+A delete is skipped once the active watcher is removed.
+*/
+func (w *Watcher) syncOrg(ctx context.Context, k key) error {
+	org, err := w.client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, k.org)
+	if isNotFound(err) {
+		w.forget(k)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Org: %w", err)
+	}
+	if !tenancy.WatcherReady(org, w.opts.Name) {
+		log.Debug().Msgf("Waiting for the turn of %s on %v", w.opts.Name, k)
+		return nil
+	}
+	active, err := org.GetActiveWatchers(ctx, w.opts.Name)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("unable to get OrgActiveWatcher: %w", err)
+	}
+	found := err == nil
+	retries := tenancy.WatcherRetries(org, w.opts.Name)
+	tenant := Tenant{Org: k.org, OrgUID: string(org.UID)}
+
+	if org.Spec.Deleted {
+		if !found {
+			w.forget(k)
+			return nil
+		}
+		if active.Spec.StatusIndicator == orgactivewatcherv1.StatusIndicationError && w.awaitingRetry(k, true, retries) {
+			return nil
+		}
+		if err := w.handler.OnOrgDelete(ctx, tenant); err != nil {
+			return err
+		}
+		if err := org.DeleteActiveWatchers(ctx, w.opts.Name); err != nil && !isNotFound(err) {
+			return fmt.Errorf("unable to delete OrgActiveWatcher: %w", err)
+		}
+		w.forget(k)
+		log.Info().Msgf("Deleted %v", k)
+		return nil
+	}
+
+	if found {
+		switch active.Spec.StatusIndicator {
+		case orgactivewatcherv1.StatusIndicationIdle:
+			return nil
+		case orgactivewatcherv1.StatusIndicationError:
+			if w.awaitingRetry(k, false, retries) {
+				return nil
+			}
+		}
+	}
+	if err := w.setOrgStatus(ctx, org, orgactivewatcherv1.StatusIndicationInProgress, messageCreating); err != nil {
+		return err
+	}
+	if err := w.handler.OnOrgCreate(ctx, tenant); err != nil {
+		return err
+	}
+	if err := w.setOrgStatus(ctx, org, orgactivewatcherv1.StatusIndicationIdle, messageCreated); err != nil {
+		return err
+	}
+	w.forget(k)
+	log.Info().Msgf("Created %v", k)
+	return nil
+}
+
+// setOrgStatus sets the status of the OrgActiveWatcher of the service on org, adding it if needed.
+func (w *Watcher) setOrgStatus(ctx context.Context, org *nexus_client.RuntimeorgRuntimeOrg,
+	status orgactivewatcherv1.ActiveWatcherStatus, msg string,
+) error {
+	spec := orgactivewatcherv1.OrgActiveWatcherSpec{StatusIndicator: status, Message: msg, TimeStamp: safeUnixTime()}
+	active, err := org.GetActiveWatchers(ctx, w.opts.Name)
+	if isNotFound(err) {
+		_, err = org.AddActiveWatchers(ctx, &orgactivewatcherv1.OrgActiveWatcher{
+			ObjectMeta: metav1.ObjectMeta{Name: w.opts.Name},
+			Spec:       spec,
+		})
+		if err != nil && !nexus_client.IsAlreadyExists(err) {
+			return fmt.Errorf("unable to add OrgActiveWatcher: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get OrgActiveWatcher: %w", err)
+	}
+	if active.Spec.StatusIndicator == status && active.Spec.Message == msg {
+		return nil
+	}
+	active.Spec = spec
+	if err := active.Update(ctx); err != nil {
+		return fmt.Errorf("unable to update OrgActiveWatcher: %w", err)
+	}
+	return nil
+}
+
+// failOrg reports in the OrgActiveWatcher that the handler gave up on the runtime Org of k.
+func (w *Watcher) failOrg(ctx context.Context, k key, cause error) error {
+	org, err := w.client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, k.org)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Org: %w", err)
+	}
+	w.recordFailure(k, org.Spec.Deleted, tenancy.WatcherRetries(org, w.opts.Name))
+	return w.setOrgStatus(ctx, org, orgactivewatcherv1.StatusIndicationError, cause.Error())
+}
+
+// syncProject is syncOrg for the runtime Project of k.
+func (w *Watcher) syncProject(ctx context.Context, k key) error {
+	project, err := w.client.TenancyMultiTenancy().Runtime().Orgs(k.org).Folders(k.folder).GetProjects(ctx, k.project)
+	if isNotFound(err) {
+		w.forget(k)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Project: %w", err)
+	}
+	if !tenancy.WatcherReady(project, w.opts.Name) {
+		log.Debug().Msgf("Waiting for the turn of %s on %v", w.opts.Name, k)
+		return nil
+	}
+	org, err := w.client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, k.org)
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Org: %w", err)
+	}
+	active, err := project.GetActiveWatchers(ctx, w.opts.Name)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("unable to get ProjectActiveWatcher: %w", err)
+	}
+	found := err == nil
+	retries := tenancy.WatcherRetries(project, w.opts.Name)
+	tenant := Tenant{
+		Org: k.org, OrgUID: string(org.UID),
+		Folder: k.folder, Project: k.project, ProjectUID: string(project.UID),
+		Parameters: tenancy.WatcherParameters(project, w.opts.Name),
+	}
+
+	if project.Spec.Deleted {
+		if !found {
+			w.forget(k)
+			return nil
+		}
+		if active.Spec.StatusIndicator == projectactivewatcherv1.StatusIndicationError && w.awaitingRetry(k, true, retries) {
+			return nil
+		}
+		if err := w.handler.OnProjectDelete(ctx, tenant); err != nil {
+			return err
+		}
+		if err := project.DeleteActiveWatchers(ctx, w.opts.Name); err != nil && !isNotFound(err) {
+			return fmt.Errorf("unable to delete ProjectActiveWatcher: %w", err)
+		}
+		w.forget(k)
+		log.Info().Msgf("Deleted %v", k)
+		return nil
+	}
+
+	if found {
+		switch active.Spec.StatusIndicator {
+		case projectactivewatcherv1.StatusIndicationIdle:
+			return nil
+		case projectactivewatcherv1.StatusIndicationError:
+			if w.awaitingRetry(k, false, retries) {
+				return nil
+			}
+		}
+	}
+	if err := w.setProjectStatus(ctx, project, projectactivewatcherv1.StatusIndicationInProgress, messageCreating); err != nil {
+		return err
+	}
+	if err := w.handler.OnProjectCreate(ctx, tenant); err != nil {
+		return err
+	}
+	if err := w.setProjectStatus(ctx, project, projectactivewatcherv1.StatusIndicationIdle, messageCreated); err != nil {
+		return err
+	}
+	w.forget(k)
+	log.Info().Msgf("Created %v", k)
+	return nil
+}
+
+// setProjectStatus sets the status of the ProjectActiveWatcher of the service on project, adding it if needed.
+func (w *Watcher) setProjectStatus(ctx context.Context, project *nexus_client.RuntimeprojectRuntimeProject,
+	status projectactivewatcherv1.ActiveWatcherStatus, msg string,
+) error {
+	spec := projectactivewatcherv1.ProjectActiveWatcherSpec{StatusIndicator: status, Message: msg, TimeStamp: safeUnixTime()}
+	active, err := project.GetActiveWatchers(ctx, w.opts.Name)
+	if isNotFound(err) {
+		_, err = project.AddActiveWatchers(ctx, &projectactivewatcherv1.ProjectActiveWatcher{
+			ObjectMeta: metav1.ObjectMeta{Name: w.opts.Name},
+			Spec:       spec,
+		})
+		if err != nil && !nexus_client.IsAlreadyExists(err) {
+			return fmt.Errorf("unable to add ProjectActiveWatcher: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get ProjectActiveWatcher: %w", err)
+	}
+	if active.Spec.StatusIndicator == status && active.Spec.Message == msg {
+		return nil
+	}
+	active.Spec = spec
+	if err := active.Update(ctx); err != nil {
+		return fmt.Errorf("unable to update ProjectActiveWatcher: %w", err)
+	}
+	return nil
+}
+
+// failProject reports in the ProjectActiveWatcher that the handler gave up on the runtime Project of k.
+func (w *Watcher) failProject(ctx context.Context, k key, cause error) error {
+	project, err := w.client.TenancyMultiTenancy().Runtime().Orgs(k.org).Folders(k.folder).GetProjects(ctx, k.project)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Project: %w", err)
+	}
+	w.recordFailure(k, project.Spec.Deleted, tenancy.WatcherRetries(project, w.opts.Name))
+	return w.setProjectStatus(ctx, project, projectactivewatcherv1.StatusIndicationError, cause.Error())
+}
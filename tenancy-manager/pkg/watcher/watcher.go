@@ -0,0 +1,352 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+/*
+Package watcher runs a service as an Org and Project watcher of the Tenancy Manager.
+
+The service implements Handler, and the Watcher registers it as an OrgWatcher and ProjectWatcher, adds its active
+watcher to every runtime Org and Project, reports the outcome of the handler in it, retries the handler on errors,
+waits for its turn in the watcher ordering, and removes its active watcher once the delete is handled.
+*/
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	orgwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgwatcher.edge-orchestrator.intel.com/v1"
+	projectwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectwatcher.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	defaultWorkers        = 4
+	defaultMaxRetries     = 10
+	defaultRetryBaseDelay = 500 * time.Millisecond
+	defaultRetryMaxDelay  = time.Minute
+)
+
+var log = logging.GetLogger("tenancy-watcher")
+
+// Tenant is the Org or Project a Handler is called for. The UIDs are those of the runtime objects,
+// which are stable for the lifetime of the Org or Project.
+type Tenant struct {
+	Org    string
+	OrgUID string
+	// Folder, Project and ProjectUID are empty for an Org.
+	Folder     string
+	Project    string
+	ProjectUID string
+	// Parameters are those the ProjectTemplate of the Project sets for the watcher, nil if none.
+	Parameters map[string]string
+}
+
+// Handler provisions and removes what a service keeps for each Org and Project.
+// A handler may be called again for a Tenant it already handled, after a restart or on a retry
+// requested by the Tenancy Manager, and must be idempotent. An error is retried with backoff,
+// then reported to the Tenancy Manager.
+type Handler interface {
+	OnOrgCreate(ctx context.Context, tenant Tenant) error
+	OnOrgDelete(ctx context.Context, tenant Tenant) error
+	OnProjectCreate(ctx context.Context, tenant Tenant) error
+	OnProjectDelete(ctx context.Context, tenant Tenant) error
+}
+
+// Options configure a Watcher.
+type Options struct {
+	// Name of the OrgWatcher, ProjectWatcher and active watchers of the service.
+	Name string
+	// Orgs and Projects are the ordering and failure handling the service registers with for Orgs and Projects.
+	// A nil spec leaves that kind unwatched.
+	Orgs     *orgwatcherv1.OrgWatcherSpec
+	Projects *projectwatcherv1.ProjectWatcherSpec
+	// Workers calling the handler concurrently. Calls for the same Org or Project never run concurrently.
+	Workers int
+	// MaxRetries of a handler error, with exponential backoff between the two delays.
+	MaxRetries     int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+}
+
+func (o *Options) applyDefaults() {
+	if o.Workers <= 0 {
+		o.Workers = defaultWorkers
+	}
+	if o.MaxRetries <= 0 {
+		o.MaxRetries = defaultMaxRetries
+	}
+	if o.RetryBaseDelay <= 0 {
+		o.RetryBaseDelay = defaultRetryBaseDelay
+	}
+	if o.RetryMaxDelay <= 0 {
+		o.RetryMaxDelay = defaultRetryMaxDelay
+	}
+}
+
+// key identifies a runtime Org, or a runtime Project if project is set.
+type key struct {
+	org     string
+	folder  string
+	project string
+}
+
+func (k key) String() string {
+	if k.project == "" {
+		return "org " + k.org
+	}
+	return fmt.Sprintf("project %s/%s/%s", k.org, k.folder, k.project)
+}
+
+// Watcher calls a Handler for the creates and deletes of the runtime Orgs and Projects.
+type Watcher struct {
+	client  *nexus_client.Clientset
+	handler Handler
+	opts    Options
+	queue   workqueue.TypedRateLimitingInterface[key]
+
+	mu sync.Mutex
+	// failedAt holds, for the Orgs and Projects whose active watcher is in error, the count of retries
+	// requested by the Tenancy Manager when it failed. The handler is called again only on a new retry.
+	failedAt map[failure]int
+}
+
+// failure is a create, or a delete, for which the handler gave up.
+type failure struct {
+	key
+	deleting bool
+}
+
+// New returns a Watcher calling handler for the Orgs and Projects of client.
+func New(client *nexus_client.Clientset, handler Handler, opts Options) *Watcher {
+	opts.applyDefaults()
+	return &Watcher{
+		client:  client,
+		handler: handler,
+		opts:    opts,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[key](opts.RetryBaseDelay, opts.RetryMaxDelay),
+			workqueue.TypedRateLimitingQueueConfig[key]{Name: opts.Name},
+		),
+		failedAt: map[failure]int{},
+	}
+}
+
+/*
+Run registers the watcher, subscribes to the runtime Orgs and Projects, and calls the handler until ctx is done.
+The Orgs and Projects that exist on startup are handled as if they were just added, which skips those
+already handled. The client must be subscribed to the data model.
+*/
+func (w *Watcher) Run(ctx context.Context) error {
+	if err := w.register(ctx); err != nil {
+		return err
+	}
+	runtime := w.client.TenancyMultiTenancy().Runtime()
+	if w.opts.Orgs != nil {
+		if _, err := runtime.Orgs("*").RegisterAddCallback(w.orgAdded); err != nil {
+			return fmt.Errorf("unable to register the add callback of runtime Orgs: %w", err)
+		}
+		if _, err := runtime.Orgs("*").RegisterUpdateCallback(w.orgUpdated); err != nil {
+			return fmt.Errorf("unable to register the update callback of runtime Orgs: %w", err)
+		}
+	}
+	if w.opts.Projects != nil {
+		if _, err := runtime.Orgs("*").Folders("*").Projects("*").RegisterAddCallback(w.projectAdded); err != nil {
+			return fmt.Errorf("unable to register the add callback of runtime Projects: %w", err)
+		}
+		if _, err := runtime.Orgs("*").Folders("*").Projects("*").RegisterUpdateCallback(w.projectUpdated); err != nil {
+			return fmt.Errorf("unable to register the update callback of runtime Projects: %w", err)
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		w.queue.ShutDown()
+	}()
+	var wg sync.WaitGroup
+	for range w.opts.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for w.processNext(ctx) {
+			}
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+// register adds the OrgWatcher and ProjectWatcher of the service, or updates their spec if they exist.
+func (w *Watcher) register(ctx context.Context) error {
+	cfg := w.client.TenancyMultiTenancy().Config()
+	if w.opts.Orgs != nil {
+		existing, err := cfg.GetOrgWatchers(ctx, w.opts.Name)
+		switch {
+		case err == nil:
+			existing.Spec = *w.opts.Orgs
+			err = existing.Update(ctx)
+		case nexus_client.IsChildNotFound(err) || nexus_client.IsNotFound(err):
+			_, err = cfg.AddOrgWatchers(ctx, &orgwatcherv1.OrgWatcher{
+				ObjectMeta: metav1.ObjectMeta{Name: w.opts.Name},
+				Spec:       *w.opts.Orgs,
+			})
+		}
+		if err != nil && !nexus_client.IsAlreadyExists(err) {
+			return fmt.Errorf("unable to register OrgWatcher %s: %w", w.opts.Name, err)
+		}
+		log.Info().Msgf("OrgWatcher %s is registered", w.opts.Name)
+	}
+	if w.opts.Projects != nil {
+		existing, err := cfg.GetProjectWatchers(ctx, w.opts.Name)
+		switch {
+		case err == nil:
+			existing.Spec = *w.opts.Projects
+			err = existing.Update(ctx)
+		case nexus_client.IsChildNotFound(err) || nexus_client.IsNotFound(err):
+			_, err = cfg.AddProjectWatchers(ctx, &projectwatcherv1.ProjectWatcher{
+				ObjectMeta: metav1.ObjectMeta{Name: w.opts.Name},
+				Spec:       *w.opts.Projects,
+			})
+		}
+		if err != nil && !nexus_client.IsAlreadyExists(err) {
+			return fmt.Errorf("unable to register ProjectWatcher %s: %w", w.opts.Name, err)
+		}
+		log.Info().Msgf("ProjectWatcher %s is registered", w.opts.Name)
+	}
+	return nil
+}
+
+// Deregister removes the OrgWatcher and ProjectWatcher of the service, so that the Tenancy Manager
+// no longer waits for it. It is meant for a service being uninstalled, not for every shutdown.
+func (w *Watcher) Deregister(ctx context.Context) error {
+	cfg := w.client.TenancyMultiTenancy().Config()
+	var errs []error
+	if w.opts.Orgs != nil {
+		err := cfg.DeleteOrgWatchers(ctx, w.opts.Name)
+		if err != nil && !nexus_client.IsChildNotFound(err) && !nexus_client.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("unable to deregister OrgWatcher %s: %w", w.opts.Name, err))
+		}
+	}
+	if w.opts.Projects != nil {
+		err := cfg.DeleteProjectWatchers(ctx, w.opts.Name)
+		if err != nil && !nexus_client.IsChildNotFound(err) && !nexus_client.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("unable to deregister ProjectWatcher %s: %w", w.opts.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (w *Watcher) orgAdded(org *nexus_client.RuntimeorgRuntimeOrg) {
+	w.queue.Add(key{org: org.DisplayName()})
+}
+
+func (w *Watcher) orgUpdated(_, org *nexus_client.RuntimeorgRuntimeOrg) {
+	w.queue.Add(key{org: org.DisplayName()})
+}
+
+func (w *Watcher) projectAdded(project *nexus_client.RuntimeprojectRuntimeProject) {
+	w.queue.Add(projectKey(project))
+}
+
+func (w *Watcher) projectUpdated(_, project *nexus_client.RuntimeprojectRuntimeProject) {
+	w.queue.Add(projectKey(project))
+}
+
+func projectKey(project *nexus_client.RuntimeprojectRuntimeProject) key {
+	return key{
+		org:     project.GetLabels()["runtimeorgs.runtimeorg.edge-orchestrator.intel.com"],
+		folder:  project.GetLabels()["runtimefolders.runtimefolder.edge-orchestrator.intel.com"],
+		project: project.DisplayName(),
+	}
+}
+
+// processNext calls the handler for the next Org or Project. Errors are retried with backoff up to MaxRetries,
+// then reported in the active watcher.
+func (w *Watcher) processNext(ctx context.Context) bool {
+	k, shutdown := w.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer w.queue.Done(k)
+
+	var err error
+	if k.project == "" {
+		err = w.syncOrg(ctx, k)
+	} else {
+		err = w.syncProject(ctx, k)
+	}
+	switch {
+	case err == nil:
+		w.queue.Forget(k)
+	case w.queue.NumRequeues(k) < w.opts.MaxRetries:
+		log.Debug().Msgf("Retrying %v after error: %v", k, err)
+		w.queue.AddRateLimited(k)
+	default:
+		log.InfraErr(err).Msgf("Giving up on %v after %d retries", k, w.opts.MaxRetries)
+		w.queue.Forget(k)
+		if k.project == "" {
+			err = w.failOrg(ctx, k, err)
+		} else {
+			err = w.failProject(ctx, k, err)
+		}
+		if err != nil {
+			log.InfraErr(err).Msgf("Unable to report the failure of %v", k)
+		}
+	}
+	return true
+}
+
+// recordFailure records that the handler gave up on the create or delete of k, after the Tenancy Manager
+// requested retries retries of it.
+func (w *Watcher) recordFailure(k key, deleting bool, retries int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.failedAt[failure{key: k, deleting: deleting}] = retries
+}
+
+/*
+awaitingRetry reports whether the create or delete of k, whose active watcher is in error, waits for a retry
+requested by the Tenancy Manager. A create found in error with no failure recorded, as after a restart, waits
+for the next retry. A delete does not, as the error may be that of the create.
+*/
+func (w *Watcher) awaitingRetry(k key, deleting bool, retries int) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	at, known := w.failedAt[failure{key: k, deleting: deleting}]
+	if !known {
+		if deleting {
+			return false
+		}
+		w.failedAt[failure{key: k}] = retries
+		return true
+	}
+	return retries <= at
+}
+
+// forget drops the failures recorded for k.
+func (w *Watcher) forget(k key) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.failedAt, failure{key: k})
+	delete(w.failedAt, failure{key: k, deleting: true})
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package watcher_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	orgwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/orgwatcher.edge-orchestrator.intel.com/v1"
+	projectwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectwatcher.edge-orchestrator.intel.com/v1"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/watcher"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/watcher/watchertest"
+)
+
+const (
+	watcherName = "test-watcher"
+
+	pollingInterval = 50 * time.Millisecond
+	timeoutInterval = 10 * time.Second
+)
+
+var (
+	harness *watchertest.Harness
+	handler *fakeHandler
+	cancel  context.CancelFunc
+)
+
+var _ = ginkgo.BeforeSuite(func() {
+	var (
+		ctx context.Context
+		err error
+	)
+	ctx, cancel = context.WithCancel(context.Background())
+	harness, err = watchertest.NewHarness(ctx)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	handler = newFakeHandler()
+	w := watcher.New(harness.Client, handler, watcher.Options{
+		Name:           watcherName,
+		Orgs:           &orgwatcherv1.OrgWatcherSpec{Phase: 1, DependsOn: []string{"keycloak-tenant-controller"}},
+		Projects:       &projectwatcherv1.ProjectWatcherSpec{Phase: 1},
+		MaxRetries:     2,
+		RetryBaseDelay: 10 * time.Millisecond,
+		RetryMaxDelay:  50 * time.Millisecond,
+	})
+	go func() {
+		defer ginkgo.GinkgoRecover()
+		gomega.Expect(w.Run(ctx)).To(gomega.Succeed())
+	}()
+})
+
+var _ = ginkgo.AfterSuite(func() {
+	cancel()
+})
+
+func TestWatcher(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Watcher Suite")
+}
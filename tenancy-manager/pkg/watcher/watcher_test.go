@@ -0,0 +1,188 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package watcher_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/watcher"
+)
+
+const (
+	statusInProgress = "STATUS_INDICATION_IN_PROGRESS"
+	statusIdle       = "STATUS_INDICATION_IDLE"
+	statusError      = "STATUS_INDICATION_ERROR"
+)
+
+// fakeHandler records the calls of the watcher and fails those of the tenants it is told to.
+type fakeHandler struct {
+	mu       sync.Mutex
+	calls    map[string][]watcher.Tenant
+	failures map[string]int
+}
+
+func newFakeHandler() *fakeHandler {
+	return &fakeHandler{calls: map[string][]watcher.Tenant{}, failures: map[string]int{}}
+}
+
+func (h *fakeHandler) record(call string, tenant watcher.Tenant) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	name := tenant.Org
+	if tenant.Project != "" {
+		name = tenant.Project
+	}
+	h.calls[call+" "+name] = append(h.calls[call+" "+name], tenant)
+	if h.failures[name] > 0 {
+		h.failures[name]--
+		return errors.New("provider unavailable")
+	}
+	return nil
+}
+
+// fail makes the next count calls for the org or project name fail.
+func (h *fakeHandler) fail(name string, count int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.failures[name] = count
+}
+
+func (h *fakeHandler) called(call, name string) []watcher.Tenant {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]watcher.Tenant(nil), h.calls[call+" "+name]...)
+}
+
+func (h *fakeHandler) OnOrgCreate(_ context.Context, tenant watcher.Tenant) error {
+	return h.record("OnOrgCreate", tenant)
+}
+
+func (h *fakeHandler) OnOrgDelete(_ context.Context, tenant watcher.Tenant) error {
+	return h.record("OnOrgDelete", tenant)
+}
+
+func (h *fakeHandler) OnProjectCreate(_ context.Context, tenant watcher.Tenant) error {
+	return h.record("OnProjectCreate", tenant)
+}
+
+func (h *fakeHandler) OnProjectDelete(_ context.Context, tenant watcher.Tenant) error {
+	return h.record("OnProjectDelete", tenant)
+}
+
+var _ = ginkgo.Describe("Watcher", func() {
+	ctx := context.Background()
+
+	orgStatus := func(org string) func() string {
+		return func() string {
+			status, _, err := harness.OrgWatcherStatus(ctx, org, watcherName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return status
+		}
+	}
+	projectStatus := func(org, project string) func() string {
+		return func() string {
+			status, _, err := harness.ProjectWatcherStatus(ctx, org, "default", project, watcherName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return status
+		}
+	}
+
+	ginkgo.It("should register as an org and project watcher with its ordering", func() {
+		gomega.Eventually(func() []string {
+			orgWatcher, err := harness.Client.TenancyMultiTenancy().Config().GetOrgWatchers(ctx, watcherName)
+			if err != nil {
+				return nil
+			}
+			return orgWatcher.Spec.DependsOn
+		}, timeoutInterval, pollingInterval).Should(gomega.Equal([]string{"keycloak-tenant-controller"}))
+		gomega.Eventually(func() error {
+			_, err := harness.Client.TenancyMultiTenancy().Config().GetProjectWatchers(ctx, watcherName)
+			return err
+		}, timeoutInterval, pollingInterval).Should(gomega.Succeed())
+	})
+
+	ginkgo.It("should create and delete an org", func() {
+		gomega.Expect(harness.AddOrg(ctx, "acme")).To(gomega.Succeed())
+		gomega.Eventually(orgStatus("acme"), timeoutInterval, pollingInterval).Should(gomega.Equal(statusIdle))
+		calls := handler.called("OnOrgCreate", "acme")
+		gomega.Expect(calls).To(gomega.HaveLen(1))
+		gomega.Expect(calls[0].OrgUID).NotTo(gomega.BeEmpty())
+
+		gomega.Expect(harness.DeleteOrg(ctx, "acme")).To(gomega.Succeed())
+		gomega.Eventually(orgStatus("acme"), timeoutInterval, pollingInterval).Should(gomega.BeEmpty())
+		gomega.Expect(handler.called("OnOrgDelete", "acme")).To(gomega.HaveLen(1))
+		gomega.Expect(handler.called("OnOrgCreate", "acme")).To(gomega.HaveLen(1))
+	})
+
+	ginkgo.It("should wait for its turn in the watcher ordering", func() {
+		gomega.Expect(harness.AddOrg(ctx, "initech", "keycloak-tenant-controller")).To(gomega.Succeed())
+		gomega.Consistently(orgStatus("initech"), "500ms", pollingInterval).Should(gomega.BeEmpty())
+		gomega.Expect(handler.called("OnOrgCreate", "initech")).To(gomega.BeEmpty())
+
+		gomega.Expect(harness.SetReadyWatchers(ctx, "initech", "keycloak-tenant-controller", watcherName)).To(gomega.Succeed())
+		gomega.Eventually(orgStatus("initech"), timeoutInterval, pollingInterval).Should(gomega.Equal(statusIdle))
+	})
+
+	ginkgo.It("should report a failure once the retries are exhausted, and handle a retry", func() {
+		handler.fail("globex", 3)
+		gomega.Expect(harness.AddOrg(ctx, "globex")).To(gomega.Succeed())
+		gomega.Eventually(orgStatus("globex"), timeoutInterval, pollingInterval).Should(gomega.Equal(statusError))
+		_, msg, err := harness.OrgWatcherStatus(ctx, "globex", watcherName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(msg).To(gomega.Equal("provider unavailable"))
+		gomega.Expect(handler.called("OnOrgCreate", "globex")).To(gomega.HaveLen(3))
+
+		gomega.Consistently(orgStatus("globex"), "300ms", pollingInterval).Should(gomega.Equal(statusError))
+		gomega.Expect(harness.RetryOrg(ctx, "globex", watcherName)).To(gomega.Succeed())
+		gomega.Eventually(orgStatus("globex"), timeoutInterval, pollingInterval).Should(gomega.Equal(statusIdle))
+		gomega.Expect(handler.called("OnOrgCreate", "globex")).To(gomega.HaveLen(4))
+	})
+
+	ginkgo.It("should create and delete a project", func() {
+		gomega.Expect(harness.AddOrg(ctx, "umbrella")).To(gomega.Succeed())
+		gomega.Expect(harness.AddProject(ctx, "umbrella", "default", "hive")).To(gomega.Succeed())
+		gomega.Eventually(projectStatus("umbrella", "hive"), timeoutInterval, pollingInterval).Should(gomega.Equal(statusIdle))
+		calls := handler.called("OnProjectCreate", "hive")
+		gomega.Expect(calls).To(gomega.HaveLen(1))
+		gomega.Expect(calls[0].Org).To(gomega.Equal("umbrella"))
+		gomega.Expect(calls[0].Folder).To(gomega.Equal("default"))
+		gomega.Expect(calls[0].OrgUID).NotTo(gomega.BeEmpty())
+		gomega.Expect(calls[0].ProjectUID).NotTo(gomega.BeEmpty())
+
+		gomega.Expect(harness.DeleteProject(ctx, "umbrella", "default", "hive")).To(gomega.Succeed())
+		gomega.Eventually(projectStatus("umbrella", "hive"), timeoutInterval, pollingInterval).Should(gomega.BeEmpty())
+		gomega.Expect(handler.called("OnProjectDelete", "hive")).To(gomega.HaveLen(1))
+	})
+
+	ginkgo.It("should pass the parameters the template of a project sets for it", func() {
+		gomega.Expect(harness.AddOrg(ctx, "cyberdyne")).To(gomega.Succeed())
+		gomega.Expect(harness.AddProjectWithParameters(ctx, "cyberdyne", "default", "skynet", map[string]map[string]string{
+			watcherName: {"tier": "gold"},
+			"app-orch":  {"registry": "internal"},
+		})).To(gomega.Succeed())
+		gomega.Eventually(projectStatus("cyberdyne", "skynet"), timeoutInterval, pollingInterval).Should(gomega.Equal(statusIdle))
+		calls := handler.called("OnProjectCreate", "skynet")
+		gomega.Expect(calls).To(gomega.HaveLen(1))
+		gomega.Expect(calls[0].Parameters).To(gomega.Equal(map[string]string{"tier": "gold"}))
+		gomega.Expect(handler.called("OnProjectCreate", "hive")[0].Parameters).To(gomega.BeNil())
+	})
+})
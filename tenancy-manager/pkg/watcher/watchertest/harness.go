@@ -0,0 +1,212 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package watchertest provides a fake data model to unit test services built on package watcher.
+package watchertest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	configv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/config.edge-orchestrator.intel.com/v1"
+	runtimev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtime.edge-orchestrator.intel.com/v1"
+	runtimefoldersv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimefolder.edge-orchestrator.intel.com/v1"
+	runtimeorgsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimeorg.edge-orchestrator.intel.com/v1"
+	runtimeprojectsv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimeproject.edge-orchestrator.intel.com/v1"
+	tenancyv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/tenancy.edge-orchestrator.intel.com/v1"
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+/*
+Harness plays the Tenancy Manager on a fake nexus client: it adds and deletes runtime Orgs and Projects, orders
+and retries the watchers, and reads back what a watcher reported. The fake clients of a process share their
+objects, so the tests of a package should use one Harness and distinct org names.
+*/
+type Harness struct {
+	// Client is the fake nexus client to pass to watcher.New.
+	Client *nexus_client.Clientset
+}
+
+// NewHarness returns a Harness with the config and runtime roots of the data model.
+func NewHarness(ctx context.Context) (*Harness, error) {
+	client := nexus_client.NewFakeClient()
+	root, err := client.AddTenancyMultiTenancy(ctx, &tenancyv1.MultiTenancy{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+	if nexus_client.IsAlreadyExists(err) {
+		root, err = client.GetTenancyMultiTenancy(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to add the MultiTenancy root: %w", err)
+	}
+	if _, err := root.AddConfig(ctx, &configv1.Config{ObjectMeta: metav1.ObjectMeta{Name: "default"}}); err != nil &&
+		!nexus_client.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("unable to add the config root: %w", err)
+	}
+	if _, err := root.AddRuntime(ctx, &runtimev1.Runtime{ObjectMeta: metav1.ObjectMeta{Name: "default"}}); err != nil &&
+		!nexus_client.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("unable to add the runtime root: %w", err)
+	}
+	return &Harness{Client: client}, nil
+}
+
+// AddOrg adds the runtime Org of org with its default Folder. If ready is given, only those watchers may act on it
+// until SetReadyWatchers is called, otherwise every watcher may.
+func (h *Harness) AddOrg(ctx context.Context, org string, ready ...string) error {
+	runtimeOrg := &runtimeorgsv1.RuntimeOrg{ObjectMeta: objectMeta(org)}
+	if len(ready) > 0 {
+		annotate(runtimeOrg, tenancy.ReadyWatchersAnnotation, strings.Join(ready, ","))
+	}
+	added, err := h.Client.TenancyMultiTenancy().Runtime().AddOrgs(ctx, runtimeOrg)
+	if err != nil {
+		return fmt.Errorf("unable to add runtime Org: %w", err)
+	}
+	_, err = added.AddFolders(ctx, &runtimefoldersv1.RuntimeFolder{ObjectMeta: objectMeta("default")})
+	if err != nil {
+		return fmt.Errorf("unable to add runtime Folder: %w", err)
+	}
+	return nil
+}
+
+// AddProject adds the runtime Project of project in an Org added with AddOrg.
+func (h *Harness) AddProject(ctx context.Context, org, folder, project string) error {
+	_, err := h.Client.TenancyMultiTenancy().Runtime().Orgs(org).Folders(folder).
+		AddProjects(ctx, &runtimeprojectsv1.RuntimeProject{ObjectMeta: objectMeta(project)})
+	if err != nil {
+		return fmt.Errorf("unable to add runtime Project: %w", err)
+	}
+	return nil
+}
+
+// AddProjectWithParameters is AddProject for a Project whose ProjectTemplate sets parameters, by watcher name.
+func (h *Harness) AddProjectWithParameters(ctx context.Context, org, folder, project string,
+	parameters map[string]map[string]string,
+) error {
+	runtimeProject := &runtimeprojectsv1.RuntimeProject{ObjectMeta: objectMeta(project)}
+	for watcher, params := range parameters {
+		value, err := json.Marshal(params)
+		if err != nil {
+			return fmt.Errorf("unable to encode the parameters of %s: %w", watcher, err)
+		}
+		annotate(runtimeProject, tenancy.ParametersAnnotationPrefix+watcher, string(value))
+	}
+	_, err := h.Client.TenancyMultiTenancy().Runtime().Orgs(org).Folders(folder).AddProjects(ctx, runtimeProject)
+	if err != nil {
+		return fmt.Errorf("unable to add runtime Project: %w", err)
+	}
+	return nil
+}
+
+// DeleteOrg marks the runtime Org of org deleted.
+func (h *Harness) DeleteOrg(ctx context.Context, org string) error {
+	runtimeOrg, err := h.Client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, org)
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Org: %w", err)
+	}
+	runtimeOrg.Spec.Deleted = true
+	return runtimeOrg.Update(ctx)
+}
+
+// DeleteProject marks the runtime Project of project deleted.
+func (h *Harness) DeleteProject(ctx context.Context, org, folder, project string) error {
+	runtimeProject, err := h.Client.TenancyMultiTenancy().Runtime().Orgs(org).Folders(folder).GetProjects(ctx, project)
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Project: %w", err)
+	}
+	runtimeProject.Spec.Deleted = true
+	return runtimeProject.Update(ctx)
+}
+
+// SetReadyWatchers lets only watchers act on the runtime Org of org, as the watcher ordering does.
+func (h *Harness) SetReadyWatchers(ctx context.Context, org string, watchers ...string) error {
+	runtimeOrg, err := h.Client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, org)
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Org: %w", err)
+	}
+	annotate(runtimeOrg, tenancy.ReadyWatchersAnnotation, strings.Join(watchers, ","))
+	return runtimeOrg.Update(ctx)
+}
+
+// RetryOrg asks watcher to process the current create or delete of the runtime Org of org again,
+// as the Tenancy Manager does on a failure policy or on an operator retry.
+func (h *Harness) RetryOrg(ctx context.Context, org, watcher string) error {
+	runtimeOrg, err := h.Client.TenancyMultiTenancy().Runtime().GetOrgs(ctx, org)
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Org: %w", err)
+	}
+	annotate(runtimeOrg, tenancy.RetryAnnotationPrefix+watcher, fmt.Sprintf("%d,%s",
+		tenancy.WatcherRetries(runtimeOrg, watcher)+1, time.Now().UTC().Format(time.RFC3339)))
+	return runtimeOrg.Update(ctx)
+}
+
+// RetryProject is RetryOrg for the runtime Project of project.
+func (h *Harness) RetryProject(ctx context.Context, org, folder, project, watcher string) error {
+	runtimeProject, err := h.Client.TenancyMultiTenancy().Runtime().Orgs(org).Folders(folder).GetProjects(ctx, project)
+	if err != nil {
+		return fmt.Errorf("unable to get runtime Project: %w", err)
+	}
+	annotate(runtimeProject, tenancy.RetryAnnotationPrefix+watcher, fmt.Sprintf("%d,%s",
+		tenancy.WatcherRetries(runtimeProject, watcher)+1, time.Now().UTC().Format(time.RFC3339)))
+	return runtimeProject.Update(ctx)
+}
+
+// OrgWatcherStatus returns the status and message of the OrgActiveWatcher of watcher on org,
+// both empty if it has none.
+func (h *Harness) OrgWatcherStatus(ctx context.Context, org, watcher string) (string, string, error) {
+	active, err := h.Client.TenancyMultiTenancy().Runtime().Orgs(org).GetActiveWatchers(ctx, watcher)
+	if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	return string(active.Spec.StatusIndicator), active.Spec.Message, nil
+}
+
+// ProjectWatcherStatus returns the status and message of the ProjectActiveWatcher of watcher on project,
+// both empty if it has none.
+func (h *Harness) ProjectWatcherStatus(ctx context.Context, org, folder, project, watcher string) (string, string, error) {
+	active, err := h.Client.TenancyMultiTenancy().Runtime().Orgs(org).Folders(folder).Projects(project).
+		GetActiveWatchers(ctx, watcher)
+	if nexus_client.IsNotFound(err) || nexus_client.IsChildNotFound(err) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	return string(active.Spec.StatusIndicator), active.Spec.Message, nil
+}
+
+// objectMeta names a runtime object. The fake client neither versions the objects, while its cache expects
+// a version once subscribed to, nor sets their UID.
+func objectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: name, ResourceVersion: "1", UID: uuid.NewUUID()}
+}
+
+func annotate(obj metav1.Object, name, value string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[name] = value
+	obj.SetAnnotations(annotations)
+}
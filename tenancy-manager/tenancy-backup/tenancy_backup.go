@@ -0,0 +1,164 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// tenancy-backup exports the config tree of the tenancy data model into a bundle, and imports a bundle back.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/backup"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+)
+
+const appName = "tenancy-backup"
+
+const usage = `Usage:
+  %[1]s export [-k kubeconfig] [-o file] [-format yaml|json]
+  %[1]s import [-k kubeconfig] -f file [-wait duration] [-dry-run]
+
+export writes the orgs, folders, projects, networks, watcher registrations and API mappings into a bundle.
+import recreates the objects of a bundle that are missing, and reports those that differ as conflicts.
+It exits with 2 when there are conflicts.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintf(os.Stderr, usage, appName)
+		os.Exit(1)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	var err error
+	conflicts := false
+	switch os.Args[1] {
+	case "export":
+		err = runExport(ctx, os.Args[2:])
+	case "import":
+		conflicts, err = runImport(ctx, os.Args[2:])
+	default:
+		fmt.Fprintf(os.Stderr, usage, appName)
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", appName, err)
+		os.Exit(1)
+	}
+	if conflicts {
+		os.Exit(2)
+	}
+}
+
+// newFlagSet returns the flags of a subcommand, with those to reach the cluster.
+func newFlagSet(name string) (*flag.FlagSet, *string, *bool) {
+	flags := flag.NewFlagSet(appName+" "+name, flag.ExitOnError)
+	kubeconfig := flags.String("k", "", "Absolute path to the kubeconfig file. Defaults to ~/.kube/config.")
+	useServiceAccount := flags.Bool("serviceaccount", false, "use serviceaccount")
+	return flags, kubeconfig, useServiceAccount
+}
+
+func runExport(ctx context.Context, args []string) error {
+	flags, kubeconfig, useServiceAccount := newFlagSet("export")
+	output := flags.String("o", "", "File to write the bundle to. Defaults to the standard output.")
+	format := flags.String("format", backup.FormatYAML, "Format of the bundle: yaml or json.")
+	_ = flags.Parse(args)
+
+	client, err := newClient(*kubeconfig, *useServiceAccount)
+	if err != nil {
+		return err
+	}
+	bundle, err := backup.Export(ctx, client)
+	if err != nil {
+		return err
+	}
+	data, err := backup.Marshal(bundle, *format)
+	if err != nil {
+		return err
+	}
+	if *output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(*output, data, 0o600)
+}
+
+// runImport imports a bundle and prints the report. It returns whether there are conflicts.
+func runImport(ctx context.Context, args []string) (bool, error) {
+	flags, kubeconfig, useServiceAccount := newFlagSet("import")
+	input := flags.String("f", "", "File to read the bundle from.")
+	wait := flags.Duration("wait", 2*time.Minute,
+		"How long to wait for each org and project to be IDLE. The projects of an org not IDLE in time are skipped.")
+	dryRun := flags.Bool("dry-run", false, "Report what would be imported, without writing anything.")
+	_ = flags.Parse(args)
+	if *input == "" {
+		return false, fmt.Errorf("missing bundle file, set -f")
+	}
+
+	data, err := os.ReadFile(*input)
+	if err != nil {
+		return false, fmt.Errorf("unable to read bundle: %w", err)
+	}
+	bundle, err := backup.Unmarshal(data)
+	if err != nil {
+		return false, err
+	}
+	client, err := newClient(*kubeconfig, *useServiceAccount)
+	if err != nil {
+		return false, err
+	}
+	report, importErr := backup.Import(ctx, client, bundle, backup.Options{Wait: *wait, DryRun: *dryRun})
+	out, err := yaml.Marshal(report)
+	if err != nil {
+		return false, err
+	}
+	if _, err := os.Stdout.Write(out); err != nil {
+		return false, err
+	}
+	return len(report.Conflicts) > 0, importErr
+}
+
+func newClient(kubeconfig string, useServiceAccount bool) (*nexus_client.Clientset, error) {
+	cfg, err := getConfig(kubeconfig, useServiceAccount)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch kubeconfig: %w", err)
+	}
+	client, err := nexus_client.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize nexusClient: %w", err)
+	}
+	return client, nil
+}
+
+func getConfig(kubeconfig string, useServiceAccount bool) (*rest.Config, error) {
+	if kubeconfig != "" {
+		return clientcmd.BuildConfigFromFlags("", kubeconfig)
+	} else if useServiceAccount {
+		return rest.InClusterConfig()
+	}
+	return &rest.Config{Host: "localhost:9000"}, nil
+}
@@ -19,21 +19,39 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
+	"sync/atomic"
 	"syscall"
+	"time"
 
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
 	config_helper "github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/config"
 	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancy"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/tools/record"
 )
 
+const defaultLeaseNamespace = "orch-iam"
+
 var (
 	appName = "tenancy-manager"
 	log     = logging.GetLogger(appName)
@@ -43,6 +61,12 @@ func main() {
 	var kubeconfig string
 	flag.StringVar(&kubeconfig, "k", "", "Absolute path to the kubeconfig file. Defaults to ~/.kube/config.")
 	useServiceAccount := flag.Bool("serviceaccount", false, "use serviceaccount")
+	metricsAddr := flag.String("metrics-bind-address", ":8081",
+		"Address of the metrics and health endpoints: /metrics, /healthz and /readyz.")
+	leaderElect := flag.Bool("leader-elect", true,
+		"Elect a leader through a Lease so that only one replica processes events. Disable for tests.")
+	leaseNamespace := flag.String("leader-election-namespace", os.Getenv("POD_NAMESPACE"),
+		"Namespace of the leader election Lease. Defaults to $POD_NAMESPACE.")
 	flag.Parse()
 
 	// Setup log level
@@ -74,8 +98,49 @@ func main() {
 	}
 	reconciler := tenancy.NewReconciler(nexusClient, config)
 
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		log.Fatal().Msgf("unable to initialize kubernetes client: %v", err)
+	}
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+	defer broadcaster.Shutdown()
+	reconciler.Recorder = broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: appName})
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	if err := reconciler.RegisterMetrics(registry); err != nil {
+		log.Fatal().Msgf("unable to register metrics: %v", err)
+	}
+	var ready atomic.Bool
+	server := newMetricsServer(*metricsAddr, registry, &ready)
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal().Msgf("metrics server failed: %v", err)
+		}
+	}()
+
+	// Standby replicas subscribe too, so that their caches are warm when they lead.
+	if *leaderElect {
+		reconciler.Standby()
+	}
 	subscribeToTenancyEvents(nexusClient, reconciler)
 
+	// Resume in-flight operations and track watcher acknowledgements.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped := make(chan struct{})
+	if *leaderElect {
+		go func() {
+			defer close(stopped)
+			runLeaderElection(ctx, kubeClient, *leaseNamespace, reconciler)
+		}()
+	} else {
+		close(stopped)
+		go reconciler.Start(ctx)
+	}
+	ready.Store(true)
+
 	// Main wait loop for the App.
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
@@ -85,9 +150,78 @@ func main() {
 		done <- true
 	}()
 	<-done
+	ready.Store(false)
+	// Release the Lease, so that a standby replica leads without waiting for it to expire.
+	cancel()
+	<-stopped
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.InfraErr(err).Msg("Failed to shut down the metrics server")
+	}
 	log.Debug().Msg("Exiting")
 }
 
+// runLeaderElection competes for the tenancy-manager Lease in namespace and makes reconciler lead while it holds it.
+// It returns once ctx is done and the Lease is released. Losing the Lease otherwise exits the process, as the
+// reconciler cannot stand by again once it led.
+func runLeaderElection(ctx context.Context, kubeClient kubernetes.Interface, namespace string,
+	reconciler *tenancy.Reconciler,
+) {
+	identity, err := os.Hostname()
+	if err != nil {
+		log.Fatal().Msgf("unable to get the leader election identity: %v", err)
+	}
+	if podName := os.Getenv("POD_NAME"); podName != "" {
+		identity = podName
+	}
+	if namespace == "" {
+		namespace = defaultLeaseNamespace
+	}
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta:  metav1.ObjectMeta{Name: appName, Namespace: namespace},
+		Client:     kubeClient.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+	}
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		ReleaseOnCancel: true,
+		LeaseDuration:   15 * time.Second,
+		RenewDeadline:   10 * time.Second,
+		RetryPeriod:     2 * time.Second,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: reconciler.Lead,
+			OnStoppedLeading: func() {
+				if ctx.Err() == nil {
+					log.Fatal().Msgf("%s lost the leader election Lease", identity)
+				}
+				log.Info().Msgf("%s released the leader election Lease", identity)
+			},
+			OnNewLeader: func(leader string) {
+				log.Info().Msgf("%s leads", leader)
+			},
+		},
+	})
+}
+
+// newMetricsServer serves the metrics in registry, a liveness probe and a readiness probe
+// that succeeds once ready is set.
+func newMetricsServer(addr string, registry *prometheus.Registry, ready *atomic.Bool) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		if !ready.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	return &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+}
+
 // subscribeToTenancyEvents handles Tenancy subscriptions and callback registrations.
 
 func subscribeToTenancyEvents(nexusClient *nexus_client.Clientset, reconciler *tenancy.Reconciler) {
@@ -130,6 +264,33 @@ func subscribeToConfigEvents(nexusClient *nexus_client.Clientset, reconciler *te
 	if err != nil {
 		return fmt.Errorf("failed to register 'Update' call back for config Project update, error: %w", err)
 	}
+	_, err = tenant.Config().Orgs("*").Folders("*").Projects("*").RegisterDeleteCallback(reconciler.ProcessProjectsRemove)
+	if err != nil {
+		return fmt.Errorf("failed to register 'Delete' call back for config Project removal, error: %w", err)
+	}
+
+	_, err = tenant.Config().Orgs("*").Folders("*").RegisterAddCallback(reconciler.ProcessFoldersChange)
+	if err != nil {
+		return fmt.Errorf("failed to register 'Add' call back for config Folder add, error: %w", err)
+	}
+	_, err = tenant.Config().Orgs("*").Folders("*").RegisterDeleteCallback(reconciler.ProcessFoldersChange)
+	if err != nil {
+		return fmt.Errorf("failed to register 'Delete' call back for config Folder delete, error: %w", err)
+	}
+
+	networks := tenant.Config().Orgs("*").Folders("*").Projects("*").Networks("*")
+	_, err = networks.RegisterAddCallback(reconciler.ProcessNetworksAdd)
+	if err != nil {
+		return fmt.Errorf("failed to register 'Add' call back for config Network add, error: %w", err)
+	}
+	_, err = networks.RegisterUpdateCallback(reconciler.ProcessNetworksUpdate)
+	if err != nil {
+		return fmt.Errorf("failed to register 'Update' call back for config Network update, error: %w", err)
+	}
+	_, err = networks.RegisterDeleteCallback(reconciler.ProcessNetworksRemove)
+	if err != nil {
+		return fmt.Errorf("failed to register 'Delete' call back for config Network delete, error: %w", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,207 @@
+/*
+ * Copyright (C) 2025 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions
+ * and limitations under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// tenancyctl inspects the orgs, projects and watchers of the tenancy data model by display name.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	nexus_client "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/open-edge-platform/orch-utils/tenancy-manager/pkg/tenancyctl"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const appName = "tenancyctl"
+
+const usage = `Usage: %[1]s [flags] <command>
+
+Commands:
+  list orgs                          List the orgs.
+  list projects <org>                List the projects of an org, across its folders.
+  describe org <org>                 Show an org, with the acknowledgements of its watchers.
+  describe project <org>/<project>   Show a project, with the acknowledgements of its watchers.
+  watchers                           List the registered org and project watchers.
+  wait org <org>                     Wait for an org to be IDLE.
+  wait project <org>/<project>       Wait for a project to be IDLE.
+  delete org <org>                   Delete an org without projects, or with -projects its projects first.
+  delete project <org>/<project>     Delete a project.
+
+Flags:
+`
+
+func main() {
+	flags := flag.NewFlagSet(appName, flag.ExitOnError)
+	kubeconfig := flags.String("k", "", "Absolute path to the kubeconfig file. Defaults to ~/.kube/config.")
+	useServiceAccount := flags.Bool("serviceaccount", false, "use serviceaccount")
+	output := flags.String("o", tenancyctl.FormatTable, "Output format: table, json or yaml.")
+	timeout := flags.Duration("timeout", 5*time.Minute,
+		"How long wait waits for the tenant to be IDLE, and delete org -projects for the projects to be deleted.")
+	withProjects := flags.Bool("projects", false, "With delete org, delete the projects of the org first.")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), usage, appName)
+		flags.PrintDefaults()
+	}
+	args := parseArgs(flags, os.Args[1:])
+	if len(args) == 0 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	cfg, err := getConfig(*kubeconfig, *useServiceAccount)
+	if err != nil {
+		fail(fmt.Errorf("unable to fetch kubeconfig: %w", err))
+	}
+	client, err := nexus_client.NewForConfig(cfg)
+	if err != nil {
+		fail(fmt.Errorf("unable to initialize nexusClient: %w", err))
+	}
+
+	result, err := run(ctx, client, args, options{timeout: *timeout, withProjects: *withProjects})
+	if errors.Is(err, errUsage) {
+		flags.Usage()
+		os.Exit(1)
+	}
+	if err != nil {
+		fail(err)
+	}
+	if s, ok := result.(string); ok {
+		fmt.Println(s)
+		return
+	}
+	if err := tenancyctl.Print(os.Stdout, *output, result); err != nil {
+		fail(err)
+	}
+}
+
+// errUsage is the error of a command that does not match the usage.
+var errUsage = errors.New("usage")
+
+// options are the flags of the commands.
+type options struct {
+	timeout      time.Duration
+	withProjects bool
+}
+
+// run runs the command of args, and returns what to print: a result of package tenancyctl, or a message.
+func run(ctx context.Context, client *nexus_client.Clientset, args []string, opts options) (any, error) {
+	command, kind, target := args[0], "", ""
+	if len(args) > 1 {
+		kind = args[1]
+	}
+	if len(args) > 2 {
+		target = args[2]
+	}
+
+	switch {
+	case command == "watchers" && len(args) == 1:
+		return tenancyctl.ListWatchers(ctx, client)
+	case command == "list" && kind == "orgs" && len(args) == 2:
+		return tenancyctl.ListOrgs(ctx, client)
+	case command == "list" && kind == "projects" && len(args) == 3:
+		return tenancyctl.ListProjects(ctx, client, target)
+	case len(args) != 3:
+		return nil, errUsage
+	case kind == "org":
+		return runOrg(ctx, client, command, target, opts)
+	case kind == "project":
+		org, project, ok := strings.Cut(target, "/")
+		if !ok || org == "" || project == "" {
+			return nil, fmt.Errorf("invalid project %q, expected <org>/<project>", target)
+		}
+		return runProject(ctx, client, command, org, project, opts.timeout)
+	}
+	return nil, errUsage
+}
+
+func runOrg(ctx context.Context, client *nexus_client.Clientset, command, org string, opts options) (any, error) {
+	switch command {
+	case "describe":
+		return tenancyctl.GetOrg(ctx, client, org)
+	case "wait":
+		if err := tenancyctl.WaitIdle(ctx, client, org, "", opts.timeout); err != nil {
+			return nil, err
+		}
+		return fmt.Sprintf("org %s is IDLE", org), nil
+	case "delete":
+		if err := tenancyctl.DeleteOrg(ctx, client, org, opts.withProjects, opts.timeout); err != nil {
+			return nil, err
+		}
+		return fmt.Sprintf("org %s deleted", org), nil
+	}
+	return nil, errUsage
+}
+
+func runProject(ctx context.Context, client *nexus_client.Clientset, command, org, project string,
+	timeout time.Duration,
+) (any, error) {
+	switch command {
+	case "describe":
+		return tenancyctl.GetProject(ctx, client, org, project)
+	case "wait":
+		if err := tenancyctl.WaitIdle(ctx, client, org, project, timeout); err != nil {
+			return nil, err
+		}
+		return fmt.Sprintf("project %s/%s is IDLE", org, project), nil
+	case "delete":
+		if err := tenancyctl.DeleteProject(ctx, client, org, project); err != nil {
+			return nil, err
+		}
+		return fmt.Sprintf("project %s/%s deleted", org, project), nil
+	}
+	return nil, errUsage
+}
+
+// parseArgs parses flags wherever they are in args, and returns the other arguments.
+func parseArgs(flags *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		_ = flags.Parse(args)
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "%s: %v\n", appName, err)
+	os.Exit(1)
+}
+
+func getConfig(kubeconfig string, useServiceAccount bool) (*rest.Config, error) {
+	if kubeconfig != "" {
+		return clientcmd.BuildConfigFromFlags("", kubeconfig)
+	} else if useServiceAccount {
+		return rest.InClusterConfig()
+	}
+	return &rest.Config{Host: "localhost:9000"}, nil
+}